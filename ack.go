@@ -0,0 +1,324 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ro
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// Acked is an item to be acknowledged by the downstream observer once
+// processed, with Ack, or rejected with Nack. It is emitted by WithAck and
+// CheckpointAcked, so that a source commits its position (file offset, Kafka
+// offset, WAL entry...) only after the items have been processed.
+//
+// Only the first call to Ack or Nack is taken into account. The zero value
+// ignores both.
+type Acked[T any] struct {
+	Value T
+
+	state *ackState
+}
+
+type ackState struct {
+	settled int32
+	ack     func()
+	nack    func(err error)
+}
+
+// NewAcked creates an Acked item. onAck or onNack is called on the first call
+// to Ack or Nack, respectively.
+func NewAcked[T any](value T, onAck func(), onNack func(err error)) Acked[T] {
+	return Acked[T]{
+		Value: value,
+		state: &ackState{
+			ack:  onAck,
+			nack: onNack,
+		},
+	}
+}
+
+// Ack acknowledges the item.
+func (a Acked[T]) Ack() {
+	if a.state != nil && atomic.CompareAndSwapInt32(&a.state.settled, 0, 1) && a.state.ack != nil {
+		a.state.ack()
+	}
+}
+
+// Nack rejects the item: it is redelivered, or reported as failed. See
+// WithAckRedelivery.
+func (a Acked[T]) Nack(err error) {
+	if a.state != nil && atomic.CompareAndSwapInt32(&a.state.settled, 0, 1) && a.state.nack != nil {
+		a.state.nack(err)
+	}
+}
+
+// AckOption configures the WithAck and CheckpointAcked operators.
+type AckOption func(config *ackConfig)
+
+type ackConfig struct {
+	maxRedeliveries int
+	ackOnDrop       bool
+}
+
+// WithAckRedelivery redelivers a rejected item up to maxRedeliveries times,
+// before reporting it as failed.
+func WithAckRedelivery(maxRedeliveries int) AckOption {
+	if maxRedeliveries < 0 {
+		panic(ErrAckRedeliveryWrongCount)
+	}
+
+	return func(config *ackConfig) {
+		config.maxRedeliveries = maxRedeliveries
+	}
+}
+
+// WithAckOnDrop acknowledges the items that the downstream observer returns
+// from without acknowledging or rejecting them, such as the items dropped by
+// Filter, so that they do not hold the completion back.
+//
+// Use it only when the items are processed synchronously: an item handed to
+// another goroutine (ObserveOn, MergeMap...) would be acknowledged before
+// being processed.
+func WithAckOnDrop() AckOption {
+	return func(config *ackConfig) {
+		config.ackOnDrop = true
+	}
+}
+
+// WithAck wraps each item into an Acked item. onAck is called when the
+// downstream observer acknowledges the item, and onNack when it rejects it,
+// once the redeliveries are exhausted (see WithAckRedelivery).
+//
+// The completion is forwarded once every item has been acknowledged or
+// rejected, so that a rejected item can still be redelivered. An item dropped
+// downstream is never settled, and the stream never completes: acknowledge
+// it explicitly, or use WithAckOnDrop.
+//
+// It panics if onAck or onNack is nil.
+func WithAck[T any](onAck func(ctx context.Context, value T), onNack func(ctx context.Context, value T, err error), opts ...AckOption) func(Observable[T]) Observable[Acked[T]] {
+	if onAck == nil || onNack == nil {
+		panic(ErrAckNilCallback)
+	}
+
+	return func(source Observable[T]) Observable[Acked[T]] {
+		return newAckObservable(source, opts, func() ackHooks[T] {
+			return ackHooks[T]{
+				acked: func(ctx context.Context, _ uint64, value T) error {
+					onAck(ctx, value)
+					return nil
+				},
+				nacked: func(ctx context.Context, _ uint64, value T, err error) error {
+					onNack(ctx, value, err)
+					return nil
+				},
+			}
+		})
+	}
+}
+
+// CheckpointAcked wraps each item into an Acked item, and saves the positions
+// of the acknowledged items to checkpointer, under key. The position is
+// returned by offset.
+//
+// Items may be acknowledged out of order: the position saved is the one of
+// the latest item acknowledged along with all the previous ones, so that no
+// unprocessed item is skipped on restart.
+//
+// A rejected item is redelivered (see WithAckRedelivery), then emitted as an
+// error notification once the redeliveries are exhausted: its position is
+// never saved. A Save error is emitted as an error notification.
+//
+// The completion is forwarded once every item has been acknowledged. An item
+// dropped downstream is never acknowledged, and the stream never completes:
+// acknowledge it explicitly, or use WithAckOnDrop.
+func CheckpointAcked[T any](checkpointer Checkpointer, key string, offset func(value T) int64, opts ...AckOption) func(Observable[T]) Observable[Acked[T]] {
+	return func(source Observable[T]) Observable[Acked[T]] {
+		return newAckObservable(source, opts, func() ackHooks[T] {
+			var mu sync.Mutex
+			next := uint64(0)             // the first item not acknowledged yet
+			offsets := map[uint64]int64{} // acknowledged items, waiting for the previous ones
+
+			return ackHooks[T]{
+				acked: func(ctx context.Context, seq uint64, value T) error {
+					mu.Lock()
+					defer mu.Unlock()
+
+					offsets[seq] = offset(value)
+
+					last, ok := int64(0), false
+					for {
+						o, acked := offsets[next]
+						if !acked {
+							break
+						}
+
+						delete(offsets, next)
+						next++
+						last, ok = o, true
+					}
+
+					if !ok {
+						return nil
+					}
+
+					// Saved under the lock, so that positions are saved in order.
+					return checkpointer.Save(ctx, key, last)
+				},
+				nacked: func(_ context.Context, _ uint64, _ T, err error) error {
+					return err
+				},
+			}
+		})
+	}
+}
+
+type ackHooks[T any] struct {
+	// acked is called when the item numbered seq is acknowledged. A non-nil
+	// error is emitted downstream.
+	acked func(ctx context.Context, seq uint64, value T) error
+	// nacked is called when the item numbered seq is rejected and cannot be
+	// redelivered. A non-nil error is emitted downstream.
+	nacked func(ctx context.Context, seq uint64, value T, err error) error
+}
+
+// newAckObservable wraps the items of source into Acked items. newHooks is
+// called on each subscription, so that the hooks may hold the state of a
+// single subscription.
+func newAckObservable[T any](source Observable[T], opts []AckOption, newHooks func() ackHooks[T]) Observable[Acked[T]] {
+	config := ackConfig{}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[Acked[T]]) Teardown {
+		hooks := newHooks()
+		emitter := &ackEmitter{}
+
+		var mu sync.Mutex
+		seq := uint64(0)
+		inFlight := 0
+		completed := false
+
+		settle := func(ctx context.Context) {
+			mu.Lock()
+			inFlight--
+			complete := completed && inFlight == 0
+			mu.Unlock()
+
+			if complete {
+				emitter.emit(func() { destination.CompleteWithContext(ctx) })
+			}
+		}
+
+		fail := func(ctx context.Context, err error) {
+			if err != nil {
+				emitter.emit(func() { destination.ErrorWithContext(ctx, err) })
+			}
+		}
+
+		var deliver func(ctx context.Context, seq uint64, value T, attempt int)
+		deliver = func(ctx context.Context, seq uint64, value T, attempt int) {
+			item := NewAcked(
+				value,
+				func() {
+					fail(ctx, hooks.acked(ctx, seq, value))
+					settle(ctx)
+				},
+				func(err error) {
+					if attempt < config.maxRedeliveries {
+						deliver(ctx, seq, value, attempt+1)
+						return
+					}
+
+					fail(ctx, hooks.nacked(ctx, seq, value, err))
+					settle(ctx)
+				},
+			)
+
+			emitter.emit(func() {
+				destination.NextWithContext(ctx, item)
+
+				if config.ackOnDrop {
+					item.Ack() // no-op when already settled
+				}
+			})
+		}
+
+		sub := source.SubscribeWithContext(
+			subscriberCtx,
+			NewObserverWithContext(
+				func(ctx context.Context, value T) {
+					mu.Lock()
+					s := seq
+					seq++
+					inFlight++
+					mu.Unlock()
+
+					deliver(ctx, s, value, 0)
+				},
+				fail,
+				func(ctx context.Context) {
+					mu.Lock()
+					completed = true
+					complete := inFlight == 0
+					mu.Unlock()
+
+					if complete {
+						emitter.emit(func() { destination.CompleteWithContext(ctx) })
+					}
+				},
+			),
+		)
+
+		return sub.Unsubscribe
+	})
+}
+
+// ackEmitter serializes the notifications sent by the source and by the
+// calls to Ack and Nack, which may come from any goroutine, or from the
+// downstream observer itself. A notification sent while another one is being
+// delivered is queued, and delivered by the same goroutine right after.
+type ackEmitter struct {
+	mu       sync.Mutex
+	emitting bool
+	queue    []func()
+}
+
+func (e *ackEmitter) emit(notification func()) {
+	e.mu.Lock()
+	e.queue = append(e.queue, notification)
+
+	if e.emitting {
+		e.mu.Unlock()
+		return
+	}
+
+	e.emitting = true
+
+	for len(e.queue) > 0 {
+		next := e.queue[0]
+		e.queue = e.queue[1:]
+		e.mu.Unlock()
+
+		next()
+
+		e.mu.Lock()
+	}
+
+	e.emitting = false
+	e.mu.Unlock()
+}
@@ -0,0 +1,390 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ro
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcked(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	acks, nacks := 0, []error{}
+	item := NewAcked(42, func() { acks++ }, func(err error) { nacks = append(nacks, err) })
+	is.Equal(42, item.Value)
+
+	// Only the first call is taken into account.
+	item.Ack()
+	item.Ack()
+	item.Nack(assert.AnError)
+	is.Equal(1, acks)
+	is.Empty(nacks)
+
+	item = NewAcked(42, func() { acks++ }, func(err error) { nacks = append(nacks, err) })
+	item.Nack(assert.AnError)
+	item.Ack()
+	is.Equal(1, acks)
+	is.Equal([]error{assert.AnError}, nacks)
+
+	// The zero value ignores both.
+	Acked[int]{}.Ack()
+	Acked[int]{}.Nack(assert.AnError)
+
+	is.PanicsWithValue(ErrAckRedeliveryWrongCount, func() {
+		WithAckRedelivery(-1)
+	})
+	is.PanicsWithValue(ErrAckNilCallback, func() {
+		WithAck(nil, func(context.Context, int, error) {})
+	})
+	is.PanicsWithValue(ErrAckNilCallback, func() {
+		WithAck(func(context.Context, int) {}, nil)
+	})
+}
+
+func TestWithAck(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	acked, nacked := []int{}, []int{}
+
+	values, err := Collect(
+		Pipe2(
+			Just(1, 2, 3, 4),
+			WithAck(
+				func(_ context.Context, value int) { acked = append(acked, value) },
+				func(_ context.Context, value int, err error) {
+					is.Equal(assert.AnError, err)
+					nacked = append(nacked, value)
+				},
+			),
+			Map(func(item Acked[int]) int {
+				if item.Value%2 == 0 {
+					item.Nack(assert.AnError)
+				} else {
+					item.Ack()
+				}
+
+				return item.Value
+			}),
+		),
+	)
+	is.Equal([]int{1, 2, 3, 4}, values)
+	is.NoError(err)
+	is.Equal([]int{1, 3}, acked)
+	is.Equal([]int{2, 4}, nacked)
+}
+
+func TestWithAck_redelivery(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	nacked := []int{}
+	attempts := map[int]int{}
+
+	values, err := Collect(
+		Pipe2(
+			Just(1, 2),
+			WithAck(
+				func(_ context.Context, _ int) {},
+				func(_ context.Context, value int, _ error) { nacked = append(nacked, value) },
+				WithAckRedelivery(2),
+			),
+			Map(func(item Acked[int]) int {
+				attempts[item.Value]++
+
+				// 1 succeeds on the second attempt, 2 always fails.
+				if item.Value == 1 && attempts[item.Value] == 2 {
+					item.Ack()
+				} else {
+					item.Nack(assert.AnError)
+				}
+
+				return item.Value
+			}),
+		),
+	)
+	// Redeliveries are queued after the item being processed.
+	is.Equal([]int{1, 1, 2, 2, 2}, values)
+	is.NoError(err)
+	is.Equal(map[int]int{1: 2, 2: 3}, attempts)
+	is.Equal([]int{2}, nacked)
+}
+
+func TestWithAck_completeAfterSettlement(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	items := []Acked[int]{}
+	completed := false
+
+	WithAck(func(context.Context, int) {}, func(context.Context, int, error) {})(Just(1, 2)).
+		Subscribe(NewObserver(
+			func(item Acked[int]) { items = append(items, item) },
+			func(err error) { is.Fail("unexpected error", err) },
+			func() { completed = true },
+		))
+
+	is.Len(items, 2)
+	is.False(completed)
+
+	items[1].Ack()
+	is.False(completed)
+
+	items[0].Ack()
+	is.True(completed)
+}
+
+func TestWithAck_ackOnDrop(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	acked, nacked := []int{}, []int{}
+	onAck := func(_ context.Context, value int) { acked = append(acked, value) }
+	onNack := func(_ context.Context, value int, _ error) { nacked = append(nacked, value) }
+
+	// Without the option, the items dropped by Filter hold the completion back.
+	completed := false
+	Pipe2(
+		Just(1, 2, 3, 4),
+		WithAck(onAck, onNack),
+		Filter(func(item Acked[int]) bool { return item.Value%2 == 0 }),
+	).Subscribe(NewObserver(
+		func(item Acked[int]) { item.Ack() },
+		func(err error) { is.Fail("unexpected error", err) },
+		func() { completed = true },
+	))
+	is.False(completed)
+	is.Equal([]int{2, 4}, acked)
+
+	acked = []int{}
+
+	values, err := Collect(
+		Pipe3(
+			Just(1, 2, 3, 4),
+			WithAck(onAck, onNack, WithAckOnDrop()),
+			Filter(func(item Acked[int]) bool { return item.Value%2 == 0 }),
+			Map(func(item Acked[int]) int {
+				item.Nack(assert.AnError)
+				return item.Value
+			}),
+		),
+	)
+	is.Equal([]int{2, 4}, values)
+	is.NoError(err)
+	is.Equal([]int{1, 3}, acked)
+	is.Equal([]int{2, 4}, nacked)
+}
+
+func TestCheckpointAcked(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	ctx := context.Background()
+	checkpointer := NewMemoryCheckpointer()
+
+	items := []Acked[int64]{}
+	CheckpointAcked(checkpointer, "key", func(value int64) int64 { return value * 10 })(Just[int64](1, 2, 3)).
+		Subscribe(OnNext(func(item Acked[int64]) { items = append(items, item) }))
+
+	load := func() int64 {
+		offset, ok, err := checkpointer.Load(ctx, "key")
+		is.NoError(err)
+
+		if !ok {
+			return -1
+		}
+
+		return offset
+	}
+
+	// Out of order acknowledgements wait for the previous items.
+	items[1].Ack()
+	is.Equal(int64(-1), load())
+
+	items[0].Ack()
+	is.Equal(int64(20), load())
+
+	items[2].Ack()
+	is.Equal(int64(30), load())
+}
+
+func TestCheckpointAcked_ackOnDrop(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	ctx := context.Background()
+	checkpointer := NewMemoryCheckpointer()
+
+	values, err := Collect(
+		Pipe2(
+			Just[int64](1, 2, 3),
+			CheckpointAcked(checkpointer, "key", func(value int64) int64 { return value * 10 }, WithAckOnDrop()),
+			Filter(func(item Acked[int64]) bool { return item.Value != 3 }),
+		),
+	)
+	is.Len(values, 2)
+	is.NoError(err)
+
+	offset, ok, err := checkpointer.Load(ctx, "key")
+	is.NoError(err)
+	is.True(ok)
+	is.Equal(int64(30), offset)
+}
+
+func TestCheckpointAcked_resubscribe(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	ctx := context.Background()
+	checkpointer := NewMemoryCheckpointer()
+
+	subscriptions := 0
+	source := Defer(func() Observable[int64] {
+		subscriptions++
+		if subscriptions == 1 {
+			return Just[int64](1, 2, 3)
+		}
+
+		return Just[int64](4, 5)
+	})
+
+	pipeline := Pipe2(
+		source,
+		CheckpointAcked(checkpointer, "key", func(value int64) int64 { return value }),
+		Map(func(item Acked[int64]) int64 {
+			item.Ack()
+			return item.Value
+		}),
+	)
+
+	// Each subscription tracks its own items.
+	values, err := Collect(pipeline)
+	is.Equal([]int64{1, 2, 3}, values)
+	is.NoError(err)
+
+	offset, ok, err := checkpointer.Load(ctx, "key")
+	is.NoError(err)
+	is.True(ok)
+	is.Equal(int64(3), offset)
+
+	values, err = Collect(pipeline)
+	is.Equal([]int64{4, 5}, values)
+	is.NoError(err)
+
+	offset, ok, err = checkpointer.Load(ctx, "key")
+	is.NoError(err)
+	is.True(ok)
+	is.Equal(int64(5), offset)
+}
+
+func TestCheckpointAcked_nack(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	checkpointer := NewMemoryCheckpointer()
+
+	values, err := Collect(
+		Pipe2(
+			Just[int64](1, 2, 3),
+			CheckpointAcked(checkpointer, "key", func(value int64) int64 { return value }, WithAckRedelivery(1)),
+			Map(func(item Acked[int64]) int64 {
+				if item.Value == 2 {
+					item.Nack(assert.AnError)
+				} else {
+					item.Ack()
+				}
+
+				return item.Value
+			}),
+		),
+	)
+	is.Equal([]int64{1, 2, 2}, values)
+	is.Equal(assert.AnError, err)
+
+	// The rejected item is not saved.
+	offset, ok, err := checkpointer.Load(context.Background(), "key")
+	is.NoError(err)
+	is.True(ok)
+	is.Equal(int64(1), offset)
+}
+
+func TestCheckpointAcked_saveError(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		Pipe2(
+			Just[int64](1, 2),
+			CheckpointAcked(failingCheckpointer{NewMemoryCheckpointer()}, "key", func(value int64) int64 { return value }),
+			Map(func(item Acked[int64]) int64 {
+				item.Ack()
+				return item.Value
+			}),
+		),
+	)
+	is.Equal([]int64{1}, values)
+	is.Equal(assert.AnError, err)
+}
+
+func TestCheckpointAcked_concurrentAcks(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, time.Second)
+	is := assert.New(t)
+
+	checkpointer := NewMemoryCheckpointer()
+
+	items := []Acked[int64]{}
+	completed := make(chan struct{})
+
+	CheckpointAcked(checkpointer, "key", func(value int64) int64 { return value })(Range(0, 100)).
+		Subscribe(NewObserver(
+			func(item Acked[int64]) { items = append(items, item) },
+			func(err error) { is.Fail("unexpected error", err) },
+			func() { close(completed) },
+		))
+
+	var wg sync.WaitGroup
+	for i := range items {
+		wg.Add(1)
+
+		go func(item Acked[int64]) {
+			defer wg.Done()
+			item.Ack()
+		}(items[i])
+	}
+
+	wg.Wait()
+	<-completed
+
+	offset, ok, err := checkpointer.Load(context.Background(), "key")
+	is.NoError(err)
+	is.True(ok)
+	is.Equal(int64(99), offset)
+}
@@ -0,0 +1,426 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package analyzer provides a go/analysis pass detecting common misuses of
+// github.com/samber/ro.
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const roPath = "github.com/samber/ro"
+
+const doc = `detect common misuses of github.com/samber/ro
+
+The ro analyzer reports:
+  - the Subscription returned by Subscribe being ignored: it can be neither
+    unsubscribed nor waited;
+  - Wait being called on a Subscription to a never-completing Observable
+    (Interval, Never...): Wait never returns;
+  - a cold Observable stored in a variable being subscribed several times:
+    the source is executed once per subscription, where Share was likely
+    intended;
+  - a blocking Collect helper being called on a never-completing Observable.
+
+The analysis is syntactic and conservative: Observables are followed through
+local variables assigned once and through Pipe calls, but not across function
+calls.`
+
+// Analyzer reports common misuses of github.com/samber/ro. See the package
+// documentation of the "ro" analyzer for the list of checks.
+var Analyzer = &analysis.Analyzer{
+	Name:     "ro",
+	Doc:      doc,
+	Run:      run,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+}
+
+// infiniteSources lists the creation operators whose Observables never complete.
+var infiniteSources = map[string]bool{
+	"Interval":            true,
+	"IntervalWithInitial": true,
+	"Never":               true,
+}
+
+// boundingPrefixes lists the operators that may complete a never-completing
+// Observable. A Pipe containing any of them is considered as completing.
+var boundingPrefixes = []string{
+	"Take",
+	"First",
+	"ElementAt",
+	"Find",
+	"Single",
+	"Contains",
+	"Every",
+	"Some",
+	"IsEmpty",
+	"IsNotEmpty",
+	"Timeout",
+}
+
+// blockingCollectors lists the helpers blocking until the Observable completes,
+// with the position of their context (-1 when none) and Observable arguments.
+// A helper taking a context is reported only when it receives
+// context.Background() or context.TODO().
+var blockingCollectors = map[string]struct{ ctx, obs int }{
+	"Collect":            {-1, 0},
+	"MustCollect":        {-1, 0},
+	"CollectWithContext": {0, 1},
+	"CollectFunc":        {0, 1},
+	"CollectChunks":      {0, 1},
+	"LastValue":          {0, 1},
+}
+
+type checker struct {
+	pass *analysis.Pass
+
+	// definitions maps the variables assigned exactly once to their value.
+	// Variables assigned several times map to nil.
+	definitions map[types.Object]ast.Expr
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	c := &checker{
+		pass:        pass,
+		definitions: map[types.Object]ast.Expr{},
+	}
+
+	inspect.Preorder([]ast.Node{(*ast.AssignStmt)(nil), (*ast.ValueSpec)(nil)}, c.collectDefinition)
+
+	subscriptions := map[types.Object][]*ast.CallExpr{}
+	order := []types.Object{}
+
+	inspect.Preorder([]ast.Node{(*ast.ExprStmt)(nil), (*ast.CallExpr)(nil)}, func(node ast.Node) {
+		switch node := node.(type) {
+		case *ast.ExprStmt:
+			c.checkIgnoredSubscription(node)
+		case *ast.CallExpr:
+			c.checkWait(node)
+			c.checkCollect(node)
+
+			if obj := c.subscribedVariable(node); obj != nil {
+				if _, ok := subscriptions[obj]; !ok {
+					order = append(order, obj)
+				}
+
+				subscriptions[obj] = append(subscriptions[obj], node)
+			}
+		}
+	})
+
+	for _, obj := range order {
+		c.checkColdSubscriptions(obj, subscriptions[obj])
+	}
+
+	return nil, nil
+}
+
+func (c *checker) collectDefinition(node ast.Node) {
+	switch node := node.(type) {
+	case *ast.AssignStmt:
+		for i, lhs := range node.Lhs {
+			var value ast.Expr
+			if len(node.Lhs) == len(node.Rhs) {
+				value = node.Rhs[i]
+			}
+
+			c.define(lhs, value)
+		}
+	case *ast.ValueSpec:
+		for i, name := range node.Names {
+			var value ast.Expr
+			if len(node.Names) == len(node.Values) {
+				value = node.Values[i]
+			}
+
+			c.define(name, value)
+		}
+	}
+}
+
+func (c *checker) define(lhs ast.Expr, value ast.Expr) {
+	ident, ok := astutil.Unparen(lhs).(*ast.Ident)
+	if !ok || ident.Name == "_" {
+		return
+	}
+
+	obj := c.pass.TypesInfo.ObjectOf(ident)
+	if obj == nil {
+		return
+	}
+
+	if _, ok := c.definitions[obj]; ok {
+		value = nil
+	}
+
+	c.definitions[obj] = value
+}
+
+// resolve follows the variables assigned once, and returns the expression
+// they were assigned.
+func (c *checker) resolve(expr ast.Expr) ast.Expr {
+	for i := 0; i < 16; i++ {
+		expr = astutil.Unparen(expr)
+
+		ident, ok := expr.(*ast.Ident)
+		if !ok {
+			return expr
+		}
+
+		value := c.definitions[c.pass.TypesInfo.ObjectOf(ident)]
+		if value == nil {
+			return expr
+		}
+
+		expr = value
+	}
+
+	return expr
+}
+
+// checkIgnoredSubscription reports `obs.Subscribe(...)` used as a statement.
+func (c *checker) checkIgnoredSubscription(stmt *ast.ExprStmt) {
+	call, ok := astutil.Unparen(stmt.X).(*ast.CallExpr)
+	if !ok || !c.isSubscribe(call) {
+		return
+	}
+
+	c.pass.ReportRangef(call, "the Subscription returned by %s is ignored: it can be neither unsubscribed nor waited", methodName(call))
+}
+
+// checkWait reports `sub.Wait()` on a Subscription to a never-completing
+// Observable.
+func (c *checker) checkWait(call *ast.CallExpr) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Wait" || !isRoNamed(c.pass.TypesInfo.TypeOf(sel.X), "Subscription") {
+		return
+	}
+
+	subscribe, ok := c.resolve(sel.X).(*ast.CallExpr)
+	if !ok || !c.isSubscribe(subscribe) {
+		return
+	}
+
+	if source := c.infiniteSource(subscribe.Fun.(*ast.SelectorExpr).X); source != "" {
+		c.pass.ReportRangef(call, "Wait never returns: ro.%s never completes; use ro.Run with a cancelable context, or a Take operator", source)
+	}
+}
+
+// checkCollect reports blocking Collect helpers on never-completing Observables.
+func (c *checker) checkCollect(call *ast.CallExpr) {
+	name, ok := c.roFunc(call)
+	if !ok {
+		return
+	}
+
+	args, ok := blockingCollectors[name]
+	if !ok || len(call.Args) <= args.obs {
+		return
+	}
+
+	if args.ctx >= 0 && !c.isBackgroundContext(call.Args[args.ctx]) {
+		return
+	}
+
+	if source := c.infiniteSource(call.Args[args.obs]); source != "" {
+		c.pass.ReportRangef(call, "ro.%s never returns: ro.%s never completes; use a Take operator, or a cancelable context", name, source)
+	}
+}
+
+// checkColdSubscriptions reports the subscriptions to a cold Observable
+// variable, after the first one.
+func (c *checker) checkColdSubscriptions(obj types.Object, calls []*ast.CallExpr) {
+	if len(calls) < 2 || !c.isCold(c.definitions[obj]) {
+		return
+	}
+
+	for _, call := range calls[1:] {
+		c.pass.ReportRangef(call, "%s is a cold Observable subscribed %d times: its source is executed for each subscription; use ro.Share to share a single execution", obj.Name(), len(calls))
+	}
+}
+
+// subscribedVariable returns the variable subscribed by `v.Subscribe(...)`.
+func (c *checker) subscribedVariable(call *ast.CallExpr) types.Object {
+	if !c.isSubscribe(call) {
+		return nil
+	}
+
+	ident, ok := astutil.Unparen(call.Fun.(*ast.SelectorExpr).X).(*ast.Ident)
+	if !ok {
+		return nil
+	}
+
+	if _, ok := c.pass.TypesInfo.ObjectOf(ident).(*types.Var); !ok {
+		return nil
+	}
+
+	return c.pass.TypesInfo.ObjectOf(ident)
+}
+
+// infiniteSource returns the name of the never-completing creation operator
+// at the origin of expr, or "" if expr may complete.
+func (c *checker) infiniteSource(expr ast.Expr) string {
+	call, ok := c.resolve(expr).(*ast.CallExpr)
+	if !ok {
+		return ""
+	}
+
+	name, ok := c.roFunc(call)
+	if !ok {
+		return ""
+	}
+
+	if infiniteSources[name] {
+		return name
+	}
+
+	if !isPipe(name) || len(call.Args) == 0 {
+		return ""
+	}
+
+	for _, op := range call.Args[1:] {
+		opCall, ok := astutil.Unparen(op).(*ast.CallExpr)
+		if !ok {
+			return ""
+		}
+
+		opName, ok := c.roFunc(opCall)
+		if !ok || isBounding(opName) {
+			return ""
+		}
+	}
+
+	return c.infiniteSource(call.Args[0])
+}
+
+// isCold reports whether expr is an Observable built by ro, and not shared.
+func (c *checker) isCold(expr ast.Expr) bool {
+	if expr == nil {
+		return false
+	}
+
+	call, ok := c.resolve(expr).(*ast.CallExpr)
+	if !ok || !isRoNamed(c.pass.TypesInfo.TypeOf(call), "Observable") {
+		return false
+	}
+
+	name, ok := c.roFunc(call)
+	if !ok {
+		return false
+	}
+
+	if !isPipe(name) {
+		return true
+	}
+
+	if len(call.Args) == 0 {
+		return false
+	}
+
+	if len(call.Args) > 1 {
+		last, ok := astutil.Unparen(call.Args[len(call.Args)-1]).(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+
+		if lastName, ok := c.roFunc(last); !ok || strings.HasPrefix(lastName, "Share") {
+			return false
+		}
+	}
+
+	return c.isCold(call.Args[0])
+}
+
+// isSubscribe reports whether call is `x.Subscribe(...)` or
+// `x.SubscribeWithContext(...)` returning a ro.Subscription.
+func (c *checker) isSubscribe(call *ast.CallExpr) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || (sel.Sel.Name != "Subscribe" && sel.Sel.Name != "SubscribeWithContext") {
+		return false
+	}
+
+	if selection, ok := c.pass.TypesInfo.Selections[sel]; !ok || selection.Kind() != types.MethodVal {
+		return false
+	}
+
+	return isRoNamed(c.pass.TypesInfo.TypeOf(call), "Subscription")
+}
+
+// roFunc returns the name of the package-level ro function called by call.
+func (c *checker) roFunc(call *ast.CallExpr) (string, bool) {
+	fn, ok := typeutil.Callee(c.pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != roPath {
+		return "", false
+	}
+
+	if fn.Type().(*types.Signature).Recv() != nil {
+		return "", false
+	}
+
+	return fn.Name(), true
+}
+
+func (c *checker) isBackgroundContext(expr ast.Expr) bool {
+	call, ok := astutil.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+
+	fn, ok := typeutil.Callee(c.pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "context" {
+		return false
+	}
+
+	return fn.Name() == "Background" || fn.Name() == "TODO"
+}
+
+func isRoNamed(typ types.Type, name string) bool {
+	named, ok := typ.(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+
+	return obj.Pkg() != nil && obj.Pkg().Path() == roPath && obj.Name() == name
+}
+
+func isPipe(name string) bool {
+	return strings.HasPrefix(name, "Pipe") && !strings.HasPrefix(name, "PipeOp")
+}
+
+func isBounding(name string) bool {
+	for _, prefix := range boundingPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func methodName(call *ast.CallExpr) string {
+	return call.Fun.(*ast.SelectorExpr).Sel.Name
+}
@@ -0,0 +1,73 @@
+package a
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/ro"
+)
+
+func ignoredSubscription() {
+	ro.Just(1, 2, 3).Subscribe(ro.OnNext(func(int) {})) // want `the Subscription returned by Subscribe is ignored`
+
+	ro.Just(1, 2, 3).SubscribeWithContext(context.Background(), ro.OnNext(func(int) {})) // want `the Subscription returned by SubscribeWithContext is ignored`
+
+	_ = ro.Just(1, 2, 3).Subscribe(ro.OnNext(func(int) {}))
+
+	sub := ro.Just(1, 2, 3).Subscribe(ro.OnNext(func(int) {}))
+	defer sub.Unsubscribe()
+}
+
+func waitOnInfiniteSource() {
+	ro.Interval(time.Second).Subscribe(ro.OnNext(func(int64) {})).Wait() // want `Wait never returns: ro.Interval never completes`
+
+	obs := ro.Pipe1(ro.Never(), ro.Map(func(struct{}) int { return 1 }))
+	sub := obs.Subscribe(ro.OnNext(func(int) {}))
+	sub.Wait() // want `Wait never returns: ro.Never never completes`
+
+	bounded := ro.Pipe1(ro.Interval(time.Second), ro.Take[int64](3))
+	sub2 := bounded.Subscribe(ro.OnNext(func(int64) {}))
+	sub2.Wait()
+
+	sub3 := ro.Just(1).Subscribe(ro.OnNext(func(int) {}))
+	sub3.Wait()
+}
+
+func coldSubscribedTwice() {
+	obs := ro.Pipe1(ro.Just(1, 2, 3), ro.Map(func(i int) int { return i * 2 }))
+
+	sub1 := obs.Subscribe(ro.OnNext(func(int) {}))
+	sub2 := obs.Subscribe(ro.OnNext(func(int) {})) // want `obs is a cold Observable subscribed 2 times`
+	defer sub1.Unsubscribe()
+	defer sub2.Unsubscribe()
+
+	shared := ro.Pipe2(ro.Just(1, 2, 3), ro.Map(func(i int) int { return i * 2 }), ro.Share[int]())
+
+	sub3 := shared.Subscribe(ro.OnNext(func(int) {}))
+	sub4 := shared.Subscribe(ro.OnNext(func(int) {}))
+	defer sub3.Unsubscribe()
+	defer sub4.Unsubscribe()
+
+	subject := ro.NewPublishSubject[int]()
+
+	sub5 := subject.Subscribe(ro.OnNext(func(int) {}))
+	sub6 := subject.Subscribe(ro.OnNext(func(int) {}))
+	defer sub5.Unsubscribe()
+	defer sub6.Unsubscribe()
+}
+
+func collectInfiniteSource(ctx context.Context) {
+	_, _ = ro.Collect(ro.Interval(time.Second)) // want `ro.Collect never returns: ro.Interval never completes`
+
+	obs := ro.IntervalWithInitial(0, time.Second)
+	_ = ro.MustCollect(obs) // want `ro.MustCollect never returns: ro.IntervalWithInitial never completes`
+
+	_, _, _ = ro.CollectWithContext(context.Background(), obs) // want `ro.CollectWithContext never returns`
+	_, _ = ro.LastValue(context.TODO(), obs)                   // want `ro.LastValue never returns`
+
+	// cancelable context
+	_, _, _ = ro.CollectWithContext(ctx, obs)
+
+	_, _ = ro.Collect(ro.Pipe1(ro.Interval(time.Second), ro.Take[int64](3)))
+	_, _ = ro.Collect(ro.Just(1, 2, 3))
+}
@@ -0,0 +1,58 @@
+// Package ro is a minimal stub of github.com/samber/ro for the analyzer tests.
+package ro
+
+import (
+	"context"
+	"time"
+)
+
+type Observer[T any] interface {
+	Next(value T)
+}
+
+type Subscription interface {
+	Unsubscribe()
+	Wait()
+}
+
+type Observable[T any] interface {
+	Subscribe(destination Observer[T]) Subscription
+	SubscribeWithContext(ctx context.Context, destination Observer[T]) Subscription
+}
+
+type Subject[T any] interface {
+	Observable[T]
+	Observer[T]
+}
+
+func OnNext[T any](onNext func(value T)) Observer[T] { return nil }
+
+func Just[T any](values ...T) Observable[T]                                 { return nil }
+func Interval(interval time.Duration) Observable[int64]                     { return nil }
+func IntervalWithInitial(initial, interval time.Duration) Observable[int64] { return nil }
+func Never() Observable[struct{}]                                           { return nil }
+func NewPublishSubject[T any]() Subject[T]                                  { return nil }
+
+func Map[T, R any](project func(item T) R) func(Observable[T]) Observable[R] { return nil }
+func Take[T any](count int64) func(Observable[T]) Observable[T]              { return nil }
+func Share[T any]() func(Observable[T]) Observable[T]                        { return nil }
+
+func Pipe1[A, B any](source Observable[A], operator1 func(Observable[A]) Observable[B]) Observable[B] {
+	return nil
+}
+
+func Pipe2[A, B, C any](source Observable[A], operator1 func(Observable[A]) Observable[B], operator2 func(Observable[B]) Observable[C]) Observable[C] {
+	return nil
+}
+
+func Collect[T any](obs Observable[T]) ([]T, error) { return nil, nil }
+func MustCollect[T any](obs Observable[T]) []T      { return nil }
+
+func CollectWithContext[T any](ctx context.Context, obs Observable[T]) ([]T, context.Context, error) {
+	return nil, nil, nil
+}
+
+func LastValue[T any](ctx context.Context, obs Observable[T]) (T, error) {
+	var zero T
+	return zero, nil
+}
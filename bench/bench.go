@@ -0,0 +1,355 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bench is a benchmark harness for ro pipelines. A Scenario describes
+// the source (type, number of rows, concurrency mode), the operators under test
+// and whether emitted values are captured. Scenarios run either from a regular
+// program (Run, RunAll, WriteReport) or from a testing.B (Benchmark), and
+// Compare detects regressions between two sets of results.
+//
+// The package also contains the performance benchmarks of ro itself, for the
+// per-event hot paths (Observer.Next, Subscriber.Next, operator chains,
+// subjects) and for the buffering operators. They are fully synchronous so
+// that goleak stays happy.
+//
+// Run with:
+//
+//	go test -run='^$' -bench='^Benchmark' -count=10 -benchmem ./bench/
+package bench
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"runtime"
+	"testing"
+	"text/tabwriter"
+	"time"
+
+	"github.com/samber/ro"
+)
+
+// SourceKind is the kind of source feeding a Scenario.
+type SourceKind int8
+
+const (
+	// SourceLoop emits the rows from a loop in the subscribe callback of an
+	// Observable created with the Scenario concurrency mode.
+	SourceLoop SourceKind = iota
+	// SourceSubject emits the rows through a PublishSubject.
+	SourceSubject
+	// SourceChannel emits the rows through a buffered channel fed by another
+	// goroutine (see ro.FromChannel).
+	SourceChannel
+)
+
+// String implements fmt.Stringer.
+func (k SourceKind) String() string {
+	switch k {
+	case SourceLoop:
+		return "loop"
+	case SourceSubject:
+		return "subject"
+	case SourceChannel:
+		return "channel"
+	default:
+		return fmt.Sprintf("SourceKind(%d)", k)
+	}
+}
+
+// Scenario describes a pipeline to benchmark.
+type Scenario[T, R any] struct {
+	// Name identifies the scenario in reports and comparisons.
+	Name string
+	// Rows is the number of items emitted by the source.
+	Rows int64
+	// Source is the kind of source. Defaults to SourceLoop.
+	Source SourceKind
+	// ConcurrencyMode is used by the source and by the final subscriber.
+	// Defaults to ro.ConcurrencyModeSafe.
+	ConcurrencyMode ro.ConcurrencyMode
+	// Item builds the i-th item emitted by the source.
+	Item func(index int64) T
+	// Pipeline is the chain of operators under test.
+	Pipeline func(ro.Observable[T]) ro.Observable[R]
+	// Capture stores the emitted values in a slice, as ro.Collect would.
+	// Otherwise, emitted values are only counted.
+	Capture bool
+}
+
+// Result is the outcome of a Scenario run.
+type Result struct {
+	Scenario string
+	Rows     int64
+	Emitted  int64
+	Duration time.Duration
+	Allocs   uint64
+	Bytes    uint64
+	Err      error
+}
+
+// NsPerRow returns the average duration spent per source row, in nanoseconds.
+func (r Result) NsPerRow() float64 {
+	if r.Rows == 0 {
+		return 0
+	}
+
+	return float64(r.Duration.Nanoseconds()) / float64(r.Rows)
+}
+
+// RowsPerSecond returns the source throughput.
+func (r Result) RowsPerSecond() float64 {
+	if r.Duration <= 0 {
+		return 0
+	}
+
+	return float64(r.Rows) / r.Duration.Seconds()
+}
+
+// AllocsPerRow returns the average number of heap allocations per source row.
+func (r Result) AllocsPerRow() float64 {
+	if r.Rows == 0 {
+		return 0
+	}
+
+	return float64(r.Allocs) / float64(r.Rows)
+}
+
+// BytesPerRow returns the average number of heap bytes allocated per source row.
+func (r Result) BytesPerRow() float64 {
+	if r.Rows == 0 {
+		return 0
+	}
+
+	return float64(r.Bytes) / float64(r.Rows)
+}
+
+// Run executes the scenario once and measures its duration and heap allocations.
+func Run[T, R any](scenario Scenario[T, R]) Result {
+	var before, after runtime.MemStats
+
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	start := time.Now()
+	emitted, err := execute(scenario)
+	duration := time.Since(start)
+
+	runtime.ReadMemStats(&after)
+
+	return Result{
+		Scenario: scenario.Name,
+		Rows:     scenario.Rows,
+		Emitted:  emitted,
+		Duration: duration,
+		Allocs:   after.Mallocs - before.Mallocs,
+		Bytes:    after.TotalAlloc - before.TotalAlloc,
+		Err:      err,
+	}
+}
+
+// RunAll executes every scenario once, sequentially.
+func RunAll[T, R any](scenarios ...Scenario[T, R]) []Result {
+	results := make([]Result, 0, len(scenarios))
+	for _, scenario := range scenarios {
+		results = append(results, Run(scenario))
+	}
+
+	return results
+}
+
+// Benchmark executes the scenario b.N times and reports allocations and the
+// "ns/row" metric. The benchmark fails if the pipeline emits an error.
+func Benchmark[T, R any](b *testing.B, scenario Scenario[T, R]) {
+	b.Helper()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	start := time.Now()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := execute(scenario); err != nil {
+			b.Fatalf("bench: scenario %q failed: %v", scenario.Name, err)
+		}
+	}
+
+	elapsed := time.Since(start)
+
+	b.StopTimer()
+
+	if scenario.Rows > 0 {
+		b.ReportMetric(float64(elapsed.Nanoseconds())/float64(int64(b.N)*scenario.Rows), "ns/row")
+	}
+}
+
+// WriteReport writes the results as an aligned table.
+func WriteReport(w io.Writer, results []Result) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+
+	fmt.Fprintln(tw, "scenario\trows\temitted\tduration\tns/row\trows/s\tallocs/row\tB/row\terror\t") //nolint:errcheck
+
+	for _, r := range results {
+		errMsg := "-"
+		if r.Err != nil {
+			errMsg = r.Err.Error()
+		}
+
+		//nolint:errcheck
+		fmt.Fprintf(
+			tw,
+			"%s\t%d\t%d\t%s\t%.2f\t%.0f\t%.2f\t%.2f\t%s\t\n",
+			r.Scenario, r.Rows, r.Emitted, r.Duration, r.NsPerRow(), r.RowsPerSecond(), r.AllocsPerRow(), r.BytesPerRow(), errMsg,
+		)
+	}
+
+	return tw.Flush()
+}
+
+// Regression is a scenario slower than its baseline.
+type Regression struct {
+	Scenario string
+	Baseline Result
+	Current  Result
+	// Ratio is the current ns/row divided by the baseline ns/row.
+	Ratio float64
+}
+
+// Compare matches results by scenario name and returns the scenarios whose
+// ns/row grew by more than threshold (0.1 means 10% slower than the baseline).
+// Scenarios missing from the baseline are ignored.
+func Compare(baseline []Result, current []Result, threshold float64) []Regression {
+	byName := make(map[string]Result, len(baseline))
+	for _, r := range baseline {
+		byName[r.Scenario] = r
+	}
+
+	regressions := []Regression{}
+
+	for _, r := range current {
+		base, ok := byName[r.Scenario]
+		if !ok || base.NsPerRow() == 0 {
+			continue
+		}
+
+		ratio := r.NsPerRow() / base.NsPerRow()
+		if ratio > 1+threshold {
+			regressions = append(regressions, Regression{
+				Scenario: r.Scenario,
+				Baseline: base,
+				Current:  r,
+				Ratio:    ratio,
+			})
+		}
+	}
+
+	return regressions
+}
+
+func execute[T, R any](scenario Scenario[T, R]) (int64, error) {
+	var emitted int64
+	var err error
+	var values []R
+
+	observer := ro.NewObserver(
+		func(value R) {
+			emitted++
+
+			if scenario.Capture {
+				values = append(values, value)
+			}
+		},
+		func(e error) {
+			err = e
+		},
+		func() {},
+	)
+
+	source, feed, stop := newSource(scenario)
+	defer stop()
+
+	pipeline := scenario.Pipeline
+	if pipeline == nil {
+		panic("bench: Scenario.Pipeline is required")
+	}
+
+	sub := pipeline(source).SubscribeWithContext(
+		context.Background(),
+		ro.NewSubscriberWithConcurrencyMode(observer, scenario.ConcurrencyMode),
+	)
+
+	feed()
+	sub.Wait()
+
+	return emitted, err
+}
+
+// newSource returns the source observable of the scenario, a function emitting
+// the rows that must be called once the pipeline is subscribed, and a function
+// releasing the source once the pipeline is done.
+func newSource[T, R any](scenario Scenario[T, R]) (ro.Observable[T], func(), func()) {
+	item := scenario.Item
+	if item == nil {
+		panic("bench: Scenario.Item is required")
+	}
+
+	switch scenario.Source {
+	case SourceLoop:
+		source := ro.NewObservableWithConcurrencyMode(func(ctx context.Context, destination ro.Observer[T]) ro.Teardown {
+			for i := int64(0); i < scenario.Rows; i++ {
+				destination.NextWithContext(ctx, item(i))
+			}
+
+			destination.CompleteWithContext(ctx)
+
+			return nil
+		}, scenario.ConcurrencyMode)
+
+		return source, func() {}, func() {}
+	case SourceSubject:
+		subject := ro.NewPublishSubject[T]()
+
+		return subject.AsObservable(), func() {
+			for i := int64(0); i < scenario.Rows; i++ {
+				subject.Next(item(i))
+			}
+
+			subject.Complete()
+		}, func() {}
+	case SourceChannel:
+		ch := make(chan T, 1024)
+		done := make(chan struct{})
+		stopped := make(chan struct{})
+
+		go func() {
+			defer close(stopped)
+			defer close(ch)
+
+			for i := int64(0); i < scenario.Rows; i++ {
+				select {
+				case ch <- item(i):
+				case <-done:
+					// the pipeline stopped early (Take, error...)
+					return
+				}
+			}
+		}()
+
+		return ro.FromChannel[T](ch), func() {}, func() {
+			close(done)
+			<-stopped
+		}
+	default:
+		panic(fmt.Sprintf("bench: unexpected source kind %s", scenario.Source))
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bench
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func mapFilterScenario(name string, source SourceKind) Scenario[int64, int64] {
+	return Scenario[int64, int64]{
+		Name:   name,
+		Rows:   1000,
+		Source: source,
+		Item:   func(index int64) int64 { return index },
+		Pipeline: func(obs ro.Observable[int64]) ro.Observable[int64] {
+			return ro.Pipe2(
+				obs,
+				ro.Map(func(v int64) int64 { return v * 2 }),
+				ro.Filter(func(v int64) bool { return v%4 == 0 }),
+			)
+		},
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, source := range []SourceKind{SourceLoop, SourceSubject, SourceChannel} {
+		result := Run(mapFilterScenario(source.String(), source))
+		is.Equal(source.String(), result.Scenario)
+		is.Equal(int64(1000), result.Rows)
+		is.Equal(int64(500), result.Emitted)
+		is.NoError(result.Err)
+		is.Positive(result.Duration)
+		is.Positive(result.NsPerRow())
+		is.Positive(result.RowsPerSecond())
+	}
+
+	scenario := mapFilterScenario("unsafe", SourceLoop)
+	scenario.ConcurrencyMode = ro.ConcurrencyModeUnsafe
+	scenario.Capture = true
+	is.Equal(int64(500), Run(scenario).Emitted)
+}
+
+func TestRunEarlyStop(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	scenario := Scenario[int64, int64]{
+		Name:     "take",
+		Rows:     100_000,
+		Source:   SourceChannel,
+		Item:     func(index int64) int64 { return index },
+		Pipeline: ro.Take[int64](10),
+	}
+
+	result := Run(scenario)
+	is.Equal(int64(10), result.Emitted)
+	is.NoError(result.Err)
+
+	scenario.Pipeline = func(obs ro.Observable[int64]) ro.Observable[int64] {
+		return ro.Pipe1(obs, ro.MapErr(func(v int64) (int64, error) {
+			if v == 5 {
+				return 0, assert.AnError
+			}
+
+			return v, nil
+		}))
+	}
+
+	result = Run(scenario)
+	is.Equal(int64(5), result.Emitted)
+	is.ErrorIs(result.Err, assert.AnError)
+}
+
+func TestRunAllAndWriteReport(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	results := RunAll(
+		mapFilterScenario("loop", SourceLoop),
+		mapFilterScenario("subject", SourceSubject),
+	)
+	is.Len(results, 2)
+
+	results = append(results, Result{Scenario: "failed", Err: assert.AnError})
+
+	var buf bytes.Buffer
+	is.NoError(WriteReport(&buf, results))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	is.Len(lines, 4)
+	is.Contains(lines[0], "ns/row")
+	is.Contains(lines[1], "loop")
+	is.Contains(lines[2], "subject")
+	is.Contains(lines[3], assert.AnError.Error())
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	baseline := []Result{
+		{Scenario: "a", Rows: 100, Duration: 100 * time.Microsecond},
+		{Scenario: "b", Rows: 100, Duration: 100 * time.Microsecond},
+		{Scenario: "c", Rows: 0},
+	}
+	current := []Result{
+		{Scenario: "a", Rows: 100, Duration: 105 * time.Microsecond},
+		{Scenario: "b", Rows: 100, Duration: 150 * time.Microsecond},
+		{Scenario: "c", Rows: 100, Duration: 150 * time.Microsecond},
+		{Scenario: "d", Rows: 100, Duration: 150 * time.Microsecond},
+	}
+
+	regressions := Compare(baseline, current, 0.1)
+	is.Len(regressions, 1)
+	is.Equal("b", regressions[0].Scenario)
+	is.InDelta(1.5, regressions[0].Ratio, 0.001)
+
+	is.Empty(Compare(baseline, current, 1))
+}
@@ -12,13 +12,6 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Package bench contains performance benchmarks for the per-event hot paths
-// (Observer.Next, Subscriber.Next, operator chains, subjects) and for the
-// buffering operators. They are fully synchronous so that goleak stays happy.
-//
-// Run with:
-//
-//	go test -run='^$' -bench='^Benchmark' -count=10 -benchmem ./bench/
 package bench
 
 import (
@@ -106,6 +99,89 @@ func BenchmarkPipeMapFilter(b *testing.B) {
 	}
 }
 
+func BenchmarkSingleProducerPipeMapFilter(b *testing.B) {
+	ctx := context.Background()
+
+	var destination ro.Observer[int]
+	source := ro.NewSingleProducerObservableWithContext(func(ctx context.Context, d ro.Observer[int]) ro.Teardown {
+		destination = d
+		return nil
+	})
+
+	obs := ro.Pipe2(
+		source,
+		ro.Map(func(v int) int { return v * 2 }),
+		ro.Filter(func(v int) bool { return v%4 == 0 }),
+	)
+
+	sub := obs.SubscribeWithContext(ctx, ro.NewUnsafeSubscriber(ro.NoopObserver[int]()))
+	defer sub.Unsubscribe()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		destination.NextWithContext(ctx, i)
+	}
+}
+
+type benchCtxKey struct{}
+
+func BenchmarkPipeContextWithValue(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		head func(ro.Observable[int]) ro.Observable[int]
+	}{
+		{"PerNotification", ro.ContextMap[int](func(ctx context.Context) context.Context { return ctx })},
+		{"FromSubscription", ro.ContextFromSubscription[int]()},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			ctx := context.Background()
+			subject := ro.NewPublishSubject[int]()
+
+			obs := ro.Pipe3(
+				subject.AsObservable(),
+				bm.head,
+				ro.ContextWithValue[int](benchCtxKey{}, 42),
+				ro.Map(func(v int) int { return v * 2 }),
+			)
+
+			sub := obs.Subscribe(ro.NoopObserver[int]())
+			defer sub.Unsubscribe()
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				// a per-notification context, as produced by ContextWithValue or ContextMap upstream
+				subject.NextWithContext(context.WithValue(ctx, benchCtxKey{}, i), i)
+			}
+		})
+	}
+}
+
+func BenchmarkChainMapFilter(b *testing.B) {
+	ctx := context.Background()
+	subject := ro.NewPublishSubject[int]()
+
+	obs := ro.Chain(
+		func(v int) (int, bool) { return v * 2, true },
+		func(v int) (int, bool) { return v, v%4 == 0 },
+	)(subject.AsObservable())
+
+	sub := obs.Subscribe(ro.NoopObserver[int]())
+	defer sub.Unsubscribe()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		subject.NextWithContext(ctx, i)
+	}
+}
+
 func BenchmarkCollectRangePipe(b *testing.B) {
 	obs := ro.Pipe2(
 		ro.Range(0, 1000),
@@ -151,6 +227,34 @@ func BenchmarkSkipLast(b *testing.B) {
 	}
 }
 
+func BenchmarkBufferWithCount(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		opts []ro.BufferOption
+	}{
+		{"default", nil},
+		{"reuse", []ro.BufferOption{ro.WithBufferReuse()}},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			ctx := context.Background()
+			subject := ro.NewPublishSubject[int]()
+
+			sub := ro.BufferWithCount[int](64, bm.opts...)(subject.AsObservable()).
+				Subscribe(ro.NoopObserver[[]int]())
+			defer sub.Unsubscribe()
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				subject.NextWithContext(ctx, i)
+			}
+		})
+	}
+}
+
 func BenchmarkZip2(b *testing.B) {
 	obs := ro.Zip2(ro.Range(0, 256), ro.Range(0, 256))
 
@@ -199,6 +303,34 @@ func BenchmarkPublishSubjectFanout(b *testing.B) {
 	}
 }
 
+func BenchmarkShardedPublishSubjectFanout(b *testing.B) {
+	for _, observers := range []int{64, 1024, 8192} {
+		for _, shards := range []int{1, 8} {
+			b.Run(fmt.Sprintf("observers=%d/shards=%d", observers, shards), func(b *testing.B) {
+				ctx := context.Background()
+				subject := ro.NewShardedPublishSubject[int](shards)
+
+				subscriptions := make([]ro.Subscription, 0, observers)
+				for range make([]struct{}, observers) {
+					subscriptions = append(subscriptions, subject.Subscribe(ro.NoopObserver[int]()))
+				}
+				defer func() {
+					for _, sub := range subscriptions {
+						sub.Unsubscribe()
+					}
+				}()
+
+				b.ReportAllocs()
+				b.ResetTimer()
+
+				for i := 0; i < b.N; i++ {
+					subject.NextWithContext(ctx, i)
+				}
+			})
+		}
+	}
+}
+
 func BenchmarkReplaySubjectBounded(b *testing.B) {
 	ctx := context.Background()
 	subject := ro.NewReplaySubject[int](16)
@@ -246,3 +378,49 @@ func BenchmarkDistinct(b *testing.B) {
 		subject.NextWithContext(ctx, i%1024)
 	}
 }
+
+func BenchmarkSumBatch(b *testing.B) {
+	batch := make([]float64, 4096)
+	for i := range batch {
+		batch[i] = float64(i)
+	}
+
+	obs := ro.SumBatch()(ro.Just(batch))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ro.Collect(obs)
+	}
+}
+
+func BenchmarkMillionRows(b *testing.B) {
+	pipeline := func(obs ro.Observable[int64]) ro.Observable[int64] {
+		return ro.Pipe2(
+			obs,
+			ro.Map(func(v int64) int64 { return v * 2 }),
+			ro.Filter(func(v int64) bool { return v%4 == 0 }),
+		)
+	}
+
+	for _, source := range []SourceKind{SourceLoop, SourceSubject, SourceChannel} {
+		for modeName, mode := range map[string]ro.ConcurrencyMode{"safe": ro.ConcurrencyModeSafe, "unsafe": ro.ConcurrencyModeUnsafe} {
+			for _, capture := range []bool{false, true} {
+				scenario := Scenario[int64, int64]{
+					Name:            fmt.Sprintf("source=%s/mode=%s/capture=%t", source, modeName, capture),
+					Rows:            1_000_000,
+					Source:          source,
+					ConcurrencyMode: mode,
+					Item:            func(index int64) int64 { return index },
+					Pipeline:        pipeline,
+					Capture:         capture,
+				}
+
+				b.Run(scenario.Name, func(b *testing.B) {
+					Benchmark(b, scenario)
+				})
+			}
+		}
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ro
+
+import "context"
+
+// Builder is a fluent alternative to PipeX, for pipelines made of same-type
+// transformations. Go methods cannot declare type parameters, so the methods of
+// a Builder keep the type of the items: use Via to change it.
+//
+//	obs := ro.Via(
+//		ro.From(ro.Just(1, 2, 3, 4)).
+//			Filter(func(v int) bool { return v%2 == 0 }).
+//			Map(func(v int) int { return v * 10 }),
+//		ro.Map(strconv.Itoa),
+//	).Build()
+//
+// A Builder is immutable: each method returns a new Builder.
+type Builder[T any] struct {
+	source Observable[T]
+}
+
+// From starts a Builder from the source Observable.
+func From[T any](source Observable[T]) Builder[T] {
+	return Builder[T]{source: source}
+}
+
+// Via applies an operator changing the type of the items to the Builder. It is
+// the escape hatch of the fluent API.
+func Via[T, R any](builder Builder[T], operator func(Observable[T]) Observable[R]) Builder[R] {
+	return Builder[R]{source: operator(builder.source)}
+}
+
+// Build returns the Observable built so far.
+func (b Builder[T]) Build() Observable[T] {
+	return b.source
+}
+
+// Pipe applies same-type operators to the Builder, in order.
+func (b Builder[T]) Pipe(operators ...func(Observable[T]) Observable[T]) Builder[T] {
+	return Builder[T]{source: PipeSame(b.source, operators...)}
+}
+
+// Map applies Map to the Builder.
+func (b Builder[T]) Map(project func(item T) T) Builder[T] {
+	return Builder[T]{source: Map(project)(b.source)}
+}
+
+// MapWithContext applies MapWithContext to the Builder.
+func (b Builder[T]) MapWithContext(project func(ctx context.Context, item T) (context.Context, T)) Builder[T] {
+	return Builder[T]{source: MapWithContext(project)(b.source)}
+}
+
+// Filter applies Filter to the Builder.
+func (b Builder[T]) Filter(predicate func(item T) bool) Builder[T] {
+	return Builder[T]{source: Filter(predicate)(b.source)}
+}
+
+// FilterWithContext applies FilterWithContext to the Builder.
+func (b Builder[T]) FilterWithContext(predicate func(ctx context.Context, item T) (context.Context, bool)) Builder[T] {
+	return Builder[T]{source: FilterWithContext(predicate)(b.source)}
+}
+
+// TapOnNext applies TapOnNext to the Builder.
+func (b Builder[T]) TapOnNext(onNext func(value T)) Builder[T] {
+	return Builder[T]{source: TapOnNext(onNext)(b.source)}
+}
+
+// Take applies Take to the Builder.
+func (b Builder[T]) Take(count int64) Builder[T] {
+	return Builder[T]{source: Take[T](count)(b.source)}
+}
+
+// Skip applies Skip to the Builder.
+func (b Builder[T]) Skip(count int64) Builder[T] {
+	return Builder[T]{source: Skip[T](count)(b.source)}
+}
+
+// TakeWhile applies TakeWhile to the Builder.
+func (b Builder[T]) TakeWhile(predicate func(item T) bool) Builder[T] {
+	return Builder[T]{source: TakeWhile(predicate)(b.source)}
+}
+
+// SkipWhile applies SkipWhile to the Builder.
+func (b Builder[T]) SkipWhile(predicate func(item T) bool) Builder[T] {
+	return Builder[T]{source: SkipWhile(predicate)(b.source)}
+}
+
+// Catch applies Catch to the Builder.
+func (b Builder[T]) Catch(finally func(err error) Observable[T]) Builder[T] {
+	return Builder[T]{source: Catch(finally)(b.source)}
+}
+
+// Subscribe builds the Observable and subscribes to it.
+func (b Builder[T]) Subscribe(destination Observer[T]) Subscription {
+	return b.source.Subscribe(destination)
+}
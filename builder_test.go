@@ -0,0 +1,90 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ro
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		From(Just(1, 2, 3, 4, 5, 6)).
+			Filter(func(v int) bool { return v%2 == 0 }).
+			Map(func(v int) int { return v * 10 }).
+			Skip(1).
+			Build(),
+	)
+	is.Equal([]int{40, 60}, values)
+	is.NoError(err)
+
+	strs, err := Collect(
+		Via(
+			From(Just(1, 2, 3)).Take(2),
+			Map(strconv.Itoa),
+		).Build(),
+	)
+	is.Equal([]string{"1", "2"}, strs)
+	is.NoError(err)
+
+	tapped := []int{}
+	values, err = Collect(
+		From(Just(1, 2, 3, 4, 1)).
+			TapOnNext(func(v int) { tapped = append(tapped, v) }).
+			SkipWhile(func(v int) bool { return v < 2 }).
+			TakeWhile(func(v int) bool { return v < 4 }).
+			Pipe(StartWith(0), EndWith(9)).
+			Build(),
+	)
+	is.Equal([]int{0, 2, 3, 9}, values)
+	is.Equal([]int{1, 2, 3, 4, 1}, tapped)
+	is.NoError(err)
+
+	values, err = Collect(
+		From(Just(1, 2)).
+			MapWithContext(func(ctx context.Context, v int) (context.Context, int) { return ctx, v + 1 }).
+			FilterWithContext(func(ctx context.Context, v int) (context.Context, bool) { return ctx, v > 2 }).
+			Build(),
+	)
+	is.Equal([]int{3}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		From(Throw[int](assert.AnError)).
+			Catch(func(err error) Observable[int] { return Just(42) }).
+			Build(),
+	)
+	is.Equal([]int{42}, values)
+	is.NoError(err)
+
+	// a Builder is immutable
+	base := From(Just(1, 2, 3))
+	_ = base.Map(func(v int) int { return v * 2 })
+	values, err = Collect(base.Build())
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	received := []int{}
+	From(Just(1, 2)).Subscribe(OnNext(func(v int) { received = append(received, v) }))
+	is.Equal([]int{1, 2}, received)
+}
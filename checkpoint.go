@@ -0,0 +1,225 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ro
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Checkpointer persists the position (offset, line number, message id...)
+// reached by a source, so that a pipeline can resume where it stopped after a
+// restart. Positions are stored by key, one per source.
+//
+// A position must be saved once the matching item has been processed, so that
+// the items in flight during a crash are read again on restart: such a
+// pipeline delivers the items at least once. See Checkpoint.
+//
+// NewMemoryCheckpointer and NewFileCheckpointer are provided. Plugins provide
+// other backends, such as rosql.NewCheckpointer.
+type Checkpointer interface {
+	// Load returns the last position saved for key. The boolean is false
+	// when no position has been saved yet.
+	Load(ctx context.Context, key string) (int64, bool, error)
+	// Save persists the position reached for key.
+	Save(ctx context.Context, key string, offset int64) error
+}
+
+var (
+	_ Checkpointer = (*memoryCheckpointer)(nil)
+	_ Checkpointer = (*fileCheckpointer)(nil)
+)
+
+// NewMemoryCheckpointer creates a Checkpointer keeping the positions in
+// memory. It does not survive a restart, and is intended for tests.
+func NewMemoryCheckpointer() Checkpointer {
+	return &memoryCheckpointer{
+		offsets: map[string]int64{},
+	}
+}
+
+type memoryCheckpointer struct {
+	mu      sync.Mutex
+	offsets map[string]int64
+}
+
+func (c *memoryCheckpointer) Load(_ context.Context, key string) (int64, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	offset, ok := c.offsets[key]
+
+	return offset, ok, nil
+}
+
+func (c *memoryCheckpointer) Save(_ context.Context, key string, offset int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.offsets[key] = offset
+
+	return nil
+}
+
+// NewFileCheckpointer creates a Checkpointer storing the positions in a JSON
+// file. The file is created on the first Save, and replaced atomically (write
+// to a temporary file, then rename) on each Save, so that a crash never leaves
+// a truncated file. The file and its directory are synced before Save returns.
+//
+// The file must not be shared between several Checkpointers.
+func NewFileCheckpointer(path string) Checkpointer {
+	return &fileCheckpointer{
+		path: path,
+	}
+}
+
+type fileCheckpointer struct {
+	path string
+
+	mu      sync.Mutex
+	offsets map[string]int64 // lazily loaded
+}
+
+func (c *fileCheckpointer) load() error {
+	if c.offsets != nil {
+		return nil
+	}
+
+	offsets := map[string]int64{}
+
+	content, err := os.ReadFile(c.path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	if len(content) > 0 {
+		if err := json.Unmarshal(content, &offsets); err != nil {
+			return err
+		}
+	}
+
+	c.offsets = offsets
+
+	return nil
+}
+
+func (c *fileCheckpointer) Load(_ context.Context, key string) (int64, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.load(); err != nil {
+		return 0, false, err
+	}
+
+	offset, ok := c.offsets[key]
+
+	return offset, ok, nil
+}
+
+func (c *fileCheckpointer) Save(_ context.Context, key string, offset int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.load(); err != nil {
+		return err
+	}
+
+	c.offsets[key] = offset
+
+	content, err := json.Marshal(c.offsets)
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(c.path, content)
+}
+
+// writeFileAtomic writes content to a temporary file of the same directory,
+// syncs it, then renames it to path.
+func writeFileAtomic(path string, content []byte) error {
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+
+	tmp := file.Name()
+
+	_, err = file.Write(content)
+	if err == nil {
+		err = file.Sync()
+	}
+
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(tmp, path)
+	}
+
+	if err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+
+	// Persist the rename. Syncing a directory is not supported on every
+	// platform, hence the error is ignored.
+	if dir, err := os.Open(filepath.Dir(path)); err == nil {
+		_ = dir.Sync()
+		_ = dir.Close()
+	}
+
+	return nil
+}
+
+// Checkpoint saves the position of each item to checkpointer, under key,
+// once the item has been processed by the downstream observer. The position
+// is returned by offset. No position is saved once the subscription is
+// canceled. A Save error is emitted as an error notification.
+//
+// Place it last, right before the sink, so that a position is saved only when
+// the matching item has been fully processed. On restart, load the position
+// with Checkpointer.Load and resume the source from there.
+func Checkpoint[T any](checkpointer Checkpointer, key string, offset func(value T) int64) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						destination.NextWithContext(ctx, value)
+
+						// The item may not have been processed.
+						if destination.IsClosed() {
+							return
+						}
+
+						if err := checkpointer.Save(ctx, key, offset(value)); err != nil {
+							destination.ErrorWithContext(ctx, err)
+						}
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ro
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type failingCheckpointer struct {
+	Checkpointer
+}
+
+func (c failingCheckpointer) Save(context.Context, string, int64) error {
+	return assert.AnError
+}
+
+func TestMemoryCheckpointer(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+	ctx := context.Background()
+
+	checkpointer := NewMemoryCheckpointer()
+
+	offset, ok, err := checkpointer.Load(ctx, "a")
+	is.NoError(err)
+	is.False(ok)
+	is.EqualValues(0, offset)
+
+	is.NoError(checkpointer.Save(ctx, "a", 42))
+	offset, ok, err = checkpointer.Load(ctx, "a")
+	is.NoError(err)
+	is.True(ok)
+	is.EqualValues(42, offset)
+}
+
+func TestFileCheckpointer(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "offsets.json")
+
+	checkpointer := NewFileCheckpointer(path)
+
+	_, ok, err := checkpointer.Load(ctx, "a")
+	is.NoError(err)
+	is.False(ok)
+
+	is.NoError(checkpointer.Save(ctx, "a", 21))
+	is.NoError(checkpointer.Save(ctx, "b", 42))
+	is.NoError(checkpointer.Save(ctx, "a", 84))
+
+	// restart
+	checkpointer = NewFileCheckpointer(path)
+
+	offset, ok, err := checkpointer.Load(ctx, "a")
+	is.NoError(err)
+	is.True(ok)
+	is.EqualValues(84, offset)
+
+	offset, ok, err = checkpointer.Load(ctx, "b")
+	is.NoError(err)
+	is.True(ok)
+	is.EqualValues(42, offset)
+
+	// no temporary file left
+	entries, err := os.ReadDir(filepath.Dir(path))
+	is.NoError(err)
+	is.Len(entries, 1)
+
+	// corrupted file
+	is.NoError(os.WriteFile(path, []byte("{"), 0o600))
+	_, _, err = NewFileCheckpointer(path).Load(ctx, "a")
+	is.Error(err)
+	is.Error(NewFileCheckpointer(path).Save(ctx, "a", 1))
+}
+
+func TestFileCheckpointerRoundTrip(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "offsets.json")
+	offset := func(value int) int64 { return int64(value) }
+
+	values, err := Collect(
+		Checkpoint(NewFileCheckpointer(path), "source", offset)(Just(1, 2, 3)),
+	)
+	is.NoError(err)
+	is.Equal([]int{1, 2, 3}, values)
+
+	// resume from a fresh checkpointer
+	last, ok, err := NewFileCheckpointer(path).Load(ctx, "source")
+	is.NoError(err)
+	is.True(ok)
+	is.EqualValues(3, last)
+
+	values, err = Collect(
+		Checkpoint(NewFileCheckpointer(path), "source", offset)(Just(4, 5)),
+	)
+	is.NoError(err)
+	is.Equal([]int{4, 5}, values)
+
+	last, ok, err = NewFileCheckpointer(path).Load(ctx, "source")
+	is.NoError(err)
+	is.True(ok)
+	is.EqualValues(5, last)
+
+	content, err := os.ReadFile(path)
+	is.NoError(err)
+	is.JSONEq(`{"source":5}`, string(content))
+}
+
+func TestCheckpoint(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+	ctx := context.Background()
+
+	checkpointer := NewMemoryCheckpointer()
+	offset := func(value int) int64 { return int64(value) }
+
+	values, err := Collect(
+		Checkpoint(checkpointer, "source", offset)(Just(1, 2, 3)),
+	)
+	is.NoError(err)
+	is.Equal([]int{1, 2, 3}, values)
+
+	last, ok, err := checkpointer.Load(ctx, "source")
+	is.NoError(err)
+	is.True(ok)
+	is.EqualValues(3, last)
+
+	// no position saved once unsubscribed
+	checkpointer = NewMemoryCheckpointer()
+	source := NewPublishSubject[int]()
+	var sub Subscription
+	sub = Checkpoint[int](checkpointer, "canceled", offset)(source).Subscribe(
+		OnNext(func(value int) {
+			if value == 2 {
+				sub.Unsubscribe()
+			}
+		}),
+	)
+	source.Next(1)
+	source.Next(2)
+	source.Next(3)
+
+	last, ok, err = checkpointer.Load(ctx, "canceled")
+	is.NoError(err)
+	is.True(ok)
+	is.EqualValues(1, last)
+
+	// save error
+	values, err = Collect(
+		Checkpoint(failingCheckpointer{NewMemoryCheckpointer()}, "source", offset)(Just(1, 2, 3)),
+	)
+	is.ErrorIs(err, assert.AnError)
+	is.Equal([]int{1}, values)
+
+	// error propagation
+	values, err = Collect(
+		Checkpoint(checkpointer, "source", offset)(Throw[int](assert.AnError)),
+	)
+	is.ErrorIs(err, assert.AnError)
+	is.Empty(values)
+}
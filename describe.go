@@ -0,0 +1,151 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ro
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Describer is implemented by the observables built by ro, the pipelines
+// returned by PipeX() when PipeDescriptions is enabled and the subscriptions,
+// so that logging them with %v gives a human-readable description instead of
+// a closure address.
+//
+// String returns a one-line summary, such as
+// "ro.Pipe[string](ro.FromSlice | ro.Filter | ro.MapIWithContext)".
+// DebugString returns a multi-line view, with a line per stage or teardown.
+//
+// Operators are named after the function that declared them: operators built
+// on top of another one report the latter, as in StageError.
+type Describer interface {
+	fmt.Stringer
+	DebugString() string
+}
+
+var (
+	_ Describer = (*observableImpl[int])(nil)
+	_ Describer = (*pipeObservable[int])(nil)
+	_ Describer = (*subscriptionImpl)(nil)
+	_ Describer = (*subscriberImpl[int])(nil)
+)
+
+// describeName returns a short name for an observable, an operator or any
+// other value, for the descriptions.
+func describeName(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "<nil>"
+	case interface{ describeName() string }:
+		return v.describeName()
+	case fmt.Stringer:
+		return v.String()
+	}
+
+	if reflect.TypeOf(v).Kind() == reflect.Func {
+		if name := stageOperatorName(v); name != "" {
+			// Method values are suffixed with "-fm".
+			return strings.TrimSuffix(name, "-fm")
+		}
+	}
+
+	return strings.TrimPrefix(fmt.Sprintf("%T", v), "*")
+}
+
+// indentDescription indents the lines following the first one, to nest a
+// DebugString into another.
+func indentDescription(description string) string {
+	return strings.ReplaceAll(description, "\n", "\n  ")
+}
+
+// pipeDescription records the source and the operators of a pipe, before
+// they are wrapped by PipeStageErrors.
+type pipeDescription struct {
+	source    any
+	operators []any
+}
+
+// newPipeDescription returns nil when PipeDescriptions is disabled. The
+// operators are copied, so that the variadic slice does not escape.
+func newPipeDescription(source any, operators ...any) *pipeDescription {
+	if !PipeDescriptions {
+		return nil
+	}
+
+	return &pipeDescription{
+		source:    source,
+		operators: append([]any(nil), operators...),
+	}
+}
+
+// describePipe attaches the description of a pipe to its resulting
+// Observable. Subscriptions are forwarded as is. The result is returned
+// unchanged when there is no description, or when it is nil.
+func describePipe[T any](description *pipeDescription, result Observable[T]) Observable[T] {
+	if description == nil || result == nil {
+		return result
+	}
+
+	return &pipeObservable[T]{
+		Observable:  result,
+		description: description,
+	}
+}
+
+type pipeObservable[T any] struct {
+	Observable[T]
+	description *pipeDescription
+}
+
+func (o *pipeObservable[T]) describeName() string {
+	return o.String()
+}
+
+// String implements Describer.
+func (o *pipeObservable[T]) String() string {
+	names := make([]string, 0, len(o.description.operators)+1)
+	names = append(names, describeName(o.description.source))
+
+	for _, operator := range o.description.operators {
+		names = append(names, describeName(operator))
+	}
+
+	return fmt.Sprintf("ro.Pipe[%s](%s)", stageTypeName[T](), strings.Join(names, " | "))
+}
+
+// DebugString implements Describer.
+func (o *pipeObservable[T]) DebugString() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "ro.Pipe[%s]", stageTypeName[T]())
+
+	source := describeName(o.description.source)
+	if describer, ok := o.description.source.(Describer); ok {
+		source = describer.DebugString()
+	}
+
+	fmt.Fprintf(&b, "\n  source: %s", indentDescription(source))
+
+	for i, operator := range o.description.operators {
+		fmt.Fprintf(&b, "\n  stage %d: %s", i+1, describeName(operator))
+
+		if typ := reflect.TypeOf(operator); typ != nil && typ.Kind() == reflect.Func && typ.NumOut() == 1 {
+			fmt.Fprintf(&b, " -> %s", typ.Out(0))
+		}
+	}
+
+	return b.String()
+}
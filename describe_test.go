@@ -0,0 +1,142 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ro
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeObservable(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal("ro.Observable[int](ro.Of, mode=unsafe)", fmt.Sprint(Just(1, 2, 3)))
+	is.Equal("ro.Observable[int](ro.Of, mode=unsafe)", Just(1, 2, 3).(Describer).DebugString())
+	is.Equal("safe", ConcurrencyModeSafe.String())
+	is.Equal("eventually-safe", ConcurrencyModeEventuallySafe.String())
+	is.Equal("ConcurrencyMode(42)", ConcurrencyMode(42).String())
+	is.Equal("drop", BackpressureDrop.String())
+}
+
+//nolint:paralleltest
+func TestDescribePipe(t *testing.T) {
+	// t.Parallel()
+	is := assert.New(t)
+
+	PipeDescriptions = true
+	defer func() { PipeDescriptions = false }()
+
+	pipeline := Pipe2(
+		Just(1, 2, 3),
+		Map(strconv.Itoa),
+		Filter(func(s string) bool { return s != "" }),
+	)
+
+	is.Equal("ro.Pipe[string](ro.Of | ro.Map | ro.Filter)", fmt.Sprint(pipeline))
+	is.Equal(
+		"ro.Pipe[string]\n  source: ro.Observable[int](ro.Of, mode=unsafe)\n  stage 1: ro.Map -> ro.Observable[string]\n  stage 2: ro.Filter -> ro.Observable[string]",
+		pipeline.(Describer).DebugString(),
+	)
+
+	nested := PipeSame(pipeline, Take[string](1))
+	is.Equal("ro.Pipe[string](ro.Pipe[string](ro.Of | ro.Map | ro.Filter) | ro.Take)", fmt.Sprint(nested))
+	is.Equal(
+		"ro.Pipe[string]\n  source: ro.Pipe[string]\n    source: ro.Observable[int](ro.Of, mode=unsafe)\n    stage 1: ro.Map -> ro.Observable[string]\n    stage 2: ro.Filter -> ro.Observable[string]\n  stage 1: ro.Take -> ro.Observable[string]",
+		nested.(Describer).DebugString(),
+	)
+
+	values, err := Collect(nested)
+	is.NoError(err)
+	is.Equal([]string{"1"}, values)
+
+	untyped := Pipe[int, string](Just(1), Map(strconv.Itoa))
+	is.Equal("ro.Pipe[string](ro.Of | ro.Map)", fmt.Sprint(untyped))
+}
+
+//nolint:paralleltest
+func TestDescribePipeWithStageErrors(t *testing.T) {
+	// t.Parallel()
+	is := assert.New(t)
+
+	PipeStageErrors = true
+	PipeDescriptions = true
+	defer func() {
+		PipeStageErrors = false
+		PipeDescriptions = false
+	}()
+
+	// Operators are described before being wrapped.
+	pipeline := Pipe1(Just(1), Map(strconv.Itoa))
+	is.Equal("ro.Pipe[string](ro.Of | ro.Map)", fmt.Sprint(pipeline))
+}
+
+//nolint:paralleltest
+func TestDescribePipeUnchanged(t *testing.T) {
+	// t.Parallel()
+	is := assert.New(t)
+
+	// Disabled by default: the result of the last operator is returned as is.
+	source := Just(1)
+	identity := func(o Observable[int]) Observable[int] { return o }
+	is.Same(source, Pipe1(source, identity))
+	is.Same(source, Pipe[int, int](source, identity))
+	is.Same(source, PipeSame(source, identity))
+	is.Zero(testing.AllocsPerRun(100, func() { _ = Pipe2(source, identity, identity) }))
+
+	PipeDescriptions = true
+	defer func() { PipeDescriptions = false }()
+
+	// A nil result is not wrapped.
+	null := func(o Observable[int]) Observable[int] { return nil }
+	is.Nil(Pipe[int, int](source, null))
+	is.Nil(Pipe1(source, null))
+}
+
+func TestDescribeSubscription(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	subscription := NewSubscription(nil)
+	subscription.Add(func() {})
+	is.Equal("ro.Subscription(state=active, teardowns=1)", fmt.Sprint(subscription))
+	is.Contains(subscription.(Describer).DebugString(), "\n  teardown 1: ro.TestDescribeSubscription")
+
+	subscription.(CauseSubscription).UnsubscribeWithCause(errors.New("stopped"))
+	is.Equal("ro.Subscription(state=closed, cause=stopped)", fmt.Sprint(subscription))
+
+	closed := NewSubscription(nil)
+	closed.Unsubscribe()
+	is.Equal("ro.Subscription(state=closed)", fmt.Sprint(closed))
+}
+
+func TestDescribeSubscriber(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	subscriber := NewSubscriber(NoopObserver[int]())
+	is.Equal("ro.Subscriber[int](mode=safe, backpressure=block, state=active)", fmt.Sprint(subscriber))
+	is.Equal(
+		"ro.Subscriber[int](mode=safe, backpressure=block, state=active)\n  destination: ro.observerImpl[int]\n  subscription: ro.Subscription(state=active, teardowns=0)",
+		subscriber.(Describer).DebugString(),
+	)
+
+	subscriber.Error(assert.AnError)
+	is.Equal("ro.Subscriber[int](mode=safe, backpressure=block, state=errored)", fmt.Sprint(subscriber))
+}
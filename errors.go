@@ -45,14 +45,36 @@ func recoverUnhandledError(cb func()) {
 	)
 }
 
+// The following errors classify the failures of a pipeline, so that they can
+// be checked with errors.Is, whatever the operator that emitted them. For
+// instance, errors.Is(err, ErrEmpty) is true for ErrFirstEmpty, and
+// errors.Is(err, ErrTimeout) is true for the errors emitted by Timeout. Use
+// errors.As with the matching error type (*EmptyError, *TimeoutError...) to
+// read the details of the failure.
+var (
+	ErrEmpty          = errors.New("ro: empty")
+	ErrMoreThanOne    = errors.New("ro: more than one value")
+	ErrTimeout        = errors.New("ro: timeout")
+	ErrBufferOverflow = errors.New("ro: buffer overflow")
+	ErrRateLimited    = errors.New("ro: rate limited")
+	ErrCircuitOpen    = errors.New("ro: circuit open")
+	ErrDropped        = errors.New("ro: dropped")
+)
+
 var (
 	//nolint:revive
+	ErrIntervalWrongInterval                        = errors.New("ro.Interval: interval must be greater than 0")
+	ErrIntervalWrongInitialDelay                    = errors.New("ro.WithIntervalInitialDelay: delay must be greater or equal to 0")
+	ErrIntervalWrongJitter                          = errors.New("ro.WithIntervalJitter: jitter must be greater or equal to 0")
+	ErrIntervalWrongAlignment                       = errors.New("ro.WithIntervalAlignment: alignment must be greater than 0")
 	ErrRangeWithStepWrongStep                       = errors.New("ro.RangeWithStep: step must be greater than 0")
 	ErrRangeWithStepAndIntervalWrongStep            = errors.New("ro.RangeWithStepAndInterval: step must be greater than 0")
-	ErrFirstEmpty                                   = errors.New("ro.First: empty")
-	ErrLastEmpty                                    = errors.New("ro.Last: empty")
-	ErrHeadEmpty                                    = errors.New("ro.First: empty")
-	ErrTailEmpty                                    = errors.New("ro.Last: empty")
+	ErrFirstEmpty                                   = newEmptyError("ro.First")
+	ErrLastEmpty                                    = newEmptyError("ro.Last")
+	ErrHeadEmpty                                    = newEmptyError("ro.First")
+	ErrTailEmpty                                    = newEmptyError("ro.Last")
+	ErrSingleEmpty                                  = newEmptyError("ro.Single")
+	ErrSingleMoreThanOne                            = newMoreThanOneError("ro.Single")
 	ErrTakeWrongCount                               = errors.New("ro.Take: count must be greater or equal to 0")
 	ErrTakeLastWrongCount                           = errors.New("ro.TakeLast: count must be greater than 0")
 	ErrSkipWrongCount                               = errors.New("ro.Skip: count must be greater or equal to 0")
@@ -60,21 +82,69 @@ var (
 	ErrElementAtWrongNth                            = errors.New("ro.ElementAt: nth must be greater or equal to 0")
 	ErrElementAtNotFound                            = errors.New("ro.ElementAt: nth element not found")
 	ErrElementAtOrDefaultWrongNth                   = errors.New("ro.ElementAtOrDefault: nth must be greater or equal to 0")
+	ErrSingleValueEmpty                             = newEmptyError("ro.SingleValue")
+	ErrSingleValueMultiple                          = newMoreThanOneError("ro.SingleValue")
 	ErrRepeatWrongCount                             = errors.New("ro.Repeat: count must be greater or equal to 0")
 	ErrRepeatWithIntervalWrongCount                 = errors.New("ro.RepeatWithInterval: count must be greater or equal to 0")
 	ErrRepeatWithWrongCount                         = errors.New("ro.RepeatWith: count must be greater or equal to 0")
+	ErrPaceWrongInterval                            = errors.New("ro.Pace: interval must be greater than 0")
+	ErrBulkheadWrongMaxConcurrent                   = errors.New("ro.Bulkhead: max concurrent must be greater than 0")
+	ErrBulkheadWrongMaxQueued                       = errors.New("ro.Bulkhead: max queued must be greater or equal to 0")
+	ErrBulkheadFull                                 = errors.New("ro.Bulkhead: too many concurrent subscriptions")
+	ErrShedLoadWrongTargetLatency                   = errors.New("ro.ShedLoad: target latency must be greater than 0")
+	ErrCircuitBreakerMissingAction                  = errors.New("ro.CircuitBreaker: missing action")
+	ErrCircuitBreakerWrongFailureThreshold          = errors.New("ro.CircuitBreaker: failure threshold must be between 0 and 1")
+	ErrCircuitBreakerWrongWindowSize                = errors.New("ro.CircuitBreaker: window size must be greater or equal to 0")
+	ErrCircuitBreakerWrongMinimumRequests           = errors.New("ro.CircuitBreaker: minimum requests must be between 0 and the window size")
+	ErrCircuitBreakerWrongOpenTimeout               = errors.New("ro.CircuitBreaker: open timeout must be greater or equal to 0")
+	ErrCircuitBreakerWrongHalfOpenProbes            = errors.New("ro.CircuitBreaker: half-open probes must be greater or equal to 0")
+	ErrCircuitBreakerOpen                           = newCircuitOpenError("ro.CircuitBreaker")
+	ErrBufferWrongMaxSize                           = errors.New("ro.WithBufferMaxSize: max size must be greater than 0")
 	ErrBufferWithCountWrongSize                     = errors.New("ro.BufferWithCount: size must be greater than 0")
 	ErrBufferWithTimeWrongDuration                  = errors.New("ro.BufferWithTime: duration must be greater than 0")
 	ErrBufferWithTimeOrCountWrongSize               = errors.New("ro.BufferWithTimeOrCount: size must be greater than 0")
 	ErrBufferWithTimeOrCountWrongDuration           = errors.New("ro.BufferWithTimeOrCount: duration must be greater than 0")
+	ErrBufferWithEventTimeWrongSize                 = errors.New("ro.BufferWithEventTime: size must be greater than 0")
+	ErrBufferWithEventTimeWrongOutOfOrderness       = errors.New("ro.BufferWithEventTime: max out-of-orderness must be greater or equal to 0")
+	ErrBufferWithEventTimeWrongAllowedLateness      = errors.New("ro.BufferWithEventTime: allowed lateness must be greater or equal to 0")
+	ErrPrioritizeByWithCountWrongSize               = errors.New("ro.PrioritizeByWithCount: size must be greater than 0")
+	ErrPrioritizeByWithTimeWrongDuration            = errors.New("ro.PrioritizeByWithTime: duration must be greater than 0")
+	ErrPrioritizeByWithTimeOrCountWrongSize         = errors.New("ro.PrioritizeByWithTimeOrCount: size must be greater than 0")
+	ErrPrioritizeByWithTimeOrCountWrongDuration     = errors.New("ro.PrioritizeByWithTimeOrCount: duration must be greater than 0")
 	ErrClampLowerLessThanUpper                      = errors.New("ro.Clamp: lower must be less than or equal to upper")
+	ErrPercentileWrongPercentile                    = errors.New("ro.Percentile: percentile must be between 0 and 100")
+	ErrQuantilesWrongQuantile                       = errors.New("ro.Quantiles: quantile must be between 0 and 1")
+	ErrPercentileApproxWrongPercentile              = errors.New("ro.PercentileApprox: percentile must be between 0 and 100")
+	ErrMovingAverageWrongWindow                     = errors.New("ro.MovingAverage: window must be greater than 0")
+	ErrMovingAverageTimeWrongDuration               = errors.New("ro.MovingAverageTime: duration must be greater than 0")
+	ErrEWMAWrongAlpha                               = errors.New("ro.EWMA: alpha must be greater than 0 and less than or equal to 1")
+	ErrHistogramWrongBuckets                        = errors.New("ro.Histogram: buckets must be sorted in strictly increasing order")
+	ErrHistogramWrongInterval                       = errors.New("ro.Histogram: interval must be greater than 0")
+	ErrTopKWrongCount                               = errors.New("ro.TopK: k must be greater or equal to 0")
+	ErrBottomKWrongCount                            = errors.New("ro.BottomK: k must be greater or equal to 0")
+	ErrAggregateWrongContextPolicy                  = errors.New("ro.WithContextPolicy: unknown context policy")
+	ErrFrequencyWrongMaxKeys                        = errors.New("ro.WithFrequencyMaxKeys: max keys must be greater than 0")
+	ErrSumBigFloatNaN                               = errors.New("ro.SumBigFloat: the sum is NaN")
+	ErrAverageBigNaN                                = errors.New("ro.AverageBig: the sum is NaN")
+	ErrCollectChunksWrongSize                       = errors.New("ro.CollectChunks: chunk size must be greater than 0")
+	ErrCollectNWrongCount                           = errors.New("ro.CollectN: n must be greater or equal to 0")
+	ErrBaseObservableNotInitialized                 = errors.New("ro.BaseObservable: must be created with ro.NewBaseObservable")
 	ErrToChannelWrongSize                           = errors.New("ro.ErrToChannelWrongSize: size must be greater or equal to 0")
 	ErrPoolWrongSize                                = errors.New("ro.Pool: size must be greater than 0")
+	ErrMapParallelWrongWorkers                      = errors.New("ro.MapParallel: workers must be greater than 0")
+	ErrFlattenWrongConcurrency                      = errors.New("ro.WithFlattenConcurrency: concurrency must be greater than 0")
+	ErrKeyedSequentialWrongWorkers                  = errors.New("ro.KeyedSequential: workers must be greater than 0")
 	ErrSubscribeOnWrongBufferSize                   = errors.New("ro.SubscribeOn: buffer size must be greater than 0")
 	ErrObserveOnWrongBufferSize                     = errors.New("ro.ObserveOn: buffer size must be greater than 0")
 	ErrDetachOnWrongMode                            = errors.New("ro.detachOn: unexpected detach mode")
 	ErrUnicastSubjectConcurrent                     = errors.New("ro.UnicastSubject: a single subscriber accepted")
+	ErrDurableSubjectConcurrent                     = errors.New("ro.DurableSubject: a single subscriber accepted")
 	ErrConnectableObservableMissingConnectorFactory = errors.New("ro.ConnectableObservable: missing connector factory")
+	ErrSubjectSnapshotUnsupported                   = errors.New("ro.SnapshotSubject: subject does not hold values")
+	ErrDeadlinePerItemWrongDuration                 = errors.New("ro.DeadlinePerItem: duration must be greater than 0")
+	ErrAckRedeliveryWrongCount                      = errors.New("ro.WithAckRedelivery: max redeliveries must be greater or equal to 0")
+	ErrAckNilCallback                               = errors.New("ro.WithAck: onAck and onNack must not be nil")
+	ErrSubjectRestoreClosed                         = errors.New("ro.RestoreSubject: subject is closed")
 )
 
 func newUnsubscriptionError(err error) error {
@@ -136,18 +206,193 @@ func (e *observerError) Unwrap() error {
 	return e.err
 }
 
-func newTimeoutError(duration time.Duration) error {
-	return &timeoutError{
-		duration: duration,
+// EmptyError is emitted by the operators expecting at least one item, such as
+// First or Last, when the source completes without emitting. It matches
+// ErrEmpty.
+type EmptyError struct {
+	// Operator is the name of the operator, such as "ro.First".
+	Operator string
+}
+
+func newEmptyError(operator string) error {
+	return &EmptyError{
+		Operator: operator,
+	}
+}
+
+func (e *EmptyError) Error() string {
+	return e.Operator + ": empty"
+}
+
+func (e *EmptyError) Is(target error) bool {
+	return target == ErrEmpty
+}
+
+// MoreThanOneError is emitted by the operators expecting a single item, such
+// as Single, when the source emits more than one. It matches ErrMoreThanOne.
+type MoreThanOneError struct {
+	// Operator is the name of the operator, such as "ro.Single".
+	Operator string
+}
+
+func newMoreThanOneError(operator string) error {
+	return &MoreThanOneError{
+		Operator: operator,
+	}
+}
+
+func (e *MoreThanOneError) Error() string {
+	return e.Operator + ": more than one value"
+}
+
+func (e *MoreThanOneError) Is(target error) bool {
+	return target == ErrMoreThanOne
+}
+
+// TimeoutError is emitted by Timeout when no item is received in time, and by
+// DeadlinePerItem when an item is not processed in time. It matches
+// ErrTimeout.
+type TimeoutError struct {
+	// Operator is the name of the operator, such as "ro.Timeout".
+	Operator string
+	// Duration is the timeout that expired.
+	Duration time.Duration
+}
+
+func newTimeoutError(operator string, duration time.Duration) error {
+	return &TimeoutError{
+		Operator: operator,
+		Duration: duration,
+	}
+}
+
+func (e *TimeoutError) Error() string {
+	return e.Operator + ": timeout after " + e.Duration.String()
+}
+
+func (e *TimeoutError) Is(target error) bool {
+	return target == ErrTimeout
+}
+
+// Timeout reports true, in the manner of net.Error.
+func (e *TimeoutError) Timeout() bool {
+	return true
+}
+
+// BufferOverflowError reports an item rejected by a bounded buffer, once
+// full. It matches ErrBufferOverflow, as well as the sentinel of the operator,
+// if any, such as ErrBulkheadFull.
+type BufferOverflowError struct {
+	// Operator is the name of the operator owning the buffer.
+	Operator string
+	// Capacity is the size of the buffer.
+	Capacity int
+
+	kind error // sentinel of the operator, if any
+}
+
+func newBufferOverflowError(operator string, capacity int, kind error) error {
+	return &BufferOverflowError{
+		Operator: operator,
+		Capacity: capacity,
+		kind:     kind,
+	}
+}
+
+func (e *BufferOverflowError) Error() string {
+	return fmt.Sprintf("%s: buffer overflow (capacity %d)", e.Operator, e.Capacity)
+}
+
+func (e *BufferOverflowError) Is(target error) bool {
+	return target == ErrBufferOverflow || (e.kind != nil && target == e.kind)
+}
+
+// RateLimitError reports an item rejected by a rate limiter. It matches
+// ErrRateLimited.
+type RateLimitError struct {
+	// Operator is the name of the rate limiter.
+	Operator string
+	// RetryAfter is the delay before the limiter accepts a new item, or 0
+	// when unknown.
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	if e.RetryAfter > 0 {
+		return e.Operator + ": rate limited, retry after " + e.RetryAfter.String()
+	}
+
+	return e.Operator + ": rate limited"
+}
+
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// DroppedError reports an item deliberately dropped by an operator, such as
+// ShedLoad or Pausable in drop mode. It matches ErrDropped, and unwraps to the
+// cause of the drop, if any.
+//
+// These operators pass it to OnDroppedNotification. It implements fmt.Stringer
+// like the dropped notifications, so that hooks can match it with errors.As.
+type DroppedError struct {
+	// Operator is the name of the operator that dropped the item.
+	Operator string
+	// Value is the dropped item.
+	Value any
+	// Err is the cause of the drop, such as a *TimeoutError, or nil.
+	Err error
+}
+
+func newDroppedError(operator string, value any, cause error) *DroppedError {
+	return &DroppedError{
+		Operator: operator,
+		Value:    value,
+		Err:      cause,
+	}
+}
+
+func (e *DroppedError) Error() string {
+	msg := fmt.Sprintf("%s: dropped %+v", e.Operator, e.Value)
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+
+	return msg
+}
+
+// String formats the dropped item like a Next notification.
+func (e *DroppedError) String() string {
+	return fmt.Sprintf("Next(%+v)", e.Value)
+}
+
+func (e *DroppedError) Is(target error) bool {
+	return target == ErrDropped
+}
+
+func (e *DroppedError) Unwrap() error {
+	return e.Err
+}
+
+// CircuitOpenError reports an item rejected by an open circuit breaker. It
+// matches ErrCircuitOpen. See ErrCircuitBreakerOpen.
+type CircuitOpenError struct {
+	// Operator is the name of the circuit breaker.
+	Operator string
+}
+
+func newCircuitOpenError(operator string) error {
+	return &CircuitOpenError{
+		Operator: operator,
 	}
 }
 
-type timeoutError struct {
-	duration time.Duration
+func (e *CircuitOpenError) Error() string {
+	return e.Operator + ": circuit breaker is open"
 }
 
-func (e *timeoutError) Error() string {
-	return "ro.Timeout: timeout after " + e.duration.String()
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
 }
 
 func newCastError[T, U any]() error {
@@ -181,3 +426,62 @@ func (e *pipeError) Error() string {
 func (e *pipeError) Unwrap() error {
 	return e.err
 }
+
+// StageError is the error emitted by a pipeline when PipeStageErrors is
+// enabled. It tells which stage of a PipeX() or PipeOpX() call emitted the
+// error first. Use errors.As to retrieve it, and errors.Is or Unwrap to reach
+// the original error.
+type StageError struct {
+	// Index is the position of the operator in the pipe, starting at 1.
+	// It is 0 when the error was emitted by the source.
+	Index int
+	// Operator is the name of the function that declared the operator, such
+	// as "ro.Map". Operators built on top of another one report the latter:
+	// ro.MapErr is reported as "ro.MapErrIWithContext". It is empty for the
+	// source.
+	Operator string
+	// Type is the type of the values emitted by the stage.
+	Type string
+	// Pipeline is the pipeline name attached to the context of the error
+	// notification, if any. See WithPipelineName.
+	Pipeline string
+	// Tags are the tags attached to the context of the error notification,
+	// if any. See WithStageTag.
+	Tags map[string]string
+	// Err is the original error.
+	Err error
+}
+
+func newStageError(ctx context.Context, index int, operator string, typ string, err error) error {
+	var stageErr *StageError
+	if errors.As(err, &stageErr) {
+		// The error is attributed to the stage that emitted it first.
+		return err
+	}
+
+	return &StageError{
+		Index:    index,
+		Operator: operator,
+		Type:     typ,
+		Pipeline: PipelineName(ctx),
+		Tags:     StageTags(ctx),
+		Err:      err,
+	}
+}
+
+func (e *StageError) Error() string {
+	pipe := "ro.Pipe"
+	if e.Pipeline != "" {
+		pipe = fmt.Sprintf("ro.Pipe(%s)", e.Pipeline)
+	}
+
+	if e.Index == 0 {
+		return fmt.Sprintf("%s: source (%s): %s", pipe, e.Type, e.Err.Error())
+	}
+
+	return fmt.Sprintf("%s: stage %d %s (%s): %s", pipe, e.Index, e.Operator, e.Type, e.Err.Error())
+}
+
+func (e *StageError) Unwrap() error {
+	return e.Err
+}
@@ -18,6 +18,8 @@ import (
 	"errors"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestRecoverValueToError(t *testing.T) {
@@ -160,7 +162,7 @@ func TestErrorTypes(t *testing.T) {
 	t.Run("timeout error", func(t *testing.T) {
 		t.Parallel()
 		duration := 5 * time.Second
-		err := newTimeoutError(duration)
+		err := newTimeoutError("ro.Timeout", duration)
 
 		expected := "ro.Timeout: timeout after 5s"
 		if err.Error() != expected {
@@ -241,3 +243,66 @@ func TestErrorUnwrap(t *testing.T) {
 		}
 	})
 }
+
+func TestErrorCatalog(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var emptyErr *EmptyError
+	is.ErrorIs(ErrFirstEmpty, ErrEmpty)
+	is.ErrorAs(ErrFirstEmpty, &emptyErr)
+	is.Equal("ro.First", emptyErr.Operator)
+	is.EqualError(ErrSingleValueEmpty, "ro.SingleValue: empty")
+
+	// The sentinels are typed error: the structured types are only exposed
+	// through errors.As.
+	for _, sentinel := range []*error{&ErrFirstEmpty, &ErrLastEmpty, &ErrHeadEmpty, &ErrTailEmpty, &ErrSingleEmpty, &ErrSingleValueEmpty, &ErrSingleMoreThanOne, &ErrSingleValueMultiple, &ErrCircuitBreakerOpen} {
+		is.Error(*sentinel)
+	}
+
+	var timeoutErr *TimeoutError
+	err := newTimeoutError("ro.Timeout", 5*time.Second)
+	is.ErrorIs(err, ErrTimeout)
+	is.ErrorAs(err, &timeoutErr)
+	is.Equal("ro.Timeout", timeoutErr.Operator)
+	is.Equal(5*time.Second, timeoutErr.Duration)
+	is.True(timeoutErr.Timeout())
+	is.NotErrorIs(err, ErrEmpty)
+
+	var overflowErr *BufferOverflowError
+	err = &BufferOverflowError{Operator: "ro.Buffer", Capacity: 10}
+	is.ErrorIs(err, ErrBufferOverflow)
+	is.ErrorAs(err, &overflowErr)
+	is.EqualError(err, "ro.Buffer: buffer overflow (capacity 10)")
+
+	var rateLimitErr *RateLimitError
+	err = &RateLimitError{Operator: "ro.RateLimit", RetryAfter: time.Second}
+	is.ErrorIs(err, ErrRateLimited)
+	is.ErrorAs(err, &rateLimitErr)
+	is.EqualError(err, "ro.RateLimit: rate limited, retry after 1s")
+	is.EqualError(&RateLimitError{Operator: "ro.RateLimit"}, "ro.RateLimit: rate limited")
+
+	var moreThanOneErr *MoreThanOneError
+	is.ErrorIs(ErrSingleMoreThanOne, ErrMoreThanOne)
+	is.ErrorAs(ErrSingleValueMultiple, &moreThanOneErr)
+	is.Equal("ro.SingleValue", moreThanOneErr.Operator)
+	is.EqualError(ErrSingleMoreThanOne, "ro.Single: more than one value")
+
+	var droppedErr *DroppedError
+	err = newDroppedError("ro.DeadlinePerItem", 42, newTimeoutError("ro.DeadlinePerItem", time.Second))
+	is.ErrorIs(err, ErrDropped)
+	is.ErrorIs(err, ErrTimeout)
+	is.ErrorAs(err, &droppedErr)
+	is.Equal(42, droppedErr.Value)
+	is.EqualError(err, "ro.DeadlinePerItem: dropped 42: ro.DeadlinePerItem: timeout after 1s")
+	is.Equal("Next(42)", newDroppedError("ro.ShedLoad", 42, nil).String())
+	is.EqualError(newDroppedError("ro.ShedLoad", 42, nil), "ro.ShedLoad: dropped 42")
+
+	var circuitErr *CircuitOpenError
+	is.ErrorIs(ErrCircuitBreakerOpen, ErrCircuitOpen)
+	is.ErrorAs(ErrCircuitBreakerOpen, &circuitErr)
+	is.EqualError(ErrCircuitBreakerOpen, "ro.CircuitBreaker: circuit breaker is open")
+
+	// Wrapped errors are classified as well.
+	is.ErrorIs(&StageError{Index: 1, Operator: "ro.Timeout", Err: newTimeoutError("ro.Timeout", time.Second)}, ErrTimeout)
+}
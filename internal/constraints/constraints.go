@@ -22,3 +22,8 @@ import (
 type Numeric interface {
 	constraints.Integer | constraints.Float
 }
+
+// Integer is a constraint that matches any integer type.
+type Integer interface {
+	constraints.Integer
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xring
+
+// Unbounded is the capacity of a Ring that never evicts values.
+const Unbounded = -1
+
+// MaxPreallocatedSize is the largest backing array allocated upfront. Rings
+// with a larger capacity start at this size and double until they reach their
+// capacity, so that NewRing(math.MaxInt) does not reserve gigabytes of memory.
+const MaxPreallocatedSize = 1024
+
+// Ring is a count-bounded FIFO ring buffer. Once full, pushing a value evicts
+// the oldest one in place: a bounded ring does not allocate in steady state.
+//
+// Ring is not safe for concurrent use.
+type Ring[T any] interface {
+	// Push appends a value to the back of the ring. When the ring is full, the
+	// oldest value is evicted and returned with ok=true.
+	Push(value T) (evicted T, ok bool)
+	// Front returns the oldest value. It panics if the ring is empty.
+	Front() T
+	// PopFront removes and returns the oldest value. It panics if the ring is empty.
+	PopFront() T
+	// At returns the i-th value, 0 being the oldest. It panics if i is out of range.
+	At(i int) T
+	// Len returns the number of values in the ring.
+	Len() int
+	// Cap returns the maximum number of values in the ring, or Unbounded.
+	Cap() int
+}
+
+var _ Ring[int] = (*ringImpl[int])(nil)
+
+// NewRing creates a new empty ring holding at most capacity values. A negative
+// capacity creates an unbounded ring. The backing array is preallocated up to
+// MaxPreallocatedSize.
+func NewRing[T any](capacity int) Ring[T] {
+	if capacity < 0 {
+		capacity = Unbounded
+	}
+
+	prealloc := capacity
+	if prealloc < 0 || prealloc > MaxPreallocatedSize {
+		prealloc = 0
+		if capacity > MaxPreallocatedSize {
+			prealloc = MaxPreallocatedSize
+		}
+	}
+
+	return &ringImpl[T]{
+		items:    make([]T, prealloc),
+		capacity: capacity,
+	}
+}
+
+type ringImpl[T any] struct {
+	items    []T
+	head     int
+	count    int
+	capacity int
+}
+
+// Push appends a value to the back of the ring.
+func (r *ringImpl[T]) Push(value T) (evicted T, ok bool) {
+	if r.capacity == 0 {
+		return value, true
+	}
+
+	if r.count == r.capacity {
+		evicted = r.PopFront()
+		ok = true
+	} else if r.count == len(r.items) {
+		r.grow()
+	}
+
+	r.items[(r.head+r.count)%len(r.items)] = value
+	r.count++
+
+	return evicted, ok
+}
+
+// Front returns the oldest value.
+func (r *ringImpl[T]) Front() T {
+	if r.count == 0 {
+		panic("xring: Front on empty ring")
+	}
+
+	return r.items[r.head]
+}
+
+// PopFront removes and returns the oldest value.
+func (r *ringImpl[T]) PopFront() T {
+	if r.count == 0 {
+		panic("xring: PopFront on empty ring")
+	}
+
+	value := r.items[r.head]
+
+	var zero T
+	r.items[r.head] = zero // do not pin the evicted value in memory
+
+	r.head++
+	if r.head == len(r.items) {
+		r.head = 0
+	}
+
+	r.count--
+
+	return value
+}
+
+// At returns the i-th value, 0 being the oldest.
+func (r *ringImpl[T]) At(i int) T {
+	if i < 0 || i >= r.count {
+		panic("xring: At out of range")
+	}
+
+	return r.items[(r.head+i)%len(r.items)]
+}
+
+// Len returns the number of values in the ring.
+func (r *ringImpl[T]) Len() int {
+	return r.count
+}
+
+// Cap returns the maximum number of values in the ring.
+func (r *ringImpl[T]) Cap() int {
+	return r.capacity
+}
+
+// grow doubles the backing array, without exceeding the capacity of a bounded
+// ring, and re-linearizes the live region so that it starts at index 0.
+func (r *ringImpl[T]) grow() {
+	newCap := len(r.items) * 2
+	if newCap == 0 {
+		newCap = 4
+	}
+
+	if r.capacity != Unbounded && newCap > r.capacity {
+		newCap = r.capacity
+	}
+
+	buf := make([]T, newCap)
+
+	n := copy(buf, r.items[r.head:])
+	copy(buf[n:], r.items[:r.head])
+
+	r.items = buf
+	r.head = 0
+}
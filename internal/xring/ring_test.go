@@ -0,0 +1,132 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xring
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ringToSlice[T any](r Ring[T]) []T {
+	out := make([]T, 0, r.Len())
+	for i := 0; i < r.Len(); i++ {
+		out = append(out, r.At(i))
+	}
+
+	return out
+}
+
+func TestRingBounded(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r := NewRing[int](3)
+	is.Equal(3, r.Cap())
+	is.Equal(0, r.Len())
+
+	_, ok := r.Push(1)
+	is.False(ok)
+	r.Push(2)
+	r.Push(3)
+	is.Equal([]int{1, 2, 3}, ringToSlice(r))
+
+	evicted, ok := r.Push(4)
+	is.True(ok)
+	is.Equal(1, evicted)
+	is.Equal([]int{2, 3, 4}, ringToSlice(r))
+	is.Equal(2, r.Front())
+
+	is.Equal(2, r.PopFront())
+	is.Equal([]int{3, 4}, ringToSlice(r))
+
+	r.Push(5)
+	r.Push(6)
+	is.Equal([]int{4, 5, 6}, ringToSlice(r))
+}
+
+func TestRingZeroCapacity(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r := NewRing[int](0)
+
+	evicted, ok := r.Push(42)
+	is.True(ok)
+	is.Equal(42, evicted)
+	is.Equal(0, r.Len())
+}
+
+func TestRingUnbounded(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r := NewRing[int](Unbounded)
+	is.Equal(Unbounded, r.Cap())
+
+	for i := 0; i < 100; i++ {
+		_, ok := r.Push(i)
+		is.False(ok)
+	}
+
+	is.Equal(100, r.Len())
+	is.Equal(0, r.Front())
+	is.Equal(99, r.At(99))
+}
+
+func TestRingGrowsUpToCapacity(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r := NewRing[int](math.MaxInt)
+	impl, ok := r.(*ringImpl[int])
+	is.True(ok)
+	is.Len(impl.items, MaxPreallocatedSize)
+
+	r = NewRing[int](MaxPreallocatedSize + 10)
+	for i := 0; i < MaxPreallocatedSize+20; i++ {
+		r.Push(i)
+	}
+
+	impl, ok = r.(*ringImpl[int])
+	is.True(ok)
+	is.Len(impl.items, MaxPreallocatedSize+10)
+	is.Equal(MaxPreallocatedSize+10, r.Len())
+	is.Equal(10, r.Front())
+}
+
+func TestRingPanics(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r := NewRing[int](2)
+	is.Panics(func() { r.Front() })
+	is.Panics(func() { r.PopFront() })
+	is.Panics(func() { r.At(0) })
+}
+
+func TestRingSteadyStateDoesNotAllocate(t *testing.T) {
+	// Not parallel: testing.AllocsPerRun is unreliable when other tests run
+	// concurrently, since its result depends on whole-program GC behavior.
+	is := assert.New(t)
+
+	r := NewRing[int](8)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		r.Push(1)
+	})
+	is.Zero(allocs)
+}
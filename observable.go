@@ -16,6 +16,7 @@ package ro
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	"github.com/samber/lo"
@@ -31,6 +32,18 @@ const (
 	BackpressureDrop
 )
 
+// String returns the string representation of a Backpressure.
+func (b Backpressure) String() string {
+	switch b {
+	case BackpressureBlock:
+		return "block"
+	case BackpressureDrop:
+		return "drop"
+	}
+
+	return fmt.Sprintf("Backpressure(%d)", b)
+}
+
 // ConcurrencyMode is a type that represents the concurrency mode to use.
 type ConcurrencyMode int8
 
@@ -43,6 +56,20 @@ const (
 	ConcurrencyModeEventuallySafe
 )
 
+// String returns the string representation of a ConcurrencyMode.
+func (m ConcurrencyMode) String() string {
+	switch m {
+	case ConcurrencyModeSafe:
+		return "safe"
+	case ConcurrencyModeUnsafe:
+		return "unsafe"
+	case ConcurrencyModeEventuallySafe:
+		return "eventually-safe"
+	}
+
+	return fmt.Sprintf("ConcurrencyMode(%d)", m)
+}
+
 // Observable is the producer of values. It is the source of values that are
 // emitted to Observers.
 // Observable is a representation of any set of values over any amount of time.
@@ -238,6 +265,33 @@ func NewEventuallySafeObservableWithContext[T any](subscribe func(ctx context.Co
 	return NewObservableWithConcurrencyMode(subscribe, ConcurrencyModeEventuallySafe)
 }
 
+// NewSingleProducerObservable creates a new Observable whose subscribe function
+// emits from a single goroutine. Subscribers skip locking, exactly like
+// NewUnsafeObservable.
+//
+// It is the zero-allocation configuration: for value types, a pipeline made of
+// a single-producer source, lock-free operators (Map, Filter, Take, Skip, Scan,
+// TakeWhile, SkipWhile, Tap...) and an unsafe Observer (NewUnsafeSubscriber)
+// does not allocate on the heap per item.
+//
+// This method is not safe for concurrent use.
+func NewSingleProducerObservable[T any](subscribe func(destination Observer[T]) Teardown) Observable[T] {
+	return NewSingleProducerObservableWithContext(func(ctx context.Context, destination Observer[T]) Teardown {
+		return subscribe(destination)
+	})
+}
+
+// NewSingleProducerObservableWithContext creates a new Observable whose subscribe
+// function emits from a single goroutine. Subscribers skip locking, exactly like
+// NewUnsafeObservableWithContext.
+//
+// See NewSingleProducerObservable for the zero-allocation guarantees.
+//
+// This method is not safe for concurrent use.
+func NewSingleProducerObservableWithContext[T any](subscribe func(ctx context.Context, destination Observer[T]) Teardown) Observable[T] {
+	return NewObservableWithConcurrencyMode(subscribe, ConcurrencyModeUnsafe)
+}
+
 // NewObservableWithConcurrencyMode creates a new Observable with the given concurrency mode.
 // The subscribe function is called when the Observable is subscribed to. The subscribe function is given an Observer,
 // to which it may emit any number of items, then may either complete or error, but not both. Upon completion or error, the Observable will not emit any more items.
@@ -264,6 +318,20 @@ type observableImpl[T any] struct {
 	subscribe func(ctx context.Context, destination Observer[T]) Teardown
 }
 
+func (s *observableImpl[T]) describeName() string {
+	return stageOperatorName(s.subscribe)
+}
+
+// String implements Describer: "ro.Observable[int](ro.FromSlice, mode=safe)".
+func (s *observableImpl[T]) String() string {
+	return fmt.Sprintf("ro.Observable[%s](%s, mode=%s)", stageTypeName[T](), s.describeName(), s.mode)
+}
+
+// DebugString implements Describer.
+func (s *observableImpl[T]) DebugString() string {
+	return s.String()
+}
+
 // Subscribe subscribes an Observer to the Observable. The Observer will begin
 // to receive items emitted by the Observable. The Observer may receive any
 // number of items (including zero items), then may either complete or error,
@@ -361,6 +429,63 @@ func CollectWithContext[T any](ctx context.Context, obs Observable[T]) ([]T, con
 	return values, lastCtx, err
 }
 
+var _ Observable[int] = (*BaseObservable[int])(nil)
+
+// BaseObservable is an embeddable helper for third-party Observables, such as
+// custom sources exposing their own methods. It implements the Observable
+// interface with the same contract handling as NewObservableWithContext: the
+// destination is wrapped into a Subscriber, a panic in the subscribe function
+// is converted into an error notification, and the Teardown is called on
+// completion, error or unsubscription.
+//
+// A BaseObservable must be created with NewBaseObservable. The zero value
+// panics on subscription.
+//
+//	type Ticker struct {
+//		ro.BaseObservable[time.Time]
+//		period time.Duration
+//	}
+//
+//	func NewTicker(period time.Duration) *Ticker {
+//		t := &Ticker{period: period}
+//		t.BaseObservable = ro.NewBaseObservable(t.subscribe)
+//		return t
+//	}
+type BaseObservable[T any] struct {
+	impl *observableImpl[T]
+}
+
+// NewBaseObservable creates a BaseObservable, safe for concurrent emissions.
+// See NewObservableWithContext for the subscribe function.
+func NewBaseObservable[T any](subscribe func(ctx context.Context, destination Observer[T]) Teardown) BaseObservable[T] {
+	return NewBaseObservableWithConcurrencyMode(subscribe, ConcurrencyModeSafe)
+}
+
+// NewBaseObservableWithConcurrencyMode creates a BaseObservable with the given
+// concurrency mode. See NewObservableWithConcurrencyMode.
+func NewBaseObservableWithConcurrencyMode[T any](subscribe func(ctx context.Context, destination Observer[T]) Teardown, mode ConcurrencyMode) BaseObservable[T] {
+	return BaseObservable[T]{
+		impl: &observableImpl[T]{
+			mode:      mode,
+			subscribe: subscribe,
+		},
+	}
+}
+
+// Subscribe implements Observable.
+func (o BaseObservable[T]) Subscribe(destination Observer[T]) Subscription {
+	return o.SubscribeWithContext(context.Background(), destination)
+}
+
+// SubscribeWithContext implements Observable.
+func (o BaseObservable[T]) SubscribeWithContext(ctx context.Context, destination Observer[T]) Subscription {
+	if o.impl == nil {
+		panic(ErrBaseObservableNotInitialized)
+	}
+
+	return o.impl.SubscribeWithContext(ctx, destination)
+}
+
 // ConnectableObservable is an Observable that can be connected and disconnected.
 // When connected, it will emit values to its observers.
 //
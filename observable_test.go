@@ -255,9 +255,9 @@ func TestObservable_panicTeardown(t *testing.T) {
 		},
 	)
 
-	is.PanicsWithError(
+	is.EqualError(
+		sub.(ErrorUnsubscribable).UnsubscribeWithError(),
 		newUnsubscriptionError(assert.AnError).Error(),
-		func() { sub.Unsubscribe() },
 	)
 }
 
@@ -679,6 +679,81 @@ func TestObservable_contextPropagation(t *testing.T) {
 	is.NoError(err)
 }
 
+func TestNewSingleProducerObservable(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	obs := NewSingleProducerObservable(func(destination Observer[int]) Teardown {
+		destination.Next(1)
+		destination.Next(2)
+		destination.Complete()
+		return nil
+	})
+
+	values, err := Collect(obs)
+	is.Equal([]int{1, 2}, values)
+	is.NoError(err)
+
+	obs = NewSingleProducerObservableWithContext(func(ctx context.Context, destination Observer[int]) Teardown {
+		destination.ErrorWithContext(ctx, assert.AnError)
+		return nil
+	})
+
+	values, err = Collect(obs)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestNewSingleProducerObservable_zeroAllocation(t *testing.T) {
+	// Not parallel: testing.AllocsPerRun is unreliable when other tests run
+	// concurrently, since its result depends on whole-program GC behavior.
+	is := assert.New(t)
+
+	operators := map[string]func(Observable[int]) Observable[int]{
+		"Map":            Map(func(v int) int { return v * 2 }),
+		"MapI":           MapI(func(v int, _ int64) int { return v * 2 }),
+		"MapWithContext": MapWithContext(func(ctx context.Context, v int) (context.Context, int) { return ctx, v * 2 }),
+		"Filter":         Filter(func(v int) bool { return v%2 == 0 }),
+		"FilterI":        FilterI(func(v int, _ int64) bool { return v%2 == 0 }),
+		"Take":           Take[int](1 << 40),
+		"Skip":           Skip[int](1),
+		"TakeWhile":      TakeWhile(func(v int) bool { return true }),
+		"SkipWhile":      SkipWhile(func(v int) bool { return false }),
+		"Scan":           Scan(func(acc, v int) int { return acc + v }, 0),
+		"TapOnNext":      TapOnNext(func(v int) {}),
+		"Clamp":          Clamp(0, 1_000_000),
+		"Chain": func(source Observable[int]) Observable[int] {
+			return Pipe3(
+				source,
+				Map(func(v int) int { return v + 1 }),
+				Filter(func(v int) bool { return v > 0 }),
+				Scan(func(acc, v int) int { return acc + v }, 0),
+			)
+		},
+	}
+
+	for name, operator := range operators {
+		ctx := context.Background()
+
+		var destination Observer[int]
+		source := NewSingleProducerObservableWithContext(func(ctx context.Context, d Observer[int]) Teardown {
+			destination = d
+			return nil
+		})
+
+		sub := operator(source).SubscribeWithContext(ctx, NewUnsafeSubscriber(NoopObserver[int]()))
+
+		// 123456 is out of the range of the runtime's preallocated small
+		// integers, so that boxing would show up as an allocation.
+		allocs := testing.AllocsPerRun(1000, func() {
+			destination.NextWithContext(ctx, 123456)
+		})
+		is.Zero(allocs, name)
+
+		sub.Unsubscribe()
+	}
+}
+
 func TestNewConnectableObservable(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -725,6 +800,71 @@ func TestNewConnectableObservable(t *testing.T) {
 	is.Equal([]string{"1", "2", "3"}, b)
 }
 
+type testCounterObservable struct {
+	BaseObservable[int]
+	count int
+}
+
+func newTestCounterObservable(count int) *testCounterObservable {
+	o := &testCounterObservable{count: count}
+	o.BaseObservable = NewBaseObservable(o.subscribe)
+
+	return o
+}
+
+func (o *testCounterObservable) subscribe(ctx context.Context, destination Observer[int]) Teardown {
+	for i := 0; i < o.count; i++ {
+		destination.NextWithContext(ctx, i)
+	}
+
+	destination.CompleteWithContext(ctx)
+
+	return nil
+}
+
+func TestBaseObservable(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	obs := newTestCounterObservable(3)
+
+	values, err := Collect[int](obs)
+	is.Equal([]int{0, 1, 2}, values)
+	is.NoError(err)
+
+	values, err = Collect(Pipe1[int, int](obs, Map(func(x int) int { return x * 2 })))
+	is.Equal([]int{0, 2, 4}, values)
+	is.NoError(err)
+
+	// panics are converted into errors
+	var teardown int32
+
+	obs2 := NewBaseObservableWithConcurrencyMode(func(ctx context.Context, destination Observer[int]) Teardown {
+		destination.NextWithContext(ctx, 1)
+		panic(assert.AnError)
+	}, ConcurrencyModeUnsafe)
+
+	values, err = Collect[int](obs2)
+	is.Equal([]int{1}, values)
+	is.ErrorIs(err, assert.AnError)
+
+	// teardown
+	obs3 := NewBaseObservable(func(ctx context.Context, destination Observer[int]) Teardown {
+		destination.CompleteWithContext(ctx)
+		return func() { atomic.AddInt32(&teardown, 1) }
+	})
+
+	_, err = Collect[int](obs3)
+	is.NoError(err)
+	is.EqualValues(1, atomic.LoadInt32(&teardown))
+
+	is.PanicsWithValue(ErrBaseObservableNotInitialized, func() {
+		var zero BaseObservable[int]
+		zero.Subscribe(NoopObserver[int]())
+	})
+}
+
 func TestNewConnectableObservableWithConfig(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -0,0 +1,195 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ro
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// operator is the internal fast path of stateless operators. Most operators
+// subscribe to their source with NewObserverWithContext and closures, which
+// costs a few indirect calls per item and per stage. An operator instead builds
+// a concrete observer calling the user function and the destination directly.
+//
+// The functional API (Map, Filter...) applies operators with newOperatorObservable.
+// The closure is declared by the functional API itself, so that the operator
+// name is reported by StageError.
+type operator[T, R any] interface {
+	observer(destination Observer[R]) Observer[T]
+}
+
+// newOperatorObservable applies an operator to the source.
+func newOperatorObservable[T, R any](source Observable[T], op operator[T, R]) Observable[R] {
+	return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[R]) Teardown {
+		sub := source.SubscribeWithContext(subscriberCtx, op.observer(destination))
+		return sub.Unsubscribe
+	})
+}
+
+// operatorObserver holds the status and destination of an operator observer,
+// and forwards Error and Complete notifications. It behaves like observerImpl:
+// a panic in the Next callback is converted into an error notification, and
+// notifications received after closing are dropped.
+type operatorObserver[T, R any] struct {
+	// 0: active
+	// 1: errored
+	// 2: completed
+	status      int32
+	destination Observer[R]
+}
+
+func (o *operatorObserver[T, R]) canNext(ctx context.Context, value T) bool {
+	if atomic.LoadInt32(&o.status) != 0 {
+		OnDroppedNotification(ctx, NewNotificationNext(value))
+		return false
+	}
+
+	return true
+}
+
+// recoverNext must be deferred by the Next implementations.
+func (o *operatorObserver[T, R]) recoverNext(ctx context.Context) {
+	if e := recover(); e != nil {
+		o.tryError(ctx, newObserverError(recoverValueToError(e)))
+	}
+}
+
+func (o *operatorObserver[T, R]) Error(err error) {
+	o.ErrorWithContext(context.Background(), err)
+}
+
+func (o *operatorObserver[T, R]) ErrorWithContext(ctx context.Context, err error) {
+	if !atomic.CompareAndSwapInt32(&o.status, 0, 1) {
+		OnDroppedNotification(ctx, NewNotificationError[T](err))
+		return
+	}
+
+	o.tryError(ctx, err)
+}
+
+func (o *operatorObserver[T, R]) Complete() {
+	o.CompleteWithContext(context.Background())
+}
+
+func (o *operatorObserver[T, R]) CompleteWithContext(ctx context.Context) {
+	if !atomic.CompareAndSwapInt32(&o.status, 0, 2) {
+		OnDroppedNotification(ctx, NewNotificationComplete[T]())
+		return
+	}
+
+	defer recoverUnhandled(ctx)
+
+	o.destination.CompleteWithContext(ctx)
+}
+
+func (o *operatorObserver[T, R]) tryError(ctx context.Context, err error) {
+	defer recoverUnhandled(ctx)
+
+	o.destination.ErrorWithContext(ctx, err)
+}
+
+func (o *operatorObserver[T, R]) IsClosed() bool {
+	return atomic.LoadInt32(&o.status) != 0
+}
+
+func (o *operatorObserver[T, R]) HasThrown() bool {
+	return atomic.LoadInt32(&o.status) == 1
+}
+
+func (o *operatorObserver[T, R]) IsCompleted() bool {
+	return atomic.LoadInt32(&o.status) == 2
+}
+
+// recoverUnhandled must be deferred. It reports a panic to OnUnhandledError.
+func recoverUnhandled(ctx context.Context) {
+	if e := recover(); e != nil {
+		OnUnhandledError(ctx, newObserverError(recoverValueToError(e)))
+	}
+}
+
+/***********
+ *   Map   *
+ ***********/
+
+var _ operator[int, string] = mapOperator[int, string]{}
+
+type mapOperator[T, R any] struct {
+	project func(item T) R
+}
+
+func (op mapOperator[T, R]) observer(destination Observer[R]) Observer[T] {
+	return &mapObserver[T, R]{
+		operatorObserver: operatorObserver[T, R]{destination: destination},
+		project:          op.project,
+	}
+}
+
+type mapObserver[T, R any] struct {
+	operatorObserver[T, R]
+	project func(item T) R
+}
+
+func (o *mapObserver[T, R]) Next(value T) {
+	o.NextWithContext(context.Background(), value)
+}
+
+func (o *mapObserver[T, R]) NextWithContext(ctx context.Context, value T) {
+	if !o.canNext(ctx, value) {
+		return
+	}
+
+	defer o.recoverNext(ctx)
+
+	o.destination.NextWithContext(ctx, o.project(value))
+}
+
+/************
+ *  Filter  *
+ ************/
+
+var _ operator[int, int] = filterOperator[int]{}
+
+type filterOperator[T any] struct {
+	predicate func(item T) bool
+}
+
+func (op filterOperator[T]) observer(destination Observer[T]) Observer[T] {
+	return &filterObserver[T]{
+		operatorObserver: operatorObserver[T, T]{destination: destination},
+		predicate:        op.predicate,
+	}
+}
+
+type filterObserver[T any] struct {
+	operatorObserver[T, T]
+	predicate func(item T) bool
+}
+
+func (o *filterObserver[T]) Next(value T) {
+	o.NextWithContext(context.Background(), value)
+}
+
+func (o *filterObserver[T]) NextWithContext(ctx context.Context, value T) {
+	if !o.canNext(ctx, value) {
+		return
+	}
+
+	defer o.recoverNext(ctx)
+
+	if o.predicate(value) {
+		o.destination.NextWithContext(ctx, value)
+	}
+}
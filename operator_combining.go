@@ -118,7 +118,7 @@ func MergeAll[T any]() func(Observable[Observable[T]]) Observable[T] {
 			var parentCtx context.Context
 			var parentCtxMu sync.Mutex // atomic.Value has been introduced in go 1.19 and this library support go 1.18
 
-			subscriptions := NewSubscription(nil)
+			subscriptions := newSubscriptionImpl(nil)
 
 			// default value is not 0, because it counts the outer Observable `sources`
 			subscriptionsCount := int32(1)
@@ -141,18 +141,35 @@ func MergeAll[T any]() func(Observable[Observable[T]]) Observable[T] {
 						func(ctx context.Context, source Observable[T]) {
 							atomic.AddInt32(&subscriptionsCount, 1)
 
-							subscriptions.AddUnsubscribable(
-								source.SubscribeWithContext(
-									ctx,
-									NewObserverWithContext(
-										destination.NextWithContext,
-										destination.ErrorWithContext,
-										func(ctx context.Context) {
-											onDone()
-										},
-									),
+							// Completed inner subscriptions are detached, so that
+							// long-lived sources do not accumulate finalizers.
+							var mu sync.Mutex
+							var handle TeardownHandle
+							completed := false
+
+							sub := source.SubscribeWithContext(
+								ctx,
+								NewObserverWithContext(
+									destination.NextWithContext,
+									destination.ErrorWithContext,
+									func(ctx context.Context) {
+										mu.Lock()
+										completed = true
+										subscriptions.Remove(handle)
+										mu.Unlock()
+
+										onDone()
+									},
 								),
 							)
+
+							mu.Lock()
+							if !completed {
+								handle = subscriptions.AddRemovable(func() {
+									unsubscribeWithCause(sub, subscriptions.Cause())
+								})
+							}
+							mu.Unlock()
 						},
 						destination.ErrorWithContext,
 						func(ctx context.Context) {
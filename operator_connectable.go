@@ -18,6 +18,7 @@ import (
 	"context"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
 // ShareConfig is the configuration for the Share operator.
@@ -183,6 +184,7 @@ func ShareWithConfig[T any](config ShareConfig[T]) func(Observable[T]) Observabl
 // ShareReplayConfig is the configuration for the ShareReplay operator.
 type ShareReplayConfig struct {
 	ResetOnRefCountZero bool
+	Window              time.Duration
 }
 
 // ShareReplay creates a new Observable that multicasts (shares) the original
@@ -219,13 +221,14 @@ func ShareReplay[T any](bufferSize int) func(Observable[T]) Observable[T] {
 //   - `bufferSize` is the number of items to replay to future subscribers.
 //   - `ResetOnRefCountZero` determines whether the shared Observable should be
 //     reset when the reference count reaches zero.
+//   - `Window` is the maximum age of the replayed items. Zero means no limit.
 //
 // Play: https://go.dev/play/p/QmsDbChzRgu
 func ShareReplayWithConfig[T any](bufferSize int, config ShareReplayConfig) func(Observable[T]) Observable[T] {
 	return ShareWithConfig(
 		ShareConfig[T]{
 			Connector: func() Subject[T] {
-				return NewReplaySubject[T](bufferSize)
+				return NewReplaySubjectWithWindow[T](bufferSize, config.Window)
 			},
 			ResetOnError:        true,
 			ResetOnComplete:     false,
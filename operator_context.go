@@ -25,20 +25,33 @@ import (
 func ContextWithValue[T any](k, v any) func(Observable[T]) Observable[T] {
 	return func(source Observable[T]) Observable[T] {
 		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			// Notifications carrying the subscription context (see ContextFromSubscription)
+			// already resolve k to v, or reuse the context derived at subscription time,
+			// instead of allocating a new context for each item.
+			valueCtx := context.WithValue(subscriberCtx, k, v)
+			withValue := func(ctx context.Context) context.Context {
+				if sameValue(ctx, subscriberCtx) {
+					return valueCtx
+				}
+
+				if sameValue(ctx.Value(k), v) {
+					return ctx
+				}
+
+				return context.WithValue(ctx, k, v)
+			}
+
 			sub := source.SubscribeWithContext(
-				context.WithValue(subscriberCtx, k, v),
+				valueCtx,
 				NewObserverWithContext(
 					func(ctx context.Context, value T) {
-						ctx = context.WithValue(ctx, k, v)
-						destination.NextWithContext(ctx, value)
+						destination.NextWithContext(withValue(ctx), value)
 					},
 					func(ctx context.Context, err error) {
-						ctx = context.WithValue(ctx, k, v)
-						destination.ErrorWithContext(ctx, err)
+						destination.ErrorWithContext(withValue(ctx), err)
 					},
 					func(ctx context.Context) {
-						ctx = context.WithValue(ctx, k, v)
-						destination.CompleteWithContext(ctx)
+						destination.CompleteWithContext(withValue(ctx))
 					},
 				),
 			)
@@ -209,6 +222,37 @@ func ContextReset[T any](newCtx context.Context) func(Observable[T]) Observable[
 	}
 }
 
+// ContextFromSubscription returns an Observable that emits the same items as the
+// source Observable, but with the subscription context instead of the context of
+// each notification. Per-notification contexts derived upstream are dropped.
+//
+// Use it at the head of hot pipelines that do not rely on per-item context values:
+// downstream stages then receive the same context for every notification, and
+// ContextWithValue derives its context once per subscription instead of once per
+// item.
+func ContextFromSubscription[T any]() func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(_ context.Context, value T) {
+						destination.NextWithContext(subscriberCtx, value)
+					},
+					func(_ context.Context, err error) {
+						destination.ErrorWithContext(subscriberCtx, err)
+					},
+					func(_ context.Context) {
+						destination.CompleteWithContext(subscriberCtx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
 // ContextMap returns an Observable that emits the same items as the source
 // Observable, but with a new context. The project function is called for each
 // item emitted by the source Observable, and the context is replaced with the
@@ -299,3 +343,128 @@ func ThrowOnContextCancel[T any]() func(Observable[T]) Observable[T] {
 		})
 	}
 }
+
+// ContextWithPipelineName returns an Observable that emits the same items as
+// the source Observable, but attaches the pipeline name to the context of each
+// notification. See WithPipelineName.
+func ContextWithPipelineName[T any](name string) func(Observable[T]) Observable[T] {
+	return ContextWithValue[T](pipelineNameKey{}, name)
+}
+
+// ContextWithStageTag returns an Observable that emits the same items as the
+// source Observable, but attaches a tag to the context of each notification.
+// See WithStageTag.
+func ContextWithStageTag[T any](key, value string) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			sub := source.SubscribeWithContext(
+				WithStageTag(subscriberCtx, key, value),
+				NewObserverWithContext(
+					func(ctx context.Context, item T) {
+						destination.NextWithContext(WithStageTag(ctx, key, value), item)
+					},
+					func(ctx context.Context, err error) {
+						destination.ErrorWithContext(WithStageTag(ctx, key, value), err)
+					},
+					func(ctx context.Context) {
+						destination.CompleteWithContext(WithStageTag(ctx, key, value))
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+type pipelineNameKey struct{}
+
+type stageTagsKey struct{}
+
+// stageTag is a node of the list of tags attached to a context. The nearest
+// tag wins.
+type stageTag struct {
+	key    string
+	value  string
+	parent *stageTag
+}
+
+// WithPipelineName returns a copy of ctx carrying the name of the pipeline.
+// The name travels with the notifications: it is reported by StageError, and
+// can be read with PipelineName by operators, observers and hooks such as
+// OnUnhandledError and OnDroppedNotification.
+func WithPipelineName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, pipelineNameKey{}, name)
+}
+
+// PipelineName returns the pipeline name attached to ctx, or "".
+func PipelineName(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+
+	name, _ := ctx.Value(pipelineNameKey{}).(string)
+
+	return name
+}
+
+// WithStageTag returns a copy of ctx carrying a key-value tag, such as a
+// tenant or a stage name. A tag overrides the tags with the same key attached
+// earlier. Tags travel with the notifications like the pipeline name: see
+// WithPipelineName.
+func WithStageTag(ctx context.Context, key, value string) context.Context {
+	parent, _ := ctx.Value(stageTagsKey{}).(*stageTag)
+
+	return context.WithValue(ctx, stageTagsKey{}, &stageTag{key: key, value: value, parent: parent})
+}
+
+// StageTag returns the value of the tag attached to ctx with the given key.
+func StageTag(ctx context.Context, key string) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+
+	tag, _ := ctx.Value(stageTagsKey{}).(*stageTag)
+	for ; tag != nil; tag = tag.parent {
+		if tag.key == key {
+			return tag.value, true
+		}
+	}
+
+	return "", false
+}
+
+// StageTags returns a copy of the tags attached to ctx, or nil.
+func StageTags(ctx context.Context) map[string]string {
+	if ctx == nil {
+		return nil
+	}
+
+	tag, _ := ctx.Value(stageTagsKey{}).(*stageTag)
+	if tag == nil {
+		return nil
+	}
+
+	tags := map[string]string{}
+
+	for ; tag != nil; tag = tag.parent {
+		if _, ok := tags[tag.key]; !ok {
+			tags[tag.key] = tag.value
+		}
+	}
+
+	return tags
+}
+
+// sameValue reports whether a and b are equal. Comparing interfaces panics when
+// both hold the same non-comparable type (user-defined contexts, slices used as
+// context values...): such values are reported as different.
+func sameValue(a, b any) (same bool) {
+	defer func() {
+		if recover() != nil {
+			same = false
+		}
+	}()
+
+	return a == b
+}
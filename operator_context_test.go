@@ -333,6 +333,159 @@ func TestOperatorContextContextResetWithNil(t *testing.T) {
 	}
 }
 
+func TestOperatorContextContextFromSubscription(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type ctxKey string
+
+	subKey := ctxKey("sub")
+	itemKey := ctxKey("item")
+
+	subCtx := context.WithValue(context.Background(), subKey, "sub_value")
+
+	values := []int{}
+	contexts := []context.Context{}
+
+	obs := Pipe2(
+		Just(1, 2, 3),
+		ContextMap[int](func(ctx context.Context) context.Context {
+			return context.WithValue(ctx, itemKey, "item_value")
+		}),
+		ContextFromSubscription[int](),
+	)
+
+	sub := obs.SubscribeWithContext(
+		subCtx,
+		NewObserverWithContext(
+			func(ctx context.Context, value int) {
+				values = append(values, value)
+				contexts = append(contexts, ctx)
+			},
+			func(ctx context.Context, err error) {
+				is.Fail("should not error")
+			},
+			func(ctx context.Context) {
+				contexts = append(contexts, ctx)
+			},
+		),
+	)
+
+	sub.Unsubscribe()
+
+	is.Equal([]int{1, 2, 3}, values)
+	is.Len(contexts, 4)
+
+	for _, ctx := range contexts {
+		is.Equal(subCtx, ctx)
+		is.Nil(ctx.Value(itemKey))
+	}
+
+	// error
+	var errCtx context.Context
+
+	NewObservableWithContext(func(ctx context.Context, destination Observer[int]) Teardown {
+		destination.ErrorWithContext(context.WithValue(ctx, itemKey, "item_value"), assert.AnError)
+		return nil
+	}).
+		SubscribeWithContext(
+			subCtx,
+			NewObserverWithContext(
+				func(ctx context.Context, value int) {},
+				func(ctx context.Context, err error) { errCtx = ctx },
+				func(ctx context.Context) {},
+			),
+		)
+
+	is.NotNil(errCtx)
+	is.Equal("item_value", errCtx.Value(itemKey))
+
+	errCtx = nil
+
+	Pipe1(
+		NewObservableWithContext(func(ctx context.Context, destination Observer[int]) Teardown {
+			destination.ErrorWithContext(context.WithValue(ctx, itemKey, "item_value"), assert.AnError)
+			return nil
+		}),
+		ContextFromSubscription[int](),
+	).
+		SubscribeWithContext(
+			subCtx,
+			NewObserverWithContext(
+				func(ctx context.Context, value int) {},
+				func(ctx context.Context, err error) { errCtx = ctx },
+				func(ctx context.Context) {},
+			),
+		)
+
+	is.Equal(subCtx, errCtx)
+}
+
+func TestOperatorContextContextFromSubscriptionWithValue(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type ctxKey string
+
+	key1 := ctxKey("key1")
+	key2 := ctxKey("key2")
+
+	contexts := []context.Context{}
+
+	obs := Pipe3(
+		Just(1, 2, 3),
+		ContextFromSubscription[int](),
+		ContextWithValue[int](key1, "value1"),
+		ContextWithValue[int](key2, "value2"),
+	)
+
+	sub := obs.Subscribe(
+		NewObserverWithContext(
+			func(ctx context.Context, value int) {
+				contexts = append(contexts, ctx)
+			},
+			func(ctx context.Context, err error) {
+				is.Fail("should not error")
+			},
+			func(ctx context.Context) {
+				contexts = append(contexts, ctx)
+			},
+		),
+	)
+
+	sub.Unsubscribe()
+
+	is.Len(contexts, 4)
+
+	// every notification reuses the context derived at subscription time
+	for _, ctx := range contexts {
+		is.True(ctx == contexts[0])
+		is.Equal("value1", ctx.Value(key1))
+		is.Equal("value2", ctx.Value(key2))
+	}
+}
+
+func TestOperatorContextSameValue(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type sliceCtx struct {
+		context.Context
+		values []int
+	}
+
+	ctx := context.Background()
+	valueCtx := context.WithValue(ctx, "key", "value") //nolint:staticcheck
+
+	is.True(sameValue(ctx, ctx))
+	is.True(sameValue(valueCtx, valueCtx))
+	is.False(sameValue(ctx, valueCtx))
+	is.False(sameValue(sliceCtx{Context: ctx}, sliceCtx{Context: ctx}))
+	is.True(sameValue(42, 42))
+	is.False(sameValue([]int{42}, []int{42}))
+	is.False(sameValue(nil, 42))
+}
+
 func TestOperatorContextContextMap(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -568,3 +721,90 @@ func TestOperatorContextChaining(t *testing.T) {
 		is.Equal("value2", ctx.Value(key2))
 	}
 }
+
+func TestOperatorContextPipelineMetadata(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	ctx := context.Background()
+	is.Equal("", PipelineName(ctx))
+	is.Equal("", PipelineName(nil)) //nolint:staticcheck
+	is.Nil(StageTags(ctx))
+
+	ctx = WithPipelineName(ctx, "orders")
+	ctx = WithStageTag(ctx, "tenant", "acme")
+	ctx = WithStageTag(ctx, "stage", "parse")
+	ctx2 := WithStageTag(ctx, "stage", "enrich")
+
+	is.Equal("orders", PipelineName(ctx2))
+
+	value, ok := StageTag(ctx, "stage")
+	is.True(ok)
+	is.Equal("parse", value)
+
+	value, ok = StageTag(ctx2, "stage")
+	is.True(ok)
+	is.Equal("enrich", value)
+
+	_, ok = StageTag(ctx2, "missing")
+	is.False(ok)
+
+	is.Equal(map[string]string{"tenant": "acme", "stage": "parse"}, StageTags(ctx))
+	is.Equal(map[string]string{"tenant": "acme", "stage": "enrich"}, StageTags(ctx2))
+
+	// copies
+	tags := StageTags(ctx)
+	tags["tenant"] = "other"
+	value, _ = StageTag(ctx, "tenant")
+	is.Equal("acme", value)
+}
+
+func TestOperatorContextContextWithPipelineNameAndStageTag(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	names := []string{}
+	tags := []map[string]string{}
+
+	var errName string
+	var errTags map[string]string
+
+	values, err := Collect(
+		Pipe4(
+			Just(1, 2),
+			ContextWithPipelineName[int]("orders"),
+			ContextWithStageTag[int]("tenant", "acme"),
+			TapOnNextWithContext(func(ctx context.Context, value int) {
+				names = append(names, PipelineName(ctx))
+				tags = append(tags, StageTags(ctx))
+			}),
+			MapErrWithContext(func(ctx context.Context, value int) (int, context.Context, error) {
+				if value == 2 {
+					return 0, ctx, assert.AnError
+				}
+
+				return value, ctx, nil
+			}),
+		),
+	)
+	is.Equal([]int{1}, values)
+	is.ErrorIs(err, assert.AnError)
+	is.Equal([]string{"orders", "orders"}, names)
+	is.Equal([]map[string]string{{"tenant": "acme"}, {"tenant": "acme"}}, tags)
+
+	// errors carry the metadata
+	_, err = Collect(
+		Pipe3(
+			Throw[int](assert.AnError),
+			ContextWithPipelineName[int]("orders"),
+			ContextWithStageTag[int]("tenant", "acme"),
+			TapOnErrorWithContext[int](func(ctx context.Context, err error) {
+				errName = PipelineName(ctx)
+				errTags = StageTags(ctx)
+			}),
+		),
+	)
+	is.ErrorIs(err, assert.AnError)
+	is.Equal("orders", errName)
+	is.Equal(map[string]string{"tenant": "acme"}, errTags)
+}
@@ -17,9 +17,11 @@ package ro
 import (
 	"context"
 	"math"
+	"sync"
 	"time"
 
 	"github.com/samber/lo"
+	"github.com/samber/ro/internal/xerrors"
 	"github.com/samber/ro/internal/xrand"
 )
 
@@ -54,9 +56,111 @@ func Start[T any](cb func() T) Observable[T] {
 	})
 }
 
+// IntervalOption configures the Interval and Timer operators.
+type IntervalOption func(config *intervalConfig)
+
+type intervalConfig struct {
+	initialDelay    time.Duration
+	hasInitialDelay bool
+	immediate       bool
+	jitter          time.Duration
+	alignment       time.Duration
+}
+
+// WithIntervalInitialDelay makes Interval emit its first value after delay,
+// instead of after the first interval. Ignored by Timer.
+func WithIntervalInitialDelay(delay time.Duration) IntervalOption {
+	if delay < 0 {
+		panic(ErrIntervalWrongInitialDelay)
+	}
+
+	return func(config *intervalConfig) {
+		config.initialDelay = delay
+		config.hasInitialDelay = true
+	}
+}
+
+// WithIntervalImmediate makes Interval emit its first value on subscription,
+// the next ones being scheduled as usual. Ignored by Timer.
+func WithIntervalImmediate() IntervalOption {
+	return func(config *intervalConfig) {
+		config.immediate = true
+	}
+}
+
+// WithIntervalJitter delays each tick by a random duration in [0, jitter), so
+// that many pollers do not hit a service at the same time. The jitter does not
+// accumulate: ticks stay scheduled on the interval.
+func WithIntervalJitter(jitter time.Duration) IntervalOption {
+	if jitter < 0 {
+		panic(ErrIntervalWrongJitter)
+	}
+
+	return func(config *intervalConfig) {
+		config.jitter = jitter
+	}
+}
+
+// WithIntervalAlignment aligns the ticks on the wall clock: the first tick is
+// postponed to the next multiple of alignment since the zero time (e.g. the next
+// minute boundary for time.Minute), and the next ones follow on the interval.
+// Alignments are computed in UTC.
+func WithIntervalAlignment(alignment time.Duration) IntervalOption {
+	if alignment <= 0 {
+		panic(ErrIntervalWrongAlignment)
+	}
+
+	return func(config *intervalConfig) {
+		config.alignment = alignment
+	}
+}
+
+func newIntervalConfig(opts []IntervalOption) intervalConfig {
+	config := intervalConfig{}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return config
+}
+
+// align returns the first aligned time at or after t.
+func (c intervalConfig) align(t time.Time) time.Time {
+	if c.alignment <= 0 {
+		return t
+	}
+
+	aligned := t.Truncate(c.alignment)
+	if aligned.Before(t) {
+		aligned = aligned.Add(c.alignment)
+	}
+
+	return aligned
+}
+
+// withJitter returns t delayed by a random jitter.
+func (c intervalConfig) withJitter(t time.Time) time.Time {
+	if c.jitter <= 0 {
+		return t
+	}
+
+	return t.Add(time.Duration(xrand.Float64() * float64(c.jitter)))
+}
+
 // Timer creates an Observable that emits a value after a specified duration.
+// WithIntervalJitter and WithIntervalAlignment postpone the emission, and the
+// actual delay is emitted instead.
 // Play: https://go.dev/play/p/hMkNLEqpcy3
-func Timer(duration time.Duration) Observable[time.Duration] {
+func Timer(duration time.Duration, opts ...IntervalOption) Observable[time.Duration] {
+	if len(opts) > 0 {
+		config := newIntervalConfig(opts)
+
+		return Defer(func() Observable[time.Duration] {
+			now := time.Now()
+			return Timer(config.withJitter(config.align(now.Add(duration))).Sub(now))
+		})
+	}
+
 	return NewUnsafeObservableWithContext(func(ctx context.Context, destination Observer[time.Duration]) Teardown {
 		timer := time.NewTimer(duration)
 
@@ -80,9 +184,14 @@ func Timer(duration time.Duration) Observable[time.Duration] {
 
 // Interval creates an Observable that emits an infinite sequence of ascending
 // integers, with a constant interval between them. The first value is not emitted
-// immediately, but after the first interval has passed.
+// immediately, but after the first interval has passed. See WithIntervalInitialDelay,
+// WithIntervalImmediate, WithIntervalJitter and WithIntervalAlignment.
 // Play: https://go.dev/play/p/7yskMPPFHA7
-func Interval(interval time.Duration) Observable[int64] {
+func Interval(interval time.Duration, opts ...IntervalOption) Observable[int64] {
+	if len(opts) > 0 {
+		return intervalWithConfig(interval, newIntervalConfig(opts))
+	}
+
 	return NewObservableWithContext(func(ctx context.Context, destination Observer[int64]) Teardown {
 		ticker := time.NewTicker(interval)
 		done := make(chan struct{})
@@ -114,6 +223,61 @@ func Interval(interval time.Duration) Observable[int64] {
 	})
 }
 
+// intervalWithConfig is the scheduler of Interval when options are set. Ticks
+// are scheduled on absolute times, so that they do not drift. As with a
+// time.Ticker, the ticks missed by a slow subscriber are dropped.
+func intervalWithConfig(interval time.Duration, config intervalConfig) Observable[int64] {
+	if interval <= 0 {
+		panic(ErrIntervalWrongInterval)
+	}
+
+	return NewObservableWithContext(func(ctx context.Context, destination Observer[int64]) Teardown {
+		done := make(chan struct{})
+		value := int64(0)
+
+		if config.immediate {
+			destination.NextWithContext(ctx, value)
+			value++
+		}
+
+		delay := interval
+		if config.hasInitialDelay {
+			delay = config.initialDelay
+		}
+
+		scheduled := config.align(time.Now().Add(delay))
+		timer := time.NewTimer(time.Until(config.withJitter(scheduled)))
+
+		go recoverUnhandledError(func() {
+			defer destination.CompleteWithContext(ctx)
+
+			for {
+				select {
+				case <-done:
+					return
+				case <-ctx.Done():
+					return
+				case <-timer.C:
+					destination.NextWithContext(ctx, value)
+					value++
+
+					scheduled = scheduled.Add(interval)
+					if now := time.Now(); !scheduled.After(now) {
+						scheduled = scheduled.Add((now.Sub(scheduled)/interval + 1) * interval)
+					}
+
+					timer.Reset(time.Until(config.withJitter(scheduled)))
+				}
+			}
+		})
+
+		return func() {
+			timer.Stop()
+			close(done)
+		}
+	})
+}
+
 // IntervalWithInitial creates an Observable that emits an infinite sequence of ascending
 // integers, with a constant interval between them. The first value is not emitted immediately,
 // but after the initial interval has passed. The first interval is `initial`, and the subsequent
@@ -380,6 +544,23 @@ func FromSlice[T any](collections ...[]T) Observable[T] {
 	})
 }
 
+// FromMap creates an Observable emitting the entries of one or more maps. As
+// for a range loop, the order of the entries is not specified. See Keys and
+// Values.
+func FromMap[K comparable, V any](collections ...map[K]V) Observable[lo.Entry[K, V]] {
+	return NewUnsafeObservableWithContext(func(ctx context.Context, destination Observer[lo.Entry[K, V]]) Teardown {
+		for _, collection := range collections {
+			for key, value := range collection {
+				destination.NextWithContext(ctx, lo.Entry[K, V]{Key: key, Value: value})
+			}
+		}
+
+		destination.CompleteWithContext(ctx)
+
+		return nil
+	})
+}
+
 // Empty creates an Observable that emits no values and completes immediately.
 // Play: https://go.dev/play/p/D1JWkPG4NFK
 func Empty[T any]() Observable[T] {
@@ -468,6 +649,80 @@ func Future[T any](factory func() (T, error)) Observable[T] {
 	})
 }
 
+// FutureWithTimeout is like Future, but emits a timeout error if the `factory`
+// function does not return within the given duration. The `factory` function
+// is not interrupted: its result is dropped.
+func FutureWithTimeout[T any](factory func() (T, error), duration time.Duration) Observable[T] {
+	return Timeout[T](duration)(Future(factory))
+}
+
+// AllFutures calls every `factory` function concurrently when an Observer
+// subscribes, and emits their values in the order of the factories, once all
+// of them returned. It emits the first error returned by a factory and ignores
+// the other results. See ForkJoin.
+func AllFutures[T any](factories ...func() (T, error)) Observable[[]T] {
+	return ForkJoin(lo.Map(factories, func(factory func() (T, error), _ int) Observable[T] {
+		return Future(factory)
+	})...)
+}
+
+// AnyFuture calls every `factory` function concurrently when an Observer
+// subscribes, and emits the value of the first factory returning without
+// error. The other results are dropped. If every factory fails, it emits the
+// joined errors, in the order of the factories.
+func AnyFuture[T any](factories ...func() (T, error)) Observable[T] {
+	if len(factories) == 0 {
+		return Empty[T]()
+	}
+
+	return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+		mu := sync.Mutex{}
+		errs := make([]error, len(factories))
+		remaining := len(factories)
+		done := false
+
+		for i := range factories {
+			j := i
+
+			go func() {
+				value, err := factories[j]()
+
+				mu.Lock()
+
+				if done {
+					mu.Unlock()
+					return
+				}
+
+				if err == nil {
+					done = true
+					mu.Unlock()
+
+					destination.NextWithContext(subscriberCtx, value)
+					destination.CompleteWithContext(subscriberCtx)
+
+					return
+				}
+
+				errs[j] = err
+				remaining--
+
+				if remaining > 0 {
+					mu.Unlock()
+					return
+				}
+
+				done = true
+				mu.Unlock()
+
+				destination.ErrorWithContext(subscriberCtx, xerrors.Join(errs...))
+			}()
+		}
+
+		return nil
+	})
+}
+
 // Merge merges the values from all observables to a single observable result.
 // It subscribes to each inner Observable, and emits all values
 // from each inner Observable, maintaining their order. It completes when all
@@ -592,6 +847,102 @@ func Amb[T any](sources ...Observable[T]) Observable[T] {
 	return Race(sources...)
 }
 
+// ForkJoin subscribes to all sources concurrently and waits for them to
+// complete, then emits a single slice holding the last value of each source,
+// in the order of the sources. It completes without emitting if a source
+// completes without emitting, and errors as soon as a source errors. Other
+// sources are unsubscribed in both cases.
+//
+// Unlike Zip, ForkJoin ignores every value but the last one: it is the
+// Observable counterpart of waiting for a group of futures.
+func ForkJoin[T any](sources ...Observable[T]) Observable[[]T] {
+	if len(sources) == 0 {
+		return Empty[[]T]()
+	}
+
+	return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[[]T]) Teardown {
+		subscriptions := NewSubscription(nil)
+
+		mu := sync.Mutex{}
+		values := make([]T, len(sources))
+		hasValue := make([]bool, len(sources))
+		remaining := len(sources)
+		done := false
+
+		// finish returns true for the first caller only.
+		finish := func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if done {
+				return false
+			}
+
+			done = true
+
+			return true
+		}
+
+		for i := range sources {
+			j := i
+
+			mu.Lock()
+			stop := done
+			mu.Unlock()
+
+			if stop {
+				break
+			}
+
+			sub := sources[j].SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						mu.Lock()
+						values[j] = value
+						hasValue[j] = true
+						mu.Unlock()
+					},
+					func(ctx context.Context, err error) {
+						if finish() {
+							destination.ErrorWithContext(ctx, err)
+						}
+					},
+					func(ctx context.Context) {
+						mu.Lock()
+						remaining--
+						last := remaining == 0
+						empty := !hasValue[j]
+						mu.Unlock()
+
+						if !last && !empty {
+							return
+						}
+
+						if !finish() {
+							return
+						}
+
+						if !empty {
+							mu.Lock()
+							result := append([]T{}, values...)
+							mu.Unlock()
+
+							destination.NextWithContext(ctx, result)
+						}
+
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			subscriptions.AddUnsubscribable(sub)
+		}
+
+		return subscriptions.Unsubscribe
+	})
+}
+
 // RandIntN creates an Observable that emits random int values in the range [0, n).
 // The count is the number of values to emit.
 // Play: https://go.dev/play/p/4m7T5j-7i3a
@@ -15,6 +15,7 @@
 package ro
 
 import (
+	"errors"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -137,6 +138,81 @@ func TestOperatorCreationInterval(t *testing.T) { //nolint:paralleltest
 	})
 }
 
+func TestOperatorCreationIntervalWithOptions(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 400*time.Millisecond)
+	is := assert.New(t)
+
+	start := time.Now()
+	elapsed := []time.Duration{}
+
+	values, err := Collect(
+		Pipe2(
+			Interval(50*time.Millisecond, WithIntervalImmediate(), WithIntervalInitialDelay(10*time.Millisecond)),
+			Take[int64](3),
+			TapOnNext(func(int64) {
+				elapsed = append(elapsed, time.Since(start))
+			}),
+		),
+	)
+	is.Equal([]int64{0, 1, 2}, values)
+	is.NoError(err)
+	is.Len(elapsed, 3)
+	is.InDelta(0, elapsed[0], float64(5*time.Millisecond))
+	is.InDelta(10*time.Millisecond, elapsed[1], float64(10*time.Millisecond))
+	is.InDelta(60*time.Millisecond, elapsed[2], float64(15*time.Millisecond))
+
+	ticks := []time.Time{}
+
+	values, err = Collect(
+		Pipe2(
+			Interval(20*time.Millisecond, WithIntervalAlignment(20*time.Millisecond)),
+			Take[int64](2),
+			TapOnNext(func(int64) {
+				ticks = append(ticks, time.Now())
+			}),
+		),
+	)
+	is.Equal([]int64{0, 1}, values)
+	is.NoError(err)
+
+	for _, tick := range ticks {
+		is.Less(tick.Sub(tick.Truncate(20*time.Millisecond)), 10*time.Millisecond)
+	}
+
+	start = time.Now()
+
+	values, err = Collect(
+		Pipe1(
+			Interval(10*time.Millisecond, WithIntervalJitter(5*time.Millisecond)),
+			Take[int64](3),
+		),
+	)
+	is.Equal([]int64{0, 1, 2}, values)
+	is.NoError(err)
+	is.GreaterOrEqual(time.Since(start), 30*time.Millisecond)
+
+	delays, err := Collect(
+		Timer(0, WithIntervalAlignment(20*time.Millisecond)),
+	)
+	is.NoError(err)
+	is.Len(delays, 1)
+	is.LessOrEqual(delays[0], 20*time.Millisecond)
+
+	is.PanicsWithValue(ErrIntervalWrongInitialDelay, func() {
+		WithIntervalInitialDelay(-1)
+	})
+	is.PanicsWithValue(ErrIntervalWrongJitter, func() {
+		WithIntervalJitter(-1)
+	})
+	is.PanicsWithValue(ErrIntervalWrongAlignment, func() {
+		WithIntervalAlignment(0)
+	})
+	is.PanicsWithValue(ErrIntervalWrongInterval, func() {
+		Interval(0, WithIntervalImmediate())
+	})
+}
+
 func TestOperatorCreationIntervalWithInitial(t *testing.T) { //nolint:paralleltest
 	// t.Parallel()
 	testWithTimeout(t, 400*time.Millisecond)
@@ -472,6 +548,24 @@ func TestOperatorCreationFromSlice(t *testing.T) {
 	is.NoError(err)
 }
 
+func TestOperatorCreationFromMap(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		FromMap(map[string]int{"a": 1, "b": 2}, map[string]int{"c": 3}),
+	)
+	is.ElementsMatch([]lo.Entry[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}, {Key: "c", Value: 3}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		FromMap(map[string]int{}),
+	)
+	is.Equal([]lo.Entry[string, int]{}, values)
+	is.NoError(err)
+}
+
 func TestOperatorCreationEmpty(t *testing.T) {
 	t.Parallel()
 	testWithTimeout(t, 100*time.Millisecond)
@@ -589,6 +683,116 @@ func TestOperatorCreationFuture(t *testing.T) { //nolint:paralleltest
 	is.InDelta(200*time.Millisecond, time.Since(start), float64(40*time.Millisecond))
 }
 
+func TestOperatorCreationFutureWithTimeout(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 300*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		FutureWithTimeout(func() (int, error) {
+			return 42, nil
+		}, 50*time.Millisecond),
+	)
+	is.Equal([]int{42}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		FutureWithTimeout(func() (int, error) {
+			time.Sleep(100 * time.Millisecond)
+			return 42, nil
+		}, 20*time.Millisecond),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, "ro.Timeout: timeout after 20ms")
+}
+
+func TestOperatorCreationAllFutures(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 300*time.Millisecond)
+	is := assert.New(t)
+
+	start := time.Now()
+
+	values, err := Collect(
+		AllFutures(
+			func() (int, error) {
+				time.Sleep(50 * time.Millisecond)
+				return 1, nil
+			},
+			func() (int, error) {
+				time.Sleep(10 * time.Millisecond)
+				return 2, nil
+			},
+		),
+	)
+	is.Equal([][]int{{1, 2}}, values)
+	is.NoError(err)
+	is.InDelta(50*time.Millisecond, time.Since(start), float64(30*time.Millisecond))
+
+	values, err = Collect(
+		AllFutures(
+			func() (int, error) {
+				time.Sleep(50 * time.Millisecond)
+				return 1, nil
+			},
+			func() (int, error) {
+				return 0, assert.AnError
+			},
+		),
+	)
+	is.Equal([][]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	values, err = Collect(AllFutures[int]())
+	is.Equal([][]int{}, values)
+	is.NoError(err)
+}
+
+func TestOperatorCreationAnyFuture(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 300*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		AnyFuture(
+			func() (int, error) {
+				return 0, assert.AnError
+			},
+			func() (int, error) {
+				time.Sleep(50 * time.Millisecond)
+				return 1, nil
+			},
+			func() (int, error) {
+				time.Sleep(10 * time.Millisecond)
+				return 2, nil
+			},
+		),
+	)
+	is.Equal([]int{2}, values)
+	is.NoError(err)
+
+	err1 := errors.New("error 1")
+	err2 := errors.New("error 2")
+
+	values, err = Collect(
+		AnyFuture(
+			func() (int, error) {
+				time.Sleep(10 * time.Millisecond)
+				return 0, err1
+			},
+			func() (int, error) {
+				return 0, err2
+			},
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, "error 1\nerror 2")
+
+	values, err = Collect(AnyFuture[int]())
+	is.Equal([]int{}, values)
+	is.NoError(err)
+}
+
 func TestOperatorCreationMerge(t *testing.T) { //nolint:paralleltest
 	// t.Parallel()
 	testWithTimeout(t, 1000*time.Millisecond)
@@ -719,6 +923,44 @@ func TestOperatorCreationAmb(t *testing.T) { //nolint:paralleltest
 	// @TODO: implement
 }
 
+func TestOperatorCreationForkJoin(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 300*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		ForkJoin(
+			Just(1, 2, 3),
+			Pipe1(Just(4, 5), Delay[int](20*time.Millisecond)),
+			Just(6),
+		),
+	)
+	is.Equal([][]int{{3, 5, 6}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ForkJoin(
+			Pipe1(Just(1), Delay[int](20*time.Millisecond)),
+			Empty[int](),
+		),
+	)
+	is.Equal([][]int{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ForkJoin(
+			Pipe1(Just(1), Delay[int](50*time.Millisecond)),
+			Throw[int](assert.AnError),
+		),
+	)
+	is.Equal([][]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	values, err = Collect(ForkJoin[int]())
+	is.Equal([][]int{}, values)
+	is.NoError(err)
+}
+
 func TestOperatorCreationRandIntN(t *testing.T) {
 	t.Parallel()
 	testWithTimeout(t, 100*time.Millisecond)
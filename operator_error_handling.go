@@ -16,6 +16,8 @@ package ro
 
 import (
 	"context"
+	"math"
+	"sync"
 	"sync/atomic"
 	"time"
 )
@@ -433,3 +435,301 @@ func WhileIWithContext[T any](condition func(ctx context.Context, index int64) (
 		})
 	}
 }
+
+// CircuitBreakerState is the state of a CircuitBreaker.
+type CircuitBreakerState uint8
+
+// String returns the string representation of a CircuitBreakerState.
+func (s CircuitBreakerState) String() string {
+	switch s {
+	case CircuitBreakerClosed:
+		return "Closed"
+	case CircuitBreakerOpen:
+		return "Open"
+	case CircuitBreakerHalfOpen:
+		return "HalfOpen"
+	}
+
+	panic("you shall not pass")
+}
+
+// CircuitBreakerState constants.
+const (
+	CircuitBreakerClosed CircuitBreakerState = iota
+	CircuitBreakerOpen
+	CircuitBreakerHalfOpen
+)
+
+// CircuitBreakerTransition is a state change of a CircuitBreaker.
+type CircuitBreakerTransition struct {
+	From CircuitBreakerState
+	To   CircuitBreakerState
+}
+
+// CircuitBreakerConfig is the configuration for the CircuitBreaker operator.
+// Zero values select the defaults.
+type CircuitBreakerConfig[T any] struct {
+	// Action is the side-effect guarded by the breaker. Required.
+	Action func(ctx context.Context, item T) error
+	// Fallback receives the items rejected by the breaker or failed by Action,
+	// such as a dead-letter queue. When nil, the error is sent downstream.
+	Fallback func(ctx context.Context, item T, err error)
+
+	// FailureThreshold is the failure rate opening the breaker, between 0 and
+	// 1. Default: 0.5.
+	FailureThreshold float64
+	// WindowSize is the number of latest calls the failure rate is computed
+	// on. Default: 10.
+	WindowSize int
+	// MinimumRequests is the number of calls required before the breaker may
+	// open. Default: WindowSize.
+	MinimumRequests int
+	// OpenTimeout is the time spent open before probing. Default: 1 minute.
+	OpenTimeout time.Duration
+	// HalfOpenProbes is the number of successful probes closing the breaker.
+	// Default: 1.
+	HalfOpenProbes int
+
+	// StateChanges, when set, receives the state transitions. Pass a Subject
+	// to observe them.
+	StateChanges Observer[CircuitBreakerTransition]
+}
+
+// CircuitBreaker calls Action for each item and forwards the items that
+// succeeded. It tracks the failure rate of the latest calls and opens once the
+// threshold is reached: items are then short-circuited to Fallback with
+// ErrCircuitBreakerOpen, without calling Action. After OpenTimeout, the breaker
+// becomes half-open and lets HalfOpenProbes items through: it closes when they
+// all succeed, and opens again on the first failure.
+//
+// The breaker is created by CircuitBreaker and shared by every subscription to
+// the returned operator. It panics on invalid configuration.
+func CircuitBreaker[T any](config CircuitBreakerConfig[T]) func(Observable[T]) Observable[T] {
+	if config.Action == nil {
+		panic(ErrCircuitBreakerMissingAction)
+	}
+
+	breaker := newCircuitBreaker(config.FailureThreshold, config.WindowSize, config.MinimumRequests, config.OpenTimeout, config.HalfOpenProbes, config.StateChanges)
+
+	reject := func(ctx context.Context, destination Observer[T], value T, err error) {
+		if config.Fallback != nil {
+			config.Fallback(ctx, value, err)
+		} else {
+			destination.ErrorWithContext(ctx, err)
+		}
+	}
+
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						generation, ok := breaker.acquire(ctx)
+						if !ok {
+							reject(ctx, destination, value, ErrCircuitBreakerOpen)
+							return
+						}
+
+						err := breaker.call(ctx, generation, func() error {
+							return config.Action(ctx, value)
+						})
+						if err != nil {
+							reject(ctx, destination, value, err)
+							return
+						}
+
+						destination.NextWithContext(ctx, value)
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// circuitBreaker is the state machine of the CircuitBreaker operator. Each
+// transition starts a new generation, so that the outcome of a call started
+// in a previous state is ignored.
+type circuitBreaker struct {
+	mu sync.Mutex
+
+	failureThreshold float64
+	minimumRequests  int
+	openTimeout      time.Duration
+	halfOpenProbes   int
+	stateChanges     Observer[CircuitBreakerTransition]
+
+	state      CircuitBreakerState
+	generation uint64
+	openedAt   time.Time
+
+	// Closed: ring buffer of the latest outcomes (true on failure).
+	window   []bool
+	cursor   int
+	count    int
+	failures int
+
+	// HalfOpen: probes in flight or done, and successful probes.
+	probes    int
+	successes int
+}
+
+func newCircuitBreaker(failureThreshold float64, windowSize int, minimumRequests int, openTimeout time.Duration, halfOpenProbes int, stateChanges Observer[CircuitBreakerTransition]) *circuitBreaker {
+	if failureThreshold < 0 || failureThreshold > 1 || math.IsNaN(failureThreshold) {
+		panic(ErrCircuitBreakerWrongFailureThreshold)
+	}
+
+	if windowSize < 0 {
+		panic(ErrCircuitBreakerWrongWindowSize)
+	}
+
+	if openTimeout < 0 {
+		panic(ErrCircuitBreakerWrongOpenTimeout)
+	}
+
+	if halfOpenProbes < 0 {
+		panic(ErrCircuitBreakerWrongHalfOpenProbes)
+	}
+
+	if failureThreshold == 0 {
+		failureThreshold = 0.5
+	}
+
+	if windowSize == 0 {
+		windowSize = 10
+	}
+
+	if minimumRequests == 0 {
+		minimumRequests = windowSize
+	}
+
+	if minimumRequests < 0 || minimumRequests > windowSize {
+		panic(ErrCircuitBreakerWrongMinimumRequests)
+	}
+
+	if openTimeout == 0 {
+		openTimeout = time.Minute
+	}
+
+	if halfOpenProbes == 0 {
+		halfOpenProbes = 1
+	}
+
+	return &circuitBreaker{
+		failureThreshold: failureThreshold,
+		minimumRequests:  minimumRequests,
+		openTimeout:      openTimeout,
+		halfOpenProbes:   halfOpenProbes,
+		stateChanges:     stateChanges,
+
+		state:  CircuitBreakerClosed,
+		window: make([]bool, windowSize),
+	}
+}
+
+// acquire reports whether a call is allowed, and the generation it belongs to.
+func (cb *circuitBreaker) acquire(ctx context.Context) (uint64, bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state == CircuitBreakerOpen {
+		if time.Since(cb.openedAt) < cb.openTimeout {
+			return 0, false
+		}
+
+		cb.transition(ctx, CircuitBreakerHalfOpen)
+	}
+
+	if cb.state == CircuitBreakerHalfOpen {
+		if cb.probes >= cb.halfOpenProbes {
+			return 0, false
+		}
+
+		cb.probes++
+	}
+
+	return cb.generation, true
+}
+
+// call runs action and records its outcome. A panic is recorded as a failure
+// and returned as an error.
+func (cb *circuitBreaker) call(ctx context.Context, generation uint64, action func() error) (err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = recoverValueToError(e)
+		}
+
+		cb.release(ctx, generation, err)
+	}()
+
+	return action()
+}
+
+// release records the outcome of a call.
+func (cb *circuitBreaker) release(ctx context.Context, generation uint64, err error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if generation != cb.generation {
+		return
+	}
+
+	switch cb.state {
+	case CircuitBreakerClosed:
+		if cb.count == len(cb.window) {
+			if cb.window[cb.cursor] {
+				cb.failures--
+			}
+		} else {
+			cb.count++
+		}
+
+		cb.window[cb.cursor] = err != nil
+		cb.cursor = (cb.cursor + 1) % len(cb.window)
+
+		if err != nil {
+			cb.failures++
+
+			if cb.count >= cb.minimumRequests && float64(cb.failures) >= cb.failureThreshold*float64(cb.count) {
+				cb.transition(ctx, CircuitBreakerOpen)
+			}
+		}
+	case CircuitBreakerHalfOpen:
+		if err != nil {
+			cb.transition(ctx, CircuitBreakerOpen)
+			return
+		}
+
+		cb.successes++
+		if cb.successes >= cb.halfOpenProbes {
+			cb.transition(ctx, CircuitBreakerClosed)
+		}
+	case CircuitBreakerOpen:
+	}
+}
+
+// transition must be called with the lock held.
+func (cb *circuitBreaker) transition(ctx context.Context, to CircuitBreakerState) {
+	from := cb.state
+
+	cb.state = to
+	cb.generation++
+
+	cb.cursor = 0
+	cb.count = 0
+	cb.failures = 0
+	cb.probes = 0
+	cb.successes = 0
+
+	if to == CircuitBreakerOpen {
+		cb.openedAt = time.Now()
+	}
+
+	if cb.stateChanges != nil {
+		cb.stateChanges.NextWithContext(ctx, CircuitBreakerTransition{From: from, To: to})
+	}
+}
@@ -15,6 +15,7 @@
 package ro
 
 import (
+	"context"
 	"errors"
 	"sync/atomic"
 	"testing"
@@ -448,3 +449,130 @@ func TestOperatorErrorHandlingWhile(t *testing.T) {
 	is.Equal([]int{}, values)
 	is.EqualError(err, assert.AnError.Error())
 }
+
+func TestOperatorErrorHandlingCircuitBreaker(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	noop := func(ctx context.Context, item int) error { return nil }
+
+	is.PanicsWithValue(ErrCircuitBreakerMissingAction, func() {
+		_ = CircuitBreaker(CircuitBreakerConfig[int]{})
+	})
+	is.PanicsWithValue(ErrCircuitBreakerWrongFailureThreshold, func() {
+		_ = CircuitBreaker(CircuitBreakerConfig[int]{Action: noop, FailureThreshold: 1.5})
+	})
+	is.PanicsWithValue(ErrCircuitBreakerWrongWindowSize, func() {
+		_ = CircuitBreaker(CircuitBreakerConfig[int]{Action: noop, WindowSize: -1})
+	})
+	is.PanicsWithValue(ErrCircuitBreakerWrongMinimumRequests, func() {
+		_ = CircuitBreaker(CircuitBreakerConfig[int]{Action: noop, WindowSize: 5, MinimumRequests: 6})
+	})
+	is.PanicsWithValue(ErrCircuitBreakerWrongOpenTimeout, func() {
+		_ = CircuitBreaker(CircuitBreakerConfig[int]{Action: noop, OpenTimeout: -1})
+	})
+	is.PanicsWithValue(ErrCircuitBreakerWrongHalfOpenProbes, func() {
+		_ = CircuitBreaker(CircuitBreakerConfig[int]{Action: noop, HalfOpenProbes: -1})
+	})
+
+	// Successful items are forwarded.
+	values, err := Collect(
+		CircuitBreaker(CircuitBreakerConfig[int]{Action: noop})(Just(1, 2, 3)),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	// Without fallback, the failure is sent downstream.
+	values, err = Collect(
+		CircuitBreaker(CircuitBreakerConfig[int]{
+			Action: func(ctx context.Context, item int) error {
+				if item == 2 {
+					return assert.AnError
+				}
+				return nil
+			},
+		})(Just(1, 2, 3)),
+	)
+	is.Equal([]int{1}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	// The breaker opens, short-circuits, then closes after a successful probe.
+	var calls []int
+	var rejected []int
+	var rejections []error
+	healthy := false
+	transitions := NewReplaySubject[CircuitBreakerTransition](10)
+
+	operator := CircuitBreaker(CircuitBreakerConfig[int]{
+		Action: func(ctx context.Context, item int) error {
+			calls = append(calls, item)
+			if !healthy {
+				return assert.AnError
+			}
+			return nil
+		},
+		Fallback: func(ctx context.Context, item int, err error) {
+			rejected = append(rejected, item)
+			rejections = append(rejections, err)
+		},
+		FailureThreshold: 0.5,
+		WindowSize:       4,
+		MinimumRequests:  2,
+		OpenTimeout:      50 * time.Millisecond,
+		StateChanges:     transitions,
+	})
+
+	values, err = Collect(operator(Just(1, 2, 3, 4)))
+	is.Equal([]int{}, values)
+	is.NoError(err)
+	is.Equal([]int{1, 2}, calls)
+	is.Equal([]int{1, 2, 3, 4}, rejected)
+	is.Equal([]error{assert.AnError, assert.AnError, ErrCircuitBreakerOpen, ErrCircuitBreakerOpen}, rejections)
+
+	// The state is shared by subscriptions.
+	values, err = Collect(operator(Just(5)))
+	is.Equal([]int{}, values)
+	is.NoError(err)
+	is.Equal([]int{1, 2}, calls)
+
+	// A failed probe opens the breaker again.
+	time.Sleep(60 * time.Millisecond)
+	values, err = Collect(operator(Just(6, 7)))
+	is.Equal([]int{}, values)
+	is.NoError(err)
+	is.Equal([]int{1, 2, 6}, calls)
+
+	time.Sleep(60 * time.Millisecond)
+	healthy = true
+	values, err = Collect(operator(Just(8, 9)))
+	is.Equal([]int{8, 9}, values)
+	is.NoError(err)
+	is.Equal([]int{1, 2, 6, 8, 9}, calls)
+
+	transitions.Complete()
+	states, err := Collect[CircuitBreakerTransition](transitions)
+	is.Equal([]CircuitBreakerTransition{
+		{From: CircuitBreakerClosed, To: CircuitBreakerOpen},
+		{From: CircuitBreakerOpen, To: CircuitBreakerHalfOpen},
+		{From: CircuitBreakerHalfOpen, To: CircuitBreakerOpen},
+		{From: CircuitBreakerOpen, To: CircuitBreakerHalfOpen},
+		{From: CircuitBreakerHalfOpen, To: CircuitBreakerClosed},
+	}, states)
+	is.NoError(err)
+
+	// A panicking action is recorded as a failure.
+	values, err = Collect(
+		CircuitBreaker(CircuitBreakerConfig[int]{
+			Action: func(ctx context.Context, item int) error {
+				panic(assert.AnError)
+			},
+		})(Just(1)),
+	)
+	is.Equal([]int{}, values)
+	is.ErrorIs(err, assert.AnError)
+
+	is.Equal("Closed", CircuitBreakerClosed.String())
+	is.Equal("Open", CircuitBreakerOpen.String())
+	is.Equal("HalfOpen", CircuitBreakerHalfOpen.String())
+}
@@ -16,17 +16,21 @@ package ro
 
 import (
 	"context"
+	"sort"
 	"sync/atomic"
 
 	"github.com/samber/lo"
+	"github.com/samber/ro/internal/constraints"
 )
 
 // Filter emits only those items from an Observable that pass a predicate test.
 // Play: https://go.dev/play/p/gjk_wULxyEW
 func Filter[T any](predicate func(item T) bool) func(Observable[T]) Observable[T] {
-	return FilterIWithContext(func(ctx context.Context, v T, _ int64) (context.Context, bool) {
-		return ctx, predicate(v)
-	})
+	op := filterOperator[T]{predicate: predicate}
+
+	return func(source Observable[T]) Observable[T] {
+		return newOperatorObservable[T, T](source, op)
+	}
 }
 
 // FilterWithContext emits only those items from an Observable that pass a predicate test.
@@ -100,6 +104,43 @@ func Distinct[T comparable]() func(Observable[T]) Observable[T] {
 	}
 }
 
+// DistinctSorted suppresses duplicate items in an Observable and emits the
+// remaining items in ascending order, when the source completes.
+func DistinctSorted[T constraints.Ordered]() func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			seen := map[T]struct{}{}
+			items := []T{}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if _, ok := seen[value]; !ok {
+							seen[value] = struct{}{}
+							items = append(items, value)
+						}
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						sort.Slice(items, func(i, j int) bool {
+							return items[i] < items[j]
+						})
+
+						for _, item := range items {
+							destination.NextWithContext(ctx, item)
+						}
+
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
 // DistinctBy suppresses duplicate items in an Observable based on a key selector.
 func DistinctBy[T any, K comparable](keySelector func(item T) K) func(Observable[T]) Observable[T] {
 	return DistinctByWithContext(func(ctx context.Context, item T) (context.Context, K) {
@@ -157,6 +198,26 @@ func IgnoreElements[T any]() func(Observable[T]) Observable[T] {
 	}
 }
 
+// ToCompletion is like IgnoreElements, with an Observable of struct{}. It is
+// useful when a pipeline is run purely for side effects, and the caller only
+// cares about its termination: Observables of different types can then be
+// combined with Merge, Concat...
+func ToCompletion[T any](source Observable[T]) Observable[struct{}] {
+	return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[struct{}]) Teardown {
+		sub := source.SubscribeWithContext(
+			subscriberCtx,
+			NewObserverWithContext(
+				func(ctx context.Context, value T) {
+				},
+				destination.ErrorWithContext,
+				destination.CompleteWithContext,
+			),
+		)
+
+		return sub.Unsubscribe
+	})
+}
+
 // Skip suppresses the first n items emitted by an Observable.
 // If the count is greater than the number of items emitted by the source Observable,
 // Skip will not emit any items. If the count is zero, Skip will emit all items.
@@ -454,6 +515,76 @@ func TakeWhileIWithContext[T any](predicate func(ctx context.Context, item T, in
 	}
 }
 
+// TakeWhileInclusive emits items emitted by an Observable so long as a specified
+// condition is true. Unlike TakeWhile, the first item failing the condition is
+// emitted too, then the Observable completes.
+func TakeWhileInclusive[T any](predicate func(item T) bool) func(Observable[T]) Observable[T] {
+	return TakeWhileInclusiveIWithContext(func(ctx context.Context, v T, _ int64) (context.Context, bool) {
+		return ctx, predicate(v)
+	})
+}
+
+// TakeWhileInclusiveWithContext emits items emitted by an Observable so long as
+// a specified condition is true. Unlike TakeWhile, the first item failing the
+// condition is emitted too, then the Observable completes.
+func TakeWhileInclusiveWithContext[T any](predicate func(ctx context.Context, item T) (context.Context, bool)) func(Observable[T]) Observable[T] {
+	return TakeWhileInclusiveIWithContext(func(ctx context.Context, v T, _ int64) (context.Context, bool) {
+		return predicate(ctx, v)
+	})
+}
+
+// TakeWhileInclusiveI emits items emitted by an Observable so long as a
+// specified condition is true. Unlike TakeWhile, the first item failing the
+// condition is emitted too, then the Observable completes.
+func TakeWhileInclusiveI[T any](predicate func(item T, index int64) bool) func(Observable[T]) Observable[T] {
+	return TakeWhileInclusiveIWithContext(func(ctx context.Context, v T, i int64) (context.Context, bool) {
+		return ctx, predicate(v, i)
+	})
+}
+
+// TakeWhileInclusiveIWithContext emits items emitted by an Observable so long
+// as a specified condition is true. Unlike TakeWhile, the first item failing the
+// condition is emitted too, then the Observable completes.
+func TakeWhileInclusiveIWithContext[T any](predicate func(ctx context.Context, item T, index int64) (context.Context, bool)) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			skipping := false
+			i := int64(0)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if !skipping {
+							currentCtx, ok := predicate(ctx, value, i)
+							destination.NextWithContext(currentCtx, value)
+
+							if !ok {
+								destination.CompleteWithContext(currentCtx)
+								skipping = true
+							}
+						}
+
+						i++
+					},
+					func(ctx context.Context, err error) {
+						if !skipping {
+							destination.ErrorWithContext(ctx, err)
+						}
+					},
+					func(ctx context.Context) {
+						if !skipping {
+							destination.CompleteWithContext(ctx)
+						}
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
 // TakeLast emits only the last n items emitted by an Observable. If the count is
 // greater than the number of items emitted by the source Observable, TakeLast will
 // emit all items. If the count is zero, TakeLast will not emit any items.
@@ -730,6 +861,76 @@ func LastIWithContext[T any](predicate func(ctx context.Context, item T, index i
 	}
 }
 
+// Single emits the only item emitted by an Observable that satisfies a specified
+// condition, once the source completes. If no item satisfies the condition,
+// Single emits ErrSingleEmpty. As soon as a second item satisfies it, Single
+// emits ErrSingleMoreThanOne and unsubscribes from the source. Use errors.Is
+// with ErrEmpty and ErrMoreThanOne to handle these errors.
+func Single[T any](predicate func(item T) bool) func(Observable[T]) Observable[T] {
+	return SingleI(func(v T, _ int64) bool {
+		return predicate(v)
+	})
+}
+
+// SingleWithContext emits the only item emitted by an Observable that satisfies a
+// specified condition, once the source completes. See Single.
+func SingleWithContext[T any](predicate func(ctx context.Context, item T) (context.Context, bool)) func(Observable[T]) Observable[T] {
+	return SingleIWithContext(func(ctx context.Context, item T, _ int64) (context.Context, bool) {
+		return predicate(ctx, item)
+	})
+}
+
+// SingleI emits the only item emitted by an Observable that satisfies a specified
+// condition, once the source completes. See Single.
+func SingleI[T any](predicate func(item T, index int64) bool) func(Observable[T]) Observable[T] {
+	return SingleIWithContext(func(ctx context.Context, v T, i int64) (context.Context, bool) {
+		return ctx, predicate(v, i)
+	})
+}
+
+// SingleIWithContext emits the only item emitted by an Observable that satisfies a
+// specified condition, once the source completes. See Single.
+func SingleIWithContext[T any](predicate func(ctx context.Context, item T, index int64) (context.Context, bool)) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			var single lo.Tuple2[context.Context, T]
+
+			hasValue := false
+			i := int64(0)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if newCtx, ok := predicate(ctx, value, i); ok {
+							if hasValue {
+								destination.ErrorWithContext(newCtx, ErrSingleMoreThanOne)
+								return
+							}
+
+							single = lo.T2(newCtx, value)
+							hasValue = true
+						}
+
+						i++
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						if hasValue {
+							destination.NextWithContext(single.A, single.B)
+							destination.CompleteWithContext(single.A)
+						} else {
+							destination.ErrorWithContext(ctx, ErrSingleEmpty)
+						}
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
 // ElementAt emits only the nth item emitted by an Observable. If the source Observable
 // emits fewer than n items, ElementAt will emit an error.
 // Play: https://go.dev/play/p/0YE1tCbPaDg
@@ -15,6 +15,7 @@
 package ro
 
 import (
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -143,6 +144,29 @@ func TestOperatorFilterDistinct(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorFilterDistinctSorted(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		DistinctSorted[string]()(Just("b", "c", "a", "b", "a")),
+	)
+	is.Equal([]string{"a", "b", "c"}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		DistinctSorted[string]()(Empty[string]()),
+	)
+	is.Equal([]string{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		DistinctSorted[string]()(Throw[string](assert.AnError)),
+	)
+	is.Equal([]string{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorFilterDistinctBy(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -209,6 +233,33 @@ func TestOperatorFilterIgnoreElements(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorFilterToCompletion(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		ToCompletion(Just(1, 2, 3)),
+	)
+	is.Equal([]struct{}{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ToCompletion(Throw[string](assert.AnError)),
+	)
+	is.Equal([]struct{}{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	// pipelines of different types
+	values, err = Collect(
+		Merge(
+			ToCompletion(Just(1, 2, 3)),
+			ToCompletion(Just("a", "b")),
+		),
+	)
+	is.Equal([]struct{}{}, values)
+	is.NoError(err)
+}
+
 func TestOperatorFilterSkip(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -542,6 +593,60 @@ func TestOperatorFilterTakeWhileI(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorFilterTakeWhileInclusive(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	predicate := func(v int) bool {
+		return v < 3
+	}
+
+	values, err := Collect(
+		TakeWhileInclusive(predicate)(Just(1, 2, 3, 4)),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		TakeWhileInclusive(predicate)(Just(5, 1, 2)),
+	)
+	is.Equal([]int{5}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		TakeWhileInclusive(predicate)(Just(1, 2)),
+	)
+	is.Equal([]int{1, 2}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		TakeWhileInclusive(predicate)(Empty[int]()),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		TakeWhileInclusive(predicate)(Concat(Just(1, 3), Throw[int](assert.AnError))),
+	)
+	is.Equal([]int{1, 3}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		TakeWhileInclusive(predicate)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	values, err = Collect(
+		TakeWhileInclusiveI(func(v int, i int64) bool {
+			is.Equal(v, int(i))
+			return i < 2
+		})(Just(0, 1, 2, 3)),
+	)
+	is.Equal([]int{0, 1, 2}, values)
+	is.NoError(err)
+}
+
 func TestOperatorFilterTakeLast(t *testing.T) {
 	t.Parallel()
 	testWithTimeout(t, 10*time.Millisecond)
@@ -824,6 +929,94 @@ func TestOperatorFilterLast(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorFilterSingle(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		Pipe1(
+			Just(1, 2, 3),
+			Single(func(item int) bool {
+				return item == 2
+			}),
+		),
+	)
+	is.Equal([]int{2}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe1(
+			Just(1, 2, 3),
+			Single(func(item int) bool {
+				return item > 3
+			}),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, ErrSingleEmpty.Error())
+	is.ErrorIs(err, ErrEmpty)
+
+	values, err = Collect(
+		Pipe1(
+			Just(1, 2, 3),
+			Single(func(item int) bool {
+				return item > 1
+			}),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, ErrSingleMoreThanOne.Error())
+	is.ErrorIs(err, ErrMoreThanOne)
+
+	values, err = Collect(
+		Pipe1(
+			Throw[int](assert.AnError),
+			SingleI(func(item int, index int64) bool {
+				return true
+			}),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	// the source is unsubscribed on the second match
+	var count int32
+
+	values64, err := Collect(
+		Pipe2(
+			Interval(time.Millisecond),
+			TapOnNext(func(item int64) {
+				atomic.AddInt32(&count, 1)
+			}),
+			Single(func(item int64) bool {
+				return item < 2
+			}),
+		),
+	)
+	is.Equal([]int64{}, values64)
+	is.ErrorIs(err, ErrMoreThanOne)
+	time.Sleep(10 * time.Millisecond)
+	is.EqualValues(2, atomic.LoadInt32(&count))
+}
+
+func TestOperatorFilterEmptyErrors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, err := range []error{ErrFirstEmpty, ErrLastEmpty, ErrHeadEmpty, ErrTailEmpty, ErrSingleEmpty, ErrSingleValueEmpty} {
+		is.ErrorIs(err, ErrEmpty)
+		is.NotErrorIs(err, ErrMoreThanOne)
+	}
+
+	for _, err := range []error{ErrSingleMoreThanOne, ErrSingleValueMultiple} {
+		is.ErrorIs(err, ErrMoreThanOne)
+		is.NotErrorIs(err, ErrEmpty)
+	}
+
+	is.NotErrorIs(ErrFirstEmpty, ErrLastEmpty)
+	is.ErrorIs(ErrFirstEmpty, ErrFirstEmpty)
+}
+
 func TestOperatorFilterElementAt(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
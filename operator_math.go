@@ -15,12 +15,17 @@
 package ro
 
 import (
+	"container/heap"
 	"context"
 	"math"
 	"math/big"
+	"sort"
+	"time"
 
 	"github.com/samber/lo"
 	"github.com/samber/ro/internal/constraints"
+	"github.com/samber/ro/internal/xsync"
+	"github.com/samber/ro/internal/xtime"
 )
 
 // maxPow10Chunk is the largest decimal exponent n for which 10^n fits in a
@@ -31,6 +36,11 @@ import (
 // big.Float arithmetic.
 const maxPow10Chunk = 308
 
+// bigFloatSumPrec is the mantissa precision of the accumulators of SumBigFloat
+// and AverageBig. 512 bits hold the exact sum of any int64/uint64 values, and of
+// float64 values spanning more than 130 orders of magnitude.
+const bigFloatSumPrec = 512
+
 // maxPow10ChunkCount caps the number of 308-digit chunks we are willing to
 // process when emulating arbitrary-precision ceil operations. 32 chunks
 // (32 * 308 ≈ 9856 decimal digits) keep allocations bounded while still
@@ -39,32 +49,132 @@ const maxPow10Chunk = 308
 // no-op or infinite-precision handler to avoid runaway allocations.
 const maxPow10ChunkCount = 32
 
+// ContextPolicy selects the context accompanying the value emitted by an
+// aggregation operator (Reduce, Min, Max...) and its completion. See
+// WithContextPolicy.
+type ContextPolicy int8
+
+const (
+	// ContextPolicyDefault emits the value with the context of the item behind
+	// it: the selected item for Min and Max, the last accumulator result for
+	// Reduce, the completion for Sum, Count and Average. The completion keeps
+	// its own context.
+	ContextPolicyDefault ContextPolicy = iota
+	// ContextPolicyFirst emits the value and the completion with the context of
+	// the first item, or of the completion if the source is empty.
+	ContextPolicyFirst
+	// ContextPolicyLast emits the value and the completion with the context of
+	// the completion of the source, received after the last item.
+	ContextPolicyLast
+	// ContextPolicySubscription emits the value and the completion with the
+	// context of the subscription.
+	ContextPolicySubscription
+)
+
+// AggregateOption configures the aggregation operators (Reduce, Min, Max, Sum,
+// Count, Average...).
+type AggregateOption func(config *aggregateConfig)
+
+type aggregateConfig struct {
+	contextPolicy ContextPolicy
+}
+
+// WithContextPolicy selects the context accompanying the aggregated value and
+// the completion, so that both belong to the same trace. By default, Min emits
+// with the context of the minimal item, while the completion is sent with the
+// context of the completion of the source.
+func WithContextPolicy(policy ContextPolicy) AggregateOption {
+	if policy < ContextPolicyDefault || policy > ContextPolicySubscription {
+		panic(ErrAggregateWrongContextPolicy)
+	}
+
+	return func(config *aggregateConfig) {
+		config.contextPolicy = policy
+	}
+}
+
+func newAggregateConfig(opts []AggregateOption) aggregateConfig {
+	config := aggregateConfig{}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return config
+}
+
+// aggregateContext tracks the contexts of an aggregation, according to the
+// context policy.
+type aggregateContext struct {
+	policy        ContextPolicy
+	subscriberCtx context.Context
+	first         context.Context
+}
+
+func newAggregateContext(config aggregateConfig, subscriberCtx context.Context) *aggregateContext {
+	return &aggregateContext{
+		policy:        config.contextPolicy,
+		subscriberCtx: subscriberCtx,
+	}
+}
+
+// observe must be called with the context of each item.
+func (c *aggregateContext) observe(ctx context.Context) {
+	if c.first == nil {
+		c.first = ctx
+	}
+}
+
+// resolve returns the contexts of the aggregated value and of the completion.
+func (c *aggregateContext) resolve(valueCtx, completeCtx context.Context) (context.Context, context.Context) {
+	switch c.policy {
+	case ContextPolicyFirst:
+		if c.first != nil {
+			return c.first, c.first
+		}
+
+		return completeCtx, completeCtx
+	case ContextPolicyLast:
+		return completeCtx, completeCtx
+	case ContextPolicySubscription:
+		return c.subscriberCtx, c.subscriberCtx
+	default:
+		return valueCtx, completeCtx
+	}
+}
+
 // Average calculates the average of the values emitted by the source Observable.
 // It emits the average when the source completes. If the source is empty, it emits NaN.
+// See WithContextPolicy.
 // Play: https://go.dev/play/p/B0IhFEsQAin
-func Average[T constraints.Numeric]() func(Observable[T]) Observable[float64] {
+func Average[T constraints.Numeric](opts ...AggregateOption) func(Observable[T]) Observable[float64] {
+	config := newAggregateConfig(opts)
+
 	return func(source Observable[T]) Observable[float64] {
 		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[float64]) Teardown {
 			sum := float64(0)
 			count := int64(0)
+			contexts := newAggregateContext(config, subscriberCtx)
 
 			sub := source.SubscribeWithContext(
 				subscriberCtx,
 				NewObserverWithContext(
 					func(ctx context.Context, value T) {
+						contexts.observe(ctx)
 						sum += float64(value)
 						count++
 					},
 					destination.ErrorWithContext,
 					func(ctx context.Context) {
+						valueCtx, completeCtx := contexts.resolve(ctx, ctx)
+
 						if count == 0 {
-							destination.NextWithContext(ctx, math.NaN())
-							destination.CompleteWithContext(ctx)
+							destination.NextWithContext(valueCtx, math.NaN())
+							destination.CompleteWithContext(completeCtx)
 						}
 
 						avg := sum / float64(count)
-						destination.NextWithContext(ctx, avg)
-						destination.CompleteWithContext(ctx)
+						destination.NextWithContext(valueCtx, avg)
+						destination.CompleteWithContext(completeCtx)
 					},
 				),
 			)
@@ -75,23 +185,28 @@ func Average[T constraints.Numeric]() func(Observable[T]) Observable[float64] {
 }
 
 // Count counts the number of values emitted by the source Observable.
-// It emits the count when the source completes.
+// It emits the count when the source completes. See WithContextPolicy.
 // Play: https://go.dev/play/p/igtOxOLeHPp
-func Count[T any]() func(Observable[T]) Observable[int64] {
+func Count[T any](opts ...AggregateOption) func(Observable[T]) Observable[int64] {
+	config := newAggregateConfig(opts)
+
 	return func(source Observable[T]) Observable[int64] {
 		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[int64]) Teardown {
 			count := int64(0)
+			contexts := newAggregateContext(config, subscriberCtx)
 
 			sub := source.SubscribeWithContext(
 				subscriberCtx,
 				NewObserverWithContext(
 					func(ctx context.Context, value T) {
+						contexts.observe(ctx)
 						count++
 					},
 					destination.ErrorWithContext,
 					func(ctx context.Context) {
-						destination.NextWithContext(ctx, count)
-						destination.CompleteWithContext(ctx)
+						valueCtx, completeCtx := contexts.resolve(ctx, ctx)
+						destination.NextWithContext(valueCtx, count)
+						destination.CompleteWithContext(completeCtx)
 					},
 				),
 			)
@@ -102,23 +217,29 @@ func Count[T any]() func(Observable[T]) Observable[int64] {
 }
 
 // Sum calculates the sum of the values emitted by the source Observable.
-// It emits the sum when the source completes.
+// It emits the sum when the source completes. See WithContextPolicy.
 // Play: https://go.dev/play/p/b3rRlI80igo
-func Sum[T constraints.Numeric]() func(Observable[T]) Observable[T] {
+func Sum[T constraints.Numeric](opts ...AggregateOption) func(Observable[T]) Observable[T] {
+	config := newAggregateConfig(opts)
+
 	return func(source Observable[T]) Observable[T] {
 		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
 			var sum T
 
+			contexts := newAggregateContext(config, subscriberCtx)
+
 			sub := source.SubscribeWithContext(
 				subscriberCtx,
 				NewObserverWithContext(
 					func(ctx context.Context, value T) {
+						contexts.observe(ctx)
 						sum += value
 					},
 					destination.ErrorWithContext,
 					func(ctx context.Context) {
-						destination.NextWithContext(ctx, sum)
-						destination.CompleteWithContext(ctx)
+						valueCtx, completeCtx := contexts.resolve(ctx, ctx)
+						destination.NextWithContext(valueCtx, sum)
+						destination.CompleteWithContext(completeCtx)
 					},
 				),
 			)
@@ -151,19 +272,24 @@ func Round() func(Observable[float64]) Observable[float64] {
 
 // Min emits the minimum value emitted by the source Observable.
 // It emits the minimum value when the source completes. If the source is empty,
-// it emits no value.
+// it emits no value. See WithContextPolicy.
 // Play: https://go.dev/play/p/SPK3L-NvZ98
-func Min[T constraints.Numeric]() func(Observable[T]) Observable[T] {
+func Min[T constraints.Numeric](opts ...AggregateOption) func(Observable[T]) Observable[T] {
+	config := newAggregateConfig(opts)
+
 	return func(source Observable[T]) Observable[T] {
 		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
 			var mIn lo.Tuple2[context.Context, T]
 
 			first := true
+			contexts := newAggregateContext(config, subscriberCtx)
 
 			sub := source.SubscribeWithContext(
 				subscriberCtx,
 				NewObserverWithContext(
 					func(ctx context.Context, value T) {
+						contexts.observe(ctx)
+
 						if first || value < mIn.B {
 							mIn = lo.T2(ctx, value)
 							first = false
@@ -171,11 +297,13 @@ func Min[T constraints.Numeric]() func(Observable[T]) Observable[T] {
 					},
 					destination.ErrorWithContext,
 					func(ctx context.Context) {
+						valueCtx, completeCtx := contexts.resolve(mIn.A, ctx)
+
 						if !first {
-							destination.NextWithContext(mIn.A, mIn.B)
+							destination.NextWithContext(valueCtx, mIn.B)
 						}
 
-						destination.CompleteWithContext(ctx)
+						destination.CompleteWithContext(completeCtx)
 					},
 				),
 			)
@@ -187,18 +315,24 @@ func Min[T constraints.Numeric]() func(Observable[T]) Observable[T] {
 
 // Max emits the maximum value emitted by the source Observable. It emits the
 // maximum value when the source completes. If the source is empty, it emits no value.
+// See WithContextPolicy.
 // Play: https://go.dev/play/p/wWljVN6i1Ip
-func Max[T constraints.Numeric]() func(Observable[T]) Observable[T] {
+func Max[T constraints.Numeric](opts ...AggregateOption) func(Observable[T]) Observable[T] {
+	config := newAggregateConfig(opts)
+
 	return func(source Observable[T]) Observable[T] {
 		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
 			var mAx lo.Tuple2[context.Context, T]
 
 			first := true
+			contexts := newAggregateContext(config, subscriberCtx)
 
 			sub := source.SubscribeWithContext(
 				subscriberCtx,
 				NewObserverWithContext(
 					func(ctx context.Context, value T) {
+						contexts.observe(ctx)
+
 						if first || value > mAx.B {
 							mAx = lo.T2(ctx, value)
 							first = false
@@ -206,8 +340,88 @@ func Max[T constraints.Numeric]() func(Observable[T]) Observable[T] {
 					},
 					destination.ErrorWithContext,
 					func(ctx context.Context) {
-						destination.NextWithContext(mAx.A, mAx.B)
-						destination.CompleteWithContext(ctx)
+						valueCtx, completeCtx := contexts.resolve(mAx.A, ctx)
+						destination.NextWithContext(valueCtx, mAx.B)
+						destination.CompleteWithContext(completeCtx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// MinOrdered emits the minimum value emitted by the source Observable. Unlike
+// Min, it accepts any ordered type, such as strings. It emits the minimum value
+// when the source completes. If the source is empty, it emits no value.
+func MinOrdered[T constraints.Ordered](opts ...AggregateOption) func(Observable[T]) Observable[T] {
+	return MinBy(func(item T) T {
+		return item
+	}, opts...)
+}
+
+// MaxOrdered emits the maximum value emitted by the source Observable. Unlike
+// Max, it accepts any ordered type, such as strings. It emits the maximum value
+// when the source completes. If the source is empty, it emits no value.
+func MaxOrdered[T constraints.Ordered](opts ...AggregateOption) func(Observable[T]) Observable[T] {
+	return MaxBy(func(item T) T {
+		return item
+	}, opts...)
+}
+
+// MinBy emits the item having the minimum key, as returned by the key selector.
+// In case of equality, the first item is kept. It emits the item when the source
+// completes. If the source is empty, it emits no value. See WithContextPolicy.
+func MinBy[T any, K constraints.Ordered](keySelector func(item T) K, opts ...AggregateOption) func(Observable[T]) Observable[T] {
+	return extremumBy(keySelector, func(a, b K) bool {
+		return a < b
+	}, opts)
+}
+
+// MaxBy emits the item having the maximum key, as returned by the key selector.
+// In case of equality, the first item is kept. It emits the item when the source
+// completes. If the source is empty, it emits no value. See WithContextPolicy.
+func MaxBy[T any, K constraints.Ordered](keySelector func(item T) K, opts ...AggregateOption) func(Observable[T]) Observable[T] {
+	return extremumBy(keySelector, func(a, b K) bool {
+		return a > b
+	}, opts)
+}
+
+func extremumBy[T any, K constraints.Ordered](keySelector func(item T) K, better func(a, b K) bool, opts []AggregateOption) func(Observable[T]) Observable[T] {
+	config := newAggregateConfig(opts)
+
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			var best lo.Tuple2[context.Context, T]
+
+			var bestKey K
+
+			first := true
+			contexts := newAggregateContext(config, subscriberCtx)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						contexts.observe(ctx)
+
+						key := keySelector(value)
+						if first || better(key, bestKey) {
+							best = lo.T2(ctx, value)
+							bestKey = key
+							first = false
+						}
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						valueCtx, completeCtx := contexts.resolve(best.A, ctx)
+
+						if !first {
+							destination.NextWithContext(valueCtx, best.B)
+						}
+
+						destination.CompleteWithContext(completeCtx)
 					},
 				),
 			)
@@ -336,6 +550,18 @@ func CeilWithPrecision(places int) func(Observable[float64]) Observable[float64]
 	return precisionRound(ceilPrecisionRoundMode(), places)
 }
 
+// RoundWithPrecision emits the values emitted by the source Observable, rounded
+// half away from zero to the provided decimal precision. Positive precisions round
+// to the specified number of digits to the right of the decimal point (e.g.
+// places=2 turns 1.235 -> 1.24), zero behaves like `Round()`, while negative
+// precisions round to powers of ten (e.g. places=-1 turns 125 -> 130).
+//
+// Like FloorWithPrecision and CeilWithPrecision, very large precision magnitudes
+// are handled with chunked big.Float arithmetic.
+func RoundWithPrecision(places int) func(Observable[float64]) Observable[float64] {
+	return precisionRound(roundPrecisionRoundMode(), places)
+}
+
 func ceilWithInfiniteNegativePrecision() func(Observable[float64]) Observable[float64] {
 	return func(source Observable[float64]) Observable[float64] {
 		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[float64]) Teardown {
@@ -394,6 +620,30 @@ func floorWithInfiniteNegativePrecision() func(Observable[float64]) Observable[f
 	}
 }
 
+func roundWithInfiniteNegativePrecision() func(Observable[float64]) Observable[float64] {
+	return func(source Observable[float64]) Observable[float64] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[float64]) Teardown {
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value float64) {
+						if math.IsNaN(value) || math.IsInf(value, 0) {
+							destination.NextWithContext(ctx, math.Round(value))
+							return
+						}
+
+						destination.NextWithContext(ctx, 0)
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
 type precisionRoundMode struct {
 	round                     func(float64) float64
 	bigRound                  func(*big.Float) *big.Float
@@ -441,6 +691,25 @@ func ceilPrecisionRoundMode() precisionRoundMode {
 	}
 }
 
+func roundPrecisionRoundMode() precisionRoundMode {
+	return precisionRoundMode{
+		round:    math.Round,
+		bigRound: roundBigFloat,
+		// An underflowing scaled value is below 0.5 and rounds to zero: the
+		// small factor path is never needed.
+		shouldUseSmallFactor: nil,
+		fallbackInfinity: func(places int, value float64) (float64, bool) {
+			if places < 0 && !math.IsNaN(value) && !math.IsInf(value, 0) && value != 0 {
+				return math.Copysign(math.Inf(1), value), true
+			}
+
+			return 0, false
+		},
+		infiniteNegativePrecision: roundWithInfiniteNegativePrecision,
+		simpleOperator:            Round,
+	}
+}
+
 func precisionRound(mode precisionRoundMode, places int) func(Observable[float64]) Observable[float64] {
 	if places < 0 {
 		if places == math.MinInt {
@@ -791,6 +1060,23 @@ func floorBigFloat(x *big.Float) *big.Float {
 	return result
 }
 
+// roundBigFloat rounds half away from zero, like math.Round.
+func roundBigFloat(x *big.Float) *big.Float {
+	prec := x.Prec()
+
+	half := new(big.Float).SetPrec(prec).SetFloat64(0.5)
+	if x.Sign() < 0 {
+		half.Neg(half)
+	}
+
+	shifted := new(big.Float).SetPrec(prec).Add(x, half)
+
+	integer := new(big.Int)
+	shifted.Int(integer) // truncates toward zero
+
+	return new(big.Float).SetPrec(prec).SetInt(integer)
+}
+
 // Trunc emits the truncated values emitted by the source Observable.
 // Play: https://go.dev/play/p/iYc9oGDgRZJ
 func Trunc() func(Observable[float64]) Observable[float64] {
@@ -814,37 +1100,39 @@ func Trunc() func(Observable[float64]) Observable[float64] {
 
 // Reduce applies an accumulator function over the source Observable, and emits
 // the result when the source completes. It takes a seed value as the initial
-// accumulator value.
+// accumulator value. See WithContextPolicy.
 // Play: https://go.dev/play/p/GpOF9eNpA5w
-func Reduce[T, R any](accumulator func(agg R, item T) R, seed R) func(Observable[T]) Observable[R] {
+func Reduce[T, R any](accumulator func(agg R, item T) R, seed R, opts ...AggregateOption) func(Observable[T]) Observable[R] {
 	return ReduceIWithContext(func(ctx context.Context, agg R, item T, _ int64) (context.Context, R) {
 		return ctx, accumulator(agg, item)
-	}, seed)
+	}, seed, opts...)
 }
 
 // ReduceWithContext applies an accumulator function over the source Observable, and emits
 // the result when the source completes. It takes a seed value as the initial
-// accumulator value.
-func ReduceWithContext[T, R any](accumulator func(ctx context.Context, agg R, item T) (context.Context, R), seed R) func(Observable[T]) Observable[R] {
+// accumulator value. See WithContextPolicy.
+func ReduceWithContext[T, R any](accumulator func(ctx context.Context, agg R, item T) (context.Context, R), seed R, opts ...AggregateOption) func(Observable[T]) Observable[R] {
 	return ReduceIWithContext(func(ctx context.Context, agg R, item T, _ int64) (context.Context, R) {
 		return accumulator(ctx, agg, item)
-	}, seed)
+	}, seed, opts...)
 }
 
 // ReduceI applies an accumulator function over the source Observable, and emits
 // the result when the source completes. It takes a seed value as the initial
-// accumulator value.
-func ReduceI[T, R any](accumulator func(agg R, item T, index int64) R, seed R) func(Observable[T]) Observable[R] {
+// accumulator value. See WithContextPolicy.
+func ReduceI[T, R any](accumulator func(agg R, item T, index int64) R, seed R, opts ...AggregateOption) func(Observable[T]) Observable[R] {
 	return ReduceIWithContext(func(ctx context.Context, agg R, item T, index int64) (context.Context, R) {
 		return ctx, accumulator(agg, item, index)
-	}, seed)
+	}, seed, opts...)
 }
 
 // ReduceIWithContext applies an accumulator function over the source Observable,
 // and emits the result when the source completes. It takes a seed value as the
-// initial accumulator value.
+// initial accumulator value. See WithContextPolicy.
 // Play: https://go.dev/play/p/WALnb341F4U
-func ReduceIWithContext[T, R any](accumulator func(ctx context.Context, agg R, item T, index int64) (context.Context, R), seed R) func(Observable[T]) Observable[R] {
+func ReduceIWithContext[T, R any](accumulator func(ctx context.Context, agg R, item T, index int64) (context.Context, R), seed R, opts ...AggregateOption) func(Observable[T]) Observable[R] {
+	config := newAggregateConfig(opts)
+
 	return func(source Observable[T]) Observable[R] {
 		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[R]) Teardown {
 			output := seed
@@ -852,22 +1140,195 @@ func ReduceIWithContext[T, R any](accumulator func(ctx context.Context, agg R, i
 			var lastCtx context.Context
 
 			i := int64(0)
+			contexts := newAggregateContext(config, subscriberCtx)
 
 			sub := source.SubscribeWithContext(
 				subscriberCtx,
 				NewObserverWithContext(
 					func(ctx context.Context, value T) {
+						contexts.observe(ctx)
 						lastCtx, output = accumulator(ctx, output, value, i)
 						i++
 					},
 					destination.ErrorWithContext,
 					func(ctx context.Context) {
 						if i == 0 {
-							destination.NextWithContext(ctx, output)
+							lastCtx = ctx
+						}
+
+						valueCtx, completeCtx := contexts.resolve(lastCtx, ctx)
+						destination.NextWithContext(valueCtx, output)
+						destination.CompleteWithContext(completeCtx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// SumBatch calculates the sum of all the values of the batches emitted by the
+// source Observable. It emits the sum when the source completes. It is meant to
+// be fed by columnar readers: each batch is summed in a tight loop, with
+// independent accumulators to help the CPU pipeline the additions.
+func SumBatch() func(Observable[[]float64]) Observable[float64] {
+	return func(source Observable[[]float64]) Observable[float64] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[float64]) Teardown {
+			sum := float64(0)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, batch []float64) {
+						sum += sumFloat64s(batch)
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						destination.NextWithContext(ctx, sum)
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// AverageBatch calculates the average of all the values of the batches emitted
+// by the source Observable. It emits the average when the source completes. If
+// the source is empty or emits only empty batches, it emits NaN.
+func AverageBatch() func(Observable[[]float64]) Observable[float64] {
+	return func(source Observable[[]float64]) Observable[float64] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[float64]) Teardown {
+			sum := float64(0)
+			count := int64(0)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, batch []float64) {
+						sum += sumFloat64s(batch)
+						count += int64(len(batch))
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						if count == 0 {
+							destination.NextWithContext(ctx, math.NaN())
 						} else {
-							destination.NextWithContext(lastCtx, output)
+							destination.NextWithContext(ctx, sum/float64(count))
+						}
+
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// MinMaxBatch emits the minimum and the maximum of all the values of the batches
+// emitted by the source Observable, as a tuple. It emits when the source
+// completes. If the source is empty or emits only empty batches, it emits no
+// value. NaN values are ignored.
+func MinMaxBatch() func(Observable[[]float64]) Observable[lo.Tuple2[float64, float64]] {
+	return func(source Observable[[]float64]) Observable[lo.Tuple2[float64, float64]] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[lo.Tuple2[float64, float64]]) Teardown {
+			minimum := math.Inf(1)
+			maximum := math.Inf(-1)
+			found := false
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, batch []float64) {
+						batchMin, batchMax, ok := minMaxFloat64s(batch)
+						if !ok {
+							return
+						}
+
+						found = true
+
+						if batchMin < minimum {
+							minimum = batchMin
+						}
+
+						if batchMax > maximum {
+							maximum = batchMax
+						}
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						if found {
+							destination.NextWithContext(ctx, lo.T2(minimum, maximum))
+						}
+
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// Percentile emits the p-th percentile (0 <= p <= 100) of the values emitted by
+// the source Observable, when the source completes. The percentile is exact: it
+// is linearly interpolated between the two closest ranks, and every value is kept
+// in memory until completion. If the source is empty, it emits NaN. See
+// PercentileApprox for unbounded streams.
+func Percentile[T constraints.Numeric](p float64) func(Observable[T]) Observable[float64] {
+	if !(p >= 0 && p <= 100) {
+		panic(ErrPercentileWrongPercentile)
+	}
+
+	return func(source Observable[T]) Observable[float64] {
+		return Pipe2(
+			source,
+			Quantiles[T](p/100),
+			Map(func(quantiles []float64) float64 {
+				return quantiles[0]
+			}),
+		)
+	}
+}
+
+// Quantiles emits the quantiles (0 <= q <= 1) of the values emitted by the source
+// Observable, in the order of the requested quantiles, when the source completes.
+// Quantiles are exact: they are linearly interpolated between the two closest
+// ranks, and every value is kept in memory until completion. If the source is
+// empty, every quantile is NaN.
+func Quantiles[T constraints.Numeric](qs ...float64) func(Observable[T]) Observable[[]float64] {
+	for _, q := range qs {
+		if !(q >= 0 && q <= 1) {
+			panic(ErrQuantilesWrongQuantile)
+		}
+	}
+
+	return func(source Observable[T]) Observable[[]float64] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[[]float64]) Teardown {
+			values := []float64{}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						values = append(values, float64(value))
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						sort.Float64s(values)
+
+						result := make([]float64, len(qs))
+						for i, q := range qs {
+							result[i] = sortedQuantile(values, q)
 						}
 
+						destination.NextWithContext(ctx, result)
 						destination.CompleteWithContext(ctx)
 					},
 				),
@@ -877,3 +1338,1201 @@ func ReduceIWithContext[T, R any](accumulator func(ctx context.Context, agg R, i
 		})
 	}
 }
+
+// PercentileApprox emits an estimation of the p-th percentile (0 <= p <= 100) of
+// the values received so far, for each value emitted by the source Observable.
+// It implements the P² algorithm (Jain & Chlamtac, 1985): memory is constant, which
+// makes it suitable for unbounded streams. The estimation is exact for the first
+// five values.
+func PercentileApprox[T constraints.Numeric](p float64) func(Observable[T]) Observable[float64] {
+	if !(p >= 0 && p <= 100) {
+		panic(ErrPercentileApproxWrongPercentile)
+	}
+
+	return func(source Observable[T]) Observable[float64] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[float64]) Teardown {
+			estimator := newP2Quantile(p / 100)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						estimator.add(float64(value))
+						destination.NextWithContext(ctx, estimator.quantile())
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// Variance calculates the population variance of the values emitted by the
+// source Observable, in a single pass with Welford's online algorithm. It emits
+// the variance when the source completes. If the source is empty, it emits NaN.
+func Variance[T constraints.Numeric]() func(Observable[T]) Observable[float64] {
+	return welfordAggregate[T](func(w *welford) float64 {
+		return w.variance()
+	})
+}
+
+// StdDev calculates the population standard deviation of the values emitted by
+// the source Observable, in a single pass with Welford's online algorithm. It
+// emits the standard deviation when the source completes. If the source is
+// empty, it emits NaN.
+func StdDev[T constraints.Numeric]() func(Observable[T]) Observable[float64] {
+	return welfordAggregate[T](func(w *welford) float64 {
+		return math.Sqrt(w.variance())
+	})
+}
+
+func welfordAggregate[T constraints.Numeric](result func(w *welford) float64) func(Observable[T]) Observable[float64] {
+	return func(source Observable[T]) Observable[float64] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[float64]) Teardown {
+			w := welford{}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						w.add(float64(value))
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						destination.NextWithContext(ctx, result(&w))
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// MovingAverage emits, for each value emitted by the source Observable, the mean
+// of the last `window` values (or fewer, until the window is full).
+func MovingAverage[T constraints.Numeric](window int) func(Observable[T]) Observable[float64] {
+	if window <= 0 {
+		panic(ErrMovingAverageWrongWindow)
+	}
+
+	return func(source Observable[T]) Observable[float64] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[float64]) Teardown {
+			ring := make([]float64, window)
+			next := 0
+			size := 0
+			sum := compensatedSum{}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						v := float64(value)
+
+						if size == window {
+							sum.add(-ring[next])
+						} else {
+							size++
+						}
+
+						ring[next] = v
+						sum.add(v)
+						next = (next + 1) % window
+
+						destination.NextWithContext(ctx, sum.value()/float64(size))
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// MovingAverageTime emits, for each value emitted by the source Observable, the
+// mean of the values received during the last duration, including the current one.
+func MovingAverageTime[T constraints.Numeric](duration time.Duration) func(Observable[T]) Observable[float64] {
+	if duration <= 0 {
+		panic(ErrMovingAverageTimeWrongDuration)
+	}
+
+	type timedValue struct {
+		at    time.Time
+		value float64
+	}
+
+	return func(source Observable[T]) Observable[float64] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[float64]) Teardown {
+			values := []timedValue{}
+			sum := compensatedSum{}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						now := time.Now()
+						v := float64(value)
+
+						expired := 0
+						for expired < len(values) && now.Sub(values[expired].at) >= duration {
+							sum.add(-values[expired].value)
+							expired++
+						}
+
+						if expired == len(values) {
+							// restart from a clean sum when the window is empty
+							sum = compensatedSum{}
+						}
+
+						values = append(values[expired:], timedValue{at: now, value: v})
+						sum.add(v)
+
+						destination.NextWithContext(ctx, sum.value()/float64(len(values)))
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// EWMA emits, for each value emitted by the source Observable, the exponentially
+// weighted moving average of the values received so far:
+// avg = alpha*value + (1-alpha)*avg. The first value seeds the average. A higher
+// alpha (0 < alpha <= 1) discounts older values faster.
+func EWMA[T constraints.Numeric](alpha float64) func(Observable[T]) Observable[float64] {
+	if !(alpha > 0 && alpha <= 1) {
+		panic(ErrEWMAWrongAlpha)
+	}
+
+	return func(source Observable[T]) Observable[float64] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[float64]) Teardown {
+			avg := float64(0)
+			seeded := false
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if seeded {
+							avg += alpha * (float64(value) - avg)
+						} else {
+							avg = float64(value)
+							seeded = true
+						}
+
+						destination.NextWithContext(ctx, avg)
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// HistogramOption configures the Histogram operator.
+type HistogramOption func(config *histogramConfig)
+
+type histogramConfig struct {
+	interval time.Duration
+}
+
+// WithHistogramInterval makes Histogram emit the bucket counts periodically, in
+// addition to the completion. Counts are cumulative since the subscription.
+func WithHistogramInterval(interval time.Duration) HistogramOption {
+	if interval <= 0 {
+		panic(ErrHistogramWrongInterval)
+	}
+
+	return func(config *histogramConfig) {
+		config.interval = interval
+	}
+}
+
+// Histogram counts the values emitted by the source Observable into buckets. The
+// buckets are the upper bounds (inclusive) of the ranges and must be sorted in
+// strictly increasing order. The emitted slice has len(buckets)+1 counts: the
+// i-th count holds the values in (buckets[i-1], buckets[i]], and the last one
+// holds the values greater than the last bound. NaN values are ignored.
+//
+// It emits the counts when the source completes, and periodically when
+// WithHistogramInterval is set. Each emission is a new slice.
+func Histogram[T constraints.Numeric](buckets []float64, opts ...HistogramOption) func(Observable[T]) Observable[[]int64] {
+	for i := 1; i < len(buckets); i++ {
+		if !(buckets[i-1] < buckets[i]) {
+			panic(ErrHistogramWrongBuckets)
+		}
+	}
+
+	config := histogramConfig{}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	bounds := append([]float64{}, buckets...)
+
+	return func(source Observable[T]) Observable[[]int64] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[[]int64]) Teardown {
+			counts := make([]int64, len(bounds)+1)
+			mu := xsync.NewMutexWithSpinlock()
+
+			snapshot := func() []int64 {
+				mu.Lock()
+				defer mu.Unlock()
+
+				return append([]int64{}, counts...)
+			}
+
+			subscriptions := NewSubscription(nil)
+
+			subscriptions.AddUnsubscribable(
+				source.SubscribeWithContext(
+					subscriberCtx,
+					NewObserverWithContext(
+						func(ctx context.Context, value T) {
+							v := float64(value)
+							if math.IsNaN(v) {
+								return
+							}
+
+							i := sort.SearchFloat64s(bounds, v)
+
+							mu.Lock()
+							counts[i]++
+							mu.Unlock()
+						},
+						destination.ErrorWithContext,
+						func(ctx context.Context) {
+							destination.NextWithContext(ctx, snapshot())
+							destination.CompleteWithContext(ctx)
+						},
+					),
+				),
+			)
+
+			if config.interval > 0 {
+				subscriptions.AddUnsubscribable(
+					Interval(config.interval).SubscribeWithContext(
+						subscriberCtx,
+						NewObserverWithContext(
+							func(ctx context.Context, _ int64) {
+								destination.NextWithContext(ctx, snapshot())
+							},
+							destination.ErrorWithContext,
+							func(ctx context.Context) {},
+						),
+					),
+				)
+			}
+
+			return subscriptions.Unsubscribe
+		})
+	}
+}
+
+// TopK emits the k largest values emitted by the source Observable, largest
+// first, when the source completes. Only k values are kept in memory. In case of
+// equality, the first values are kept.
+func TopK[T constraints.Ordered](k int) func(Observable[T]) Observable[T] {
+	if k < 0 {
+		panic(ErrTopKWrongCount)
+	}
+
+	return topK(k, func(a, b T) bool {
+		return a < b
+	})
+}
+
+// TopKBy emits the k largest values emitted by the source Observable, according
+// to the less function, largest first, when the source completes. Only k values
+// are kept in memory. In case of equality, the first values are kept.
+func TopKBy[T any](k int, less func(a, b T) bool) func(Observable[T]) Observable[T] {
+	if k < 0 {
+		panic(ErrTopKWrongCount)
+	}
+
+	return topK(k, less)
+}
+
+// BottomK emits the k smallest values emitted by the source Observable, smallest
+// first, when the source completes. Only k values are kept in memory. In case of
+// equality, the first values are kept.
+func BottomK[T constraints.Ordered](k int) func(Observable[T]) Observable[T] {
+	if k < 0 {
+		panic(ErrBottomKWrongCount)
+	}
+
+	return topK(k, func(a, b T) bool {
+		return a > b
+	})
+}
+
+// BottomKBy emits the k smallest values emitted by the source Observable,
+// according to the less function, smallest first, when the source completes.
+// Only k values are kept in memory. In case of equality, the first values are kept.
+func BottomKBy[T any](k int, less func(a, b T) bool) func(Observable[T]) Observable[T] {
+	if k < 0 {
+		panic(ErrBottomKWrongCount)
+	}
+
+	return topK(k, func(a, b T) bool {
+		return less(b, a)
+	})
+}
+
+func topK[T any](k int, less func(a, b T) bool) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			h := &topKHeap[T]{less: less}
+			index := int64(0)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						item := topKItem[T]{value: value, index: index}
+						index++
+
+						if len(h.items) < k {
+							heap.Push(h, item)
+						} else if k > 0 && h.worse(h.items[0], item) {
+							h.items[0] = item
+							heap.Fix(h, 0)
+						}
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						items := h.items
+						sort.Slice(items, func(i, j int) bool {
+							return h.worse(items[j], items[i])
+						})
+
+						for _, item := range items {
+							destination.NextWithContext(ctx, item.value)
+						}
+
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+type topKItem[T any] struct {
+	value T
+	index int64
+}
+
+// topKHeap is a heap whose root is the worst kept item, so that it can be
+// replaced by a better one in O(log k).
+type topKHeap[T any] struct {
+	items []topKItem[T]
+	less  func(a, b T) bool
+}
+
+var _ heap.Interface = (*topKHeap[int])(nil)
+
+// worse reports whether a ranks after b. On equality, the latest item is worse.
+func (h *topKHeap[T]) worse(a, b topKItem[T]) bool {
+	if h.less(a.value, b.value) {
+		return true
+	} else if h.less(b.value, a.value) {
+		return false
+	}
+
+	return a.index > b.index
+}
+
+func (h *topKHeap[T]) Len() int {
+	return len(h.items)
+}
+
+func (h *topKHeap[T]) Less(i, j int) bool {
+	return h.worse(h.items[i], h.items[j])
+}
+
+func (h *topKHeap[T]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+func (h *topKHeap[T]) Push(x any) {
+	h.items = append(h.items, x.(topKItem[T]))
+}
+
+func (h *topKHeap[T]) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+
+	return last
+}
+
+// FrequencyOption configures the frequency counting operators (Mode, CountBy).
+type FrequencyOption func(config *frequencyConfig)
+
+type frequencyConfig struct {
+	maxKeys int
+}
+
+// WithFrequencyMaxKeys bounds the number of distinct keys kept in memory, for
+// high-cardinality streams. Once the limit is reached, a new key replaces the
+// least frequent one and inherits its count, plus one (Space-Saving algorithm): counts
+// become upper bounds, while the most frequent keys are still reliably tracked.
+func WithFrequencyMaxKeys(maxKeys int) FrequencyOption {
+	if maxKeys <= 0 {
+		panic(ErrFrequencyWrongMaxKeys)
+	}
+
+	return func(config *frequencyConfig) {
+		config.maxKeys = maxKeys
+	}
+}
+
+// Mode emits the most frequent values emitted by the source Observable, when the
+// source completes. In case of equality, every most frequent value is emitted, in
+// order of first appearance. If the source is empty, it emits no value. See
+// WithFrequencyMaxKeys to bound the memory.
+func Mode[T comparable](opts ...FrequencyOption) func(Observable[T]) Observable[T] {
+	config := newFrequencyConfig(opts)
+
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			counter := newFrequencyCounter[T](config)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						counter.add(value)
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						for _, value := range counter.modes() {
+							destination.NextWithContext(ctx, value)
+						}
+
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// CountBy counts the values emitted by the source Observable by key, as returned
+// by the key selector. It emits the frequency map when the source completes. See
+// WithFrequencyMaxKeys to bound the memory.
+func CountBy[T any, K comparable](keySelector func(item T) K, opts ...FrequencyOption) func(Observable[T]) Observable[map[K]int64] {
+	config := newFrequencyConfig(opts)
+
+	return func(source Observable[T]) Observable[map[K]int64] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[map[K]int64]) Teardown {
+			counter := newFrequencyCounter[K](config)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						counter.add(keySelector(value))
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						destination.NextWithContext(ctx, counter.counts())
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// Stats holds the running statistics emitted by RunningStats. StdDev is the
+// population standard deviation.
+type Stats struct {
+	Count  int64
+	Sum    float64
+	Min    float64
+	Max    float64
+	Mean   float64
+	StdDev float64
+}
+
+// RunningStats emits, for each value emitted by the source Observable, the
+// statistics of the values received so far, computed in a single pass (Welford's
+// online algorithm for the standard deviation).
+func RunningStats[T constraints.Numeric]() func(Observable[T]) Observable[Stats] {
+	return func(source Observable[T]) Observable[Stats] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[Stats]) Teardown {
+			w := welford{}
+			stats := Stats{}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						v := float64(value)
+						w.add(v)
+
+						if stats.Count == 0 || v < stats.Min {
+							stats.Min = v
+						}
+
+						if stats.Count == 0 || v > stats.Max {
+							stats.Max = v
+						}
+
+						stats.Count = w.count
+						stats.Sum += v
+						stats.Mean = w.mean
+						stats.StdDev = math.Sqrt(w.variance())
+
+						destination.NextWithContext(ctx, stats)
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// SumBig calculates the sum of the integers emitted by the source Observable,
+// using an arbitrary-precision integer that cannot overflow. It emits the sum when
+// the source completes.
+func SumBig[T constraints.Integer]() func(Observable[T]) Observable[*big.Int] {
+	return func(source Observable[T]) Observable[*big.Int] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[*big.Int]) Teardown {
+			sum := new(big.Int)
+			tmp := new(big.Int)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if value < 0 {
+							tmp.SetInt64(int64(value))
+						} else {
+							tmp.SetUint64(uint64(value))
+						}
+
+						sum.Add(sum, tmp)
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						destination.NextWithContext(ctx, sum)
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// SumBigFloat calculates the sum of the values emitted by the source Observable,
+// using a 512-bit big.Float accumulator that neither overflows nor loses the
+// small values of very long streams. It emits the sum when the source completes.
+// NaN values, or infinite values of opposite signs, emit ErrSumBigFloatNaN.
+func SumBigFloat[T constraints.Numeric]() func(Observable[T]) Observable[*big.Float] {
+	return func(source Observable[T]) Observable[*big.Float] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[*big.Float]) Teardown {
+			sum := newBigFloatSum[T]()
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if !sum.add(value) {
+							destination.ErrorWithContext(ctx, ErrSumBigFloatNaN)
+						}
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						destination.NextWithContext(ctx, sum.sum)
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// AverageBig calculates the average of the values emitted by the source
+// Observable, using a 512-bit big.Float accumulator that neither overflows nor
+// loses the small values of very long streams. It emits the average when the
+// source completes. If the source is empty, it emits no value. NaN values, or
+// infinite values of opposite signs, emit ErrAverageBigNaN.
+//
+// Use Map with (*big.Float).Float64 for a lossy float64 result.
+func AverageBig[T constraints.Numeric]() func(Observable[T]) Observable[*big.Float] {
+	return func(source Observable[T]) Observable[*big.Float] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[*big.Float]) Teardown {
+			sum := newBigFloatSum[T]()
+			count := uint64(0)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if !sum.add(value) {
+							destination.ErrorWithContext(ctx, ErrAverageBigNaN)
+							return
+						}
+
+						count++
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						if count > 0 {
+							divisor := new(big.Float).SetPrec(bigFloatSumPrec).SetUint64(count)
+							destination.NextWithContext(ctx, sum.sum.Quo(sum.sum, divisor))
+						}
+
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// Covariance calculates the population covariance of the pairs of values emitted
+// by the source Observable, in a single pass. It emits the covariance when the
+// source completes. If the source is empty, it emits NaN. See Zip2 to pair two
+// Observables.
+func Covariance[T constraints.Numeric]() func(Observable[lo.Tuple2[T, T]]) Observable[float64] {
+	return comomentAggregate[T](func(c *comoment) float64 {
+		return c.covariance()
+	})
+}
+
+// Correlation calculates the Pearson correlation coefficient of the pairs of
+// values emitted by the source Observable, in a single pass. It emits the
+// coefficient, between -1 and 1, when the source completes. If the source is
+// empty or one of the series is constant, it emits NaN. See Zip2 to pair two
+// Observables.
+func Correlation[T constraints.Numeric]() func(Observable[lo.Tuple2[T, T]]) Observable[float64] {
+	return comomentAggregate[T](func(c *comoment) float64 {
+		return c.correlation()
+	})
+}
+
+func comomentAggregate[T constraints.Numeric](result func(c *comoment) float64) func(Observable[lo.Tuple2[T, T]]) Observable[float64] {
+	return func(source Observable[lo.Tuple2[T, T]]) Observable[float64] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[float64]) Teardown {
+			c := comoment{}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value lo.Tuple2[T, T]) {
+						c.add(float64(value.A), float64(value.B))
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						destination.NextWithContext(ctx, result(&c))
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// RatePerSecond emits the per-second rate of change between each value emitted by
+// the source Observable and the previous one, measured with the arrival times of
+// the values. It is typically used to turn monotonically increasing counters into
+// rates. A value lower than the previous one is treated as a counter reset: the
+// counter is assumed to have restarted from zero, and the value itself is the
+// increase. The first value emits nothing.
+func RatePerSecond[T constraints.Numeric]() func(Observable[T]) Observable[float64] {
+	return func(source Observable[T]) Observable[float64] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[float64]) Teardown {
+			var previous float64
+			var previousAt int64
+
+			first := true
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						now := xtime.NowNanoMonotonic()
+						v := float64(value)
+
+						if first {
+							previous, previousAt, first = v, now, false
+							return
+						}
+
+						elapsed := time.Duration(now - previousAt)
+						if elapsed <= 0 {
+							// same clock tick: the rate is undefined
+							return
+						}
+
+						delta := v - previous
+						if delta < 0 {
+							// counter reset
+							delta = v
+						}
+
+						previous, previousAt = v, now
+
+						destination.NextWithContext(ctx, delta/elapsed.Seconds())
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// sumFloat64s sums a batch with 4 independent accumulators. Unrolling breaks
+// the dependency chain between additions, so that the CPU can execute them in
+// parallel. The result may differ from a sequential sum in the last ulp.
+func sumFloat64s(batch []float64) float64 {
+	var s0, s1, s2, s3 float64
+
+	i := 0
+	for ; i+4 <= len(batch); i += 4 {
+		s0 += batch[i]
+		s1 += batch[i+1]
+		s2 += batch[i+2]
+		s3 += batch[i+3]
+	}
+
+	for ; i < len(batch); i++ {
+		s0 += batch[i]
+	}
+
+	return (s0 + s1) + (s2 + s3)
+}
+
+// minMaxFloat64s returns the minimum and maximum of a batch, ignoring NaN
+// values. ok is false when the batch holds no comparable value.
+func minMaxFloat64s(batch []float64) (minimum, maximum float64, ok bool) {
+	minimum = math.Inf(1)
+	maximum = math.Inf(-1)
+
+	for _, v := range batch {
+		// NaN compares false with everything and is skipped naturally.
+		if v < minimum {
+			minimum = v
+			ok = true
+		}
+
+		if v > maximum {
+			maximum = v
+			ok = true
+		}
+	}
+
+	return minimum, maximum, ok
+}
+
+// sortedQuantile returns the q-th quantile of sorted values, linearly
+// interpolated between the two closest ranks. It returns NaN for an empty slice.
+func sortedQuantile(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return math.NaN()
+	}
+
+	rank := q * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+
+	if lower == upper {
+		return sorted[lower]
+	}
+
+	return sorted[lower] + (rank-float64(lower))*(sorted[upper]-sorted[lower])
+}
+
+// p2Quantile estimates a quantile with the P² algorithm. It tracks 5 markers:
+// the minimum, the maximum, the estimated quantile and two intermediate
+// quantiles. Markers are adjusted with a piecewise-parabolic interpolation.
+type p2Quantile struct {
+	q         float64
+	count     int
+	heights   [5]float64
+	positions [5]float64
+	desired   [5]float64
+	increment [5]float64
+}
+
+func newP2Quantile(q float64) *p2Quantile {
+	return &p2Quantile{
+		q:         q,
+		positions: [5]float64{1, 2, 3, 4, 5},
+		desired:   [5]float64{1, 1 + 2*q, 1 + 4*q, 3 + 2*q, 5},
+		increment: [5]float64{0, q / 2, q, (1 + q) / 2, 1},
+	}
+}
+
+func (e *p2Quantile) add(value float64) {
+	if e.count < 5 {
+		e.heights[e.count] = value
+		e.count++
+
+		if e.count == 5 {
+			sort.Float64s(e.heights[:])
+		}
+
+		return
+	}
+
+	e.count++
+
+	var k int
+
+	switch {
+	case value < e.heights[0]:
+		e.heights[0] = value
+		k = 0
+	case value >= e.heights[4]:
+		e.heights[4] = value
+		k = 3
+	default:
+		for k = 0; k < 3 && value >= e.heights[k+1]; k++ {
+		}
+	}
+
+	for i := k + 1; i < 5; i++ {
+		e.positions[i]++
+	}
+
+	for i := range e.desired {
+		e.desired[i] += e.increment[i]
+	}
+
+	for i := 1; i <= 3; i++ {
+		d := e.desired[i] - e.positions[i]
+
+		if (d >= 1 && e.positions[i+1]-e.positions[i] > 1) || (d <= -1 && e.positions[i-1]-e.positions[i] < -1) {
+			sign := 1.0
+			if d < 0 {
+				sign = -1.0
+			}
+
+			height := e.parabolic(i, sign)
+			if e.heights[i-1] < height && height < e.heights[i+1] {
+				e.heights[i] = height
+			} else {
+				e.heights[i] = e.linear(i, int(sign))
+			}
+
+			e.positions[i] += sign
+		}
+	}
+}
+
+func (e *p2Quantile) parabolic(i int, d float64) float64 {
+	n, h := e.positions, e.heights
+
+	return h[i] + d/(n[i+1]-n[i-1])*((n[i]-n[i-1]+d)*(h[i+1]-h[i])/(n[i+1]-n[i])+(n[i+1]-n[i]-d)*(h[i]-h[i-1])/(n[i]-n[i-1]))
+}
+
+func (e *p2Quantile) linear(i int, d int) float64 {
+	return e.heights[i] + float64(d)*(e.heights[i+d]-e.heights[i])/(e.positions[i+d]-e.positions[i])
+}
+
+// quantile returns the current estimation. Until 5 values are received, the
+// quantile is computed exactly.
+func (e *p2Quantile) quantile() float64 {
+	if e.count < 5 {
+		sorted := make([]float64, e.count)
+		copy(sorted, e.heights[:e.count])
+		sort.Float64s(sorted)
+
+		return sortedQuantile(sorted, e.q)
+	}
+
+	return e.heights[2]
+}
+
+// welford accumulates the mean and the sum of squared deviations of a series
+// with Welford's online algorithm, which avoids the catastrophic cancellation
+// of the naive sum-of-squares formula.
+type welford struct {
+	count int64
+	mean  float64
+	m2    float64
+}
+
+func (w *welford) add(value float64) {
+	w.count++
+	delta := value - w.mean
+	w.mean += delta / float64(w.count)
+	w.m2 += delta * (value - w.mean)
+}
+
+// variance returns the population variance, or NaN when no value was added.
+func (w *welford) variance() float64 {
+	if w.count == 0 {
+		return math.NaN()
+	}
+
+	return w.m2 / float64(w.count)
+}
+
+// compensatedSum is a running sum with Neumaier compensation. Sliding windows
+// add and subtract the same values: without compensation, the rounding errors
+// of large values would remain in the sum after they left the window.
+type compensatedSum struct {
+	sum          float64
+	compensation float64
+}
+
+func (s *compensatedSum) add(value float64) {
+	t := s.sum + value
+	if math.Abs(s.sum) >= math.Abs(value) {
+		s.compensation += (s.sum - t) + value
+	} else {
+		s.compensation += (value - t) + s.sum
+	}
+
+	s.sum = t
+}
+
+func (s *compensatedSum) value() float64 {
+	return s.sum + s.compensation
+}
+
+func newFrequencyConfig(opts []FrequencyOption) frequencyConfig {
+	config := frequencyConfig{}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return config
+}
+
+type frequencyEntry[K comparable] struct {
+	key   K
+	count int64
+	first int64 // order of first appearance
+	index int   // position in the heap
+}
+
+// frequencyCounter counts keys. When the number of keys is bounded, entries are
+// also kept in a min-heap by count, so that the least frequent key is evicted
+// in O(log n).
+type frequencyCounter[K comparable] struct {
+	maxKeys int
+	entries map[K]*frequencyEntry[K]
+	heap    frequencyHeap[K]
+	seq     int64
+}
+
+func newFrequencyCounter[K comparable](config frequencyConfig) *frequencyCounter[K] {
+	return &frequencyCounter[K]{
+		maxKeys: config.maxKeys,
+		entries: map[K]*frequencyEntry[K]{},
+	}
+}
+
+func (c *frequencyCounter[K]) add(key K) {
+	if entry, ok := c.entries[key]; ok {
+		entry.count++
+		if c.maxKeys > 0 {
+			heap.Fix(&c.heap, entry.index)
+		}
+
+		return
+	}
+
+	c.seq++
+
+	if c.maxKeys > 0 && len(c.entries) >= c.maxKeys {
+		// Space-Saving: the new key replaces the least frequent one.
+		entry := c.heap[0]
+		delete(c.entries, entry.key)
+
+		entry.key = key
+		entry.count++
+		entry.first = c.seq
+		c.entries[key] = entry
+		heap.Fix(&c.heap, 0)
+
+		return
+	}
+
+	entry := &frequencyEntry[K]{key: key, count: 1, first: c.seq}
+	c.entries[key] = entry
+
+	if c.maxKeys > 0 {
+		heap.Push(&c.heap, entry)
+	}
+}
+
+func (c *frequencyCounter[K]) counts() map[K]int64 {
+	counts := make(map[K]int64, len(c.entries))
+	for key, entry := range c.entries {
+		counts[key] = entry.count
+	}
+
+	return counts
+}
+
+// modes returns the most frequent keys, in order of first appearance.
+func (c *frequencyCounter[K]) modes() []K {
+	best := []*frequencyEntry[K]{}
+
+	for _, entry := range c.entries {
+		if len(best) == 0 || entry.count > best[0].count {
+			best = []*frequencyEntry[K]{entry}
+		} else if entry.count == best[0].count {
+			best = append(best, entry)
+		}
+	}
+
+	sort.Slice(best, func(i, j int) bool {
+		return best[i].first < best[j].first
+	})
+
+	keys := make([]K, len(best))
+	for i, entry := range best {
+		keys[i] = entry.key
+	}
+
+	return keys
+}
+
+type frequencyHeap[K comparable] []*frequencyEntry[K]
+
+var _ heap.Interface = (*frequencyHeap[int])(nil)
+
+func (h frequencyHeap[K]) Len() int {
+	return len(h)
+}
+
+func (h frequencyHeap[K]) Less(i, j int) bool {
+	return h[i].count < h[j].count
+}
+
+func (h frequencyHeap[K]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *frequencyHeap[K]) Push(x any) {
+	entry := x.(*frequencyEntry[K])
+	entry.index = len(*h)
+	*h = append(*h, entry)
+}
+
+func (h *frequencyHeap[K]) Pop() any {
+	old := *h
+	entry := old[len(old)-1]
+	*h = old[:len(old)-1]
+
+	return entry
+}
+
+// comoment extends Welford's online algorithm to pairs of values: it tracks
+// the variance of both series and their co-moment.
+type comoment struct {
+	x  welford
+	y  welford
+	c2 float64
+}
+
+func (c *comoment) add(x, y float64) {
+	dx := x - c.x.mean
+	c.x.add(x)
+	c.y.add(y)
+	c.c2 += dx * (y - c.y.mean)
+}
+
+// covariance returns the population covariance, or NaN when no pair was added.
+func (c *comoment) covariance() float64 {
+	if c.x.count == 0 {
+		return math.NaN()
+	}
+
+	return c.c2 / float64(c.x.count)
+}
+
+// correlation returns the Pearson correlation coefficient, or NaN when it is
+// undefined.
+func (c *comoment) correlation() float64 {
+	if c.x.count == 0 || c.x.m2 == 0 || c.y.m2 == 0 {
+		return math.NaN()
+	}
+
+	r := c.c2 / math.Sqrt(c.x.m2*c.y.m2)
+
+	// rounding errors may slightly exceed the bounds
+	return math.Max(-1, math.Min(1, r))
+}
+
+// bigFloatSum accumulates numeric values into a big.Float. Integers are converted
+// exactly, without going through float64.
+type bigFloatSum[T constraints.Numeric] struct {
+	sum     *big.Float
+	tmp     *big.Float
+	isFloat bool
+}
+
+func newBigFloatSum[T constraints.Numeric]() *bigFloatSum[T] {
+	half := 0.5
+
+	return &bigFloatSum[T]{
+		sum:     new(big.Float).SetPrec(bigFloatSumPrec),
+		tmp:     new(big.Float).SetPrec(bigFloatSumPrec),
+		isFloat: T(half) != 0, // integer types truncate to 0
+	}
+}
+
+// add returns false when the sum would be NaN, which big.Float cannot represent.
+func (s *bigFloatSum[T]) add(value T) bool {
+	switch {
+	case s.isFloat:
+		v := float64(value)
+		if math.IsNaN(v) || (s.sum.IsInf() && math.IsInf(v, 0) && (v > 0) != (s.sum.Sign() > 0)) {
+			return false
+		}
+
+		s.tmp.SetFloat64(v)
+	case value < 0:
+		s.tmp.SetInt64(int64(value))
+	default:
+		s.tmp.SetUint64(uint64(value))
+	}
+
+	s.sum.Add(s.sum, s.tmp)
+
+	return true
+}
@@ -15,9 +15,13 @@
 package ro
 
 import (
+	"context"
 	"math"
+	"math/big"
 	"testing"
+	"time"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -164,6 +168,108 @@ func TestOperatorMathMax(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorMathMinOrdered(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		MinOrdered[string]()(Just("b", "a", "c")),
+	)
+	is.Equal([]string{"a"}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MinOrdered[string]()(Empty[string]()),
+	)
+	is.Equal([]string{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MinOrdered[string]()(Throw[string](assert.AnError)),
+	)
+	is.Equal([]string{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathMaxOrdered(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		MaxOrdered[string]()(Just("b", "a", "c")),
+	)
+	is.Equal([]string{"c"}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MaxOrdered[string]()(Empty[string]()),
+	)
+	is.Equal([]string{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MaxOrdered[string]()(Throw[string](assert.AnError)),
+	)
+	is.Equal([]string{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathMinBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type user struct {
+		name string
+		age  int
+	}
+
+	values, err := Collect(
+		MinBy(func(u user) int { return u.age })(Just(user{"alice", 42}, user{"bob", 21}, user{"carol", 21})),
+	)
+	is.Equal([]user{{"bob", 21}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MinBy(func(u user) int { return u.age })(Empty[user]()),
+	)
+	is.Equal([]user{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MinBy(func(u user) int { return u.age })(Throw[user](assert.AnError)),
+	)
+	is.Equal([]user{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathMaxBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type user struct {
+		name string
+		age  int
+	}
+
+	values, err := Collect(
+		MaxBy(func(u user) string { return u.name })(Just(user{"alice", 42}, user{"carol", 21}, user{"bob", 21})),
+	)
+	is.Equal([]user{{"carol", 21}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MaxBy(func(u user) int { return u.age })(Just(user{"alice", 42}, user{"bob", 42})),
+	)
+	is.Equal([]user{{"alice", 42}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MaxBy(func(u user) int { return u.age })(Empty[user]()),
+	)
+	is.Equal([]user{}, values)
+	is.NoError(err)
+}
+
 func TestOperatorMathClamp(t *testing.T) { //nolint:paralleltest
 	// @TODO: implement
 }
@@ -567,6 +673,91 @@ func TestOperatorMathCeilWithPrecisionMinInt(t *testing.T) {
 	is.True(math.IsNaN(values[5]))
 }
 
+func TestOperatorMathRoundWithPrecision(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		RoundWithPrecision(2)(Just(1.236, -1.234, 2.5)),
+	)
+	is.NoError(err)
+	is.InDeltaSlice([]float64{1.24, -1.23, 2.5}, values, 1e-9)
+
+	values, err = Collect(
+		RoundWithPrecision(0)(Just(2.5, -2.5, 2.4)),
+	)
+	is.NoError(err)
+	is.Equal([]float64{3, -3, 2}, values)
+
+	values, err = Collect(
+		RoundWithPrecision(-1)(Just(125.0, -125.0, 124.0)),
+	)
+	is.NoError(err)
+	is.InDeltaSlice([]float64{130, -130, 120}, values, 1e-9)
+
+	values, err = Collect(
+		RoundWithPrecision(309)(Just(1.234, 6e-310, 4e-310)),
+	)
+	is.NoError(err)
+	is.Len(values, 3)
+	is.InDelta(1.234, values[0], 1e-15)
+	is.InDelta(1e-309, values[1], 1e-320)
+	is.Equal(0.0, values[2])
+
+	values, err = Collect(
+		RoundWithPrecision(2)(Just(math.MaxFloat64 / 2)),
+	)
+	is.NoError(err)
+	is.Equal([]float64{math.MaxFloat64 / 2}, values)
+
+	values, err = Collect(
+		RoundWithPrecision(-maxPow10Chunk)(Just(math.MaxFloat64, -math.MaxFloat64, 1e307)),
+	)
+	is.NoError(err)
+	is.Len(values, 3)
+	is.True(math.IsInf(values[0], 1))
+	is.True(math.IsInf(values[1], -1))
+	is.Equal(0.0, values[2])
+
+	values, err = Collect(
+		RoundWithPrecision(-400)(Just(123.45, -123.45)),
+	)
+	is.NoError(err)
+	is.Len(values, 2)
+	is.Equal(0.0, math.Abs(values[0]))
+	is.Equal(0.0, math.Abs(values[1]))
+
+	values, err = Collect(
+		RoundWithPrecision(3)(Just(math.Inf(1), math.Inf(-1), math.NaN())),
+	)
+	is.NoError(err)
+	is.Len(values, 3)
+	is.True(math.IsInf(values[0], 1))
+	is.True(math.IsInf(values[1], -1))
+	is.True(math.IsNaN(values[2]))
+
+	positiveFallback := maxPow10ChunkCount*maxPow10Chunk + 1
+
+	values, err = Collect(
+		RoundWithPrecision(positiveFallback)(Just(1.2345, -6.789)),
+	)
+	is.NoError(err)
+	is.InDeltaSlice([]float64{1.2345, -6.789}, values, 1e-12)
+
+	assert.NotPanics(t, func() {
+		values, err = Collect(
+			RoundWithPrecision(math.MinInt)(Just(42.5, -42.5, math.Inf(1), math.NaN())),
+		)
+	})
+
+	is.NoError(err)
+	is.Len(values, 4)
+	is.Equal(0.0, values[0])
+	is.Equal(0.0, values[1])
+	is.True(math.IsInf(values[2], 1))
+	is.True(math.IsNaN(values[3]))
+}
+
 func TestOperatorMathTrunc(t *testing.T) { //nolint:paralleltest
 	// @TODO: implement
 }
@@ -608,6 +799,66 @@ func TestChunkCountComputation(t *testing.T) {
 	}
 }
 
+func TestOperatorMathContextPolicy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type ctxKey string
+
+	const key = ctxKey("trace")
+
+	source := NewObservableWithContext(func(ctx context.Context, destination Observer[int]) Teardown {
+		destination.NextWithContext(context.WithValue(ctx, key, "first"), 3)
+		destination.NextWithContext(context.WithValue(ctx, key, "min"), 1)
+		destination.NextWithContext(context.WithValue(ctx, key, "last"), 2)
+		destination.CompleteWithContext(context.WithValue(ctx, key, "complete"))
+		return nil
+	})
+
+	collect := func(operator func(Observable[int]) Observable[int]) []any {
+		traces := []any{}
+
+		operator(source).SubscribeWithContext(
+			context.WithValue(context.Background(), key, "subscription"),
+			NewObserverWithContext(
+				func(ctx context.Context, value int) {
+					traces = append(traces, ctx.Value(key))
+				},
+				func(ctx context.Context, err error) {},
+				func(ctx context.Context) {
+					traces = append(traces, ctx.Value(key))
+				},
+			),
+		)
+
+		return traces
+	}
+
+	is.Equal([]any{"min", "complete"}, collect(Min[int]()))
+	is.Equal([]any{"min", "complete"}, collect(Min[int](WithContextPolicy(ContextPolicyDefault))))
+	is.Equal([]any{"first", "first"}, collect(Min[int](WithContextPolicy(ContextPolicyFirst))))
+	is.Equal([]any{"complete", "complete"}, collect(Min[int](WithContextPolicy(ContextPolicyLast))))
+	is.Equal([]any{"subscription", "subscription"}, collect(Min[int](WithContextPolicy(ContextPolicySubscription))))
+
+	is.Equal([]any{"first", "complete"}, collect(Max[int]()))
+	is.Equal([]any{"first", "first"}, collect(Max[int](WithContextPolicy(ContextPolicyFirst))))
+	is.Equal([]any{"min", "complete"}, collect(MinBy(func(v int) int { return v })))
+	is.Equal([]any{"complete", "complete"}, collect(MinBy(func(v int) int { return v }, WithContextPolicy(ContextPolicyLast))))
+
+	reducer := func(agg, item int) int {
+		return agg + item
+	}
+
+	is.Equal([]any{"last", "complete"}, collect(Reduce(reducer, 0)))
+	is.Equal([]any{"first", "first"}, collect(Reduce(reducer, 0, WithContextPolicy(ContextPolicyFirst))))
+	is.Equal([]any{"complete", "complete"}, collect(Sum[int]()))
+	is.Equal([]any{"subscription", "subscription"}, collect(Sum[int](WithContextPolicy(ContextPolicySubscription))))
+
+	is.PanicsWithValue(ErrAggregateWrongContextPolicy, func() {
+		WithContextPolicy(ContextPolicy(42))
+	})
+}
+
 func TestOperatorMathReduce(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -670,3 +921,903 @@ func TestOperatorMathReduceI(t *testing.T) {
 	is.Equal([]int{}, values)
 	is.EqualError(err, assert.AnError.Error())
 }
+
+func TestOperatorMathSumBatch(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		SumBatch()(Just([]float64{1, 2, 3, 4, 5}, []float64{}, []float64{6, 7})),
+	)
+	is.Equal([]float64{28}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		SumBatch()(Empty[[]float64]()),
+	)
+	is.Equal([]float64{0}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		SumBatch()(Throw[[]float64](assert.AnError)),
+	)
+	is.Equal([]float64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathAverageBatch(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		AverageBatch()(Just([]float64{1, 2, 3}, []float64{4, 5})),
+	)
+	is.Equal([]float64{3}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		AverageBatch()(Just([]float64{})),
+	)
+	is.Len(values, 1)
+	is.True(math.IsNaN(values[0]))
+	is.NoError(err)
+
+	values, err = Collect(
+		AverageBatch()(Empty[[]float64]()),
+	)
+	is.Len(values, 1)
+	is.True(math.IsNaN(values[0]))
+	is.NoError(err)
+
+	values, err = Collect(
+		AverageBatch()(Throw[[]float64](assert.AnError)),
+	)
+	is.Equal([]float64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathMinMaxBatch(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		MinMaxBatch()(Just([]float64{3, 1, math.NaN()}, []float64{}, []float64{-2, 8})),
+	)
+	is.Equal([]lo.Tuple2[float64, float64]{lo.T2(-2.0, 8.0)}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MinMaxBatch()(Just([]float64{math.Inf(1)})),
+	)
+	is.Equal([]lo.Tuple2[float64, float64]{lo.T2(math.Inf(1), math.Inf(1))}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MinMaxBatch()(Just([]float64{}, []float64{math.NaN()})),
+	)
+	is.Equal([]lo.Tuple2[float64, float64]{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MinMaxBatch()(Throw[[]float64](assert.AnError)),
+	)
+	is.Equal([]lo.Tuple2[float64, float64]{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathPercentile(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		Percentile[int](50)(Just(5, 1, 4, 2, 3)),
+	)
+	is.Equal([]float64{3}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Percentile[int](25)(Just(4, 1, 3, 2)),
+	)
+	is.Equal([]float64{1.75}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Percentile[float64](100)(Just(1.5, -2.0, 8.0)),
+	)
+	is.Equal([]float64{8}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Percentile[int](50)(Empty[int]()),
+	)
+	is.Len(values, 1)
+	is.True(math.IsNaN(values[0]))
+	is.NoError(err)
+
+	values, err = Collect(
+		Percentile[int](50)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]float64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithValue(ErrPercentileWrongPercentile, func() {
+		_ = Percentile[int](-1)
+	})
+	is.PanicsWithValue(ErrPercentileWrongPercentile, func() {
+		_ = Percentile[int](101)
+	})
+	is.PanicsWithValue(ErrPercentileWrongPercentile, func() {
+		_ = Percentile[int](math.NaN())
+	})
+}
+
+func TestOperatorMathQuantiles(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		Quantiles[int](0, 0.5, 0.9, 1)(Just(10, 1, 9, 2, 8, 3, 7, 4, 6, 5)),
+	)
+	is.Len(values, 1)
+	is.InDeltaSlice([]float64{1, 5.5, 9.1, 10}, values[0], 1e-9)
+	is.NoError(err)
+
+	values, err = Collect(
+		Quantiles[int]()(Just(1, 2, 3)),
+	)
+	is.Equal([][]float64{{}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Quantiles[int](0.5, 1)(Empty[int]()),
+	)
+	is.Len(values, 1)
+	is.Len(values[0], 2)
+	is.True(math.IsNaN(values[0][0]))
+	is.True(math.IsNaN(values[0][1]))
+	is.NoError(err)
+
+	values, err = Collect(
+		Quantiles[int](0.5)(Throw[int](assert.AnError)),
+	)
+	is.Equal([][]float64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithValue(ErrQuantilesWrongQuantile, func() {
+		_ = Quantiles[int](0.5, 1.5)
+	})
+}
+
+func TestOperatorMathPercentileApprox(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// exact for the first 5 values
+	values, err := Collect(
+		PercentileApprox[int](50)(Just(3, 1, 2)),
+	)
+	is.Equal([]float64{3, 2, 2}, values)
+	is.NoError(err)
+
+	// uniform distribution, shuffled deterministically
+	source := make([]int, 0, 10_000)
+	for i := 0; i < 10_000; i++ {
+		source = append(source, (i*7919)%10_000)
+	}
+
+	for _, p := range []float64{10, 50, 90, 99} {
+		values, err = Collect(
+			Pipe2(
+				Just(source...),
+				PercentileApprox[int](p),
+				TakeLast[float64](1),
+			),
+		)
+		is.Len(values, 1)
+		is.InDelta(p*100, values[0], 100)
+		is.NoError(err)
+	}
+
+	values, err = Collect(
+		PercentileApprox[int](50)(Empty[int]()),
+	)
+	is.Equal([]float64{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		PercentileApprox[int](50)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]float64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithValue(ErrPercentileApproxWrongPercentile, func() {
+		_ = PercentileApprox[int](200)
+	})
+}
+
+func TestOperatorMathVariance(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		Variance[int]()(Just(2, 4, 4, 4, 5, 5, 7, 9)),
+	)
+	is.Equal([]float64{4}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Variance[float64]()(Just(42.0)),
+	)
+	is.Equal([]float64{0}, values)
+	is.NoError(err)
+
+	// numerically stable with a large offset
+	values, err = Collect(
+		Variance[float64]()(Just(1e9+4, 1e9+7, 1e9+13, 1e9+16)),
+	)
+	is.Len(values, 1)
+	is.InDelta(22.5, values[0], 1e-6)
+	is.NoError(err)
+
+	values, err = Collect(
+		Variance[int]()(Empty[int]()),
+	)
+	is.Len(values, 1)
+	is.True(math.IsNaN(values[0]))
+	is.NoError(err)
+
+	values, err = Collect(
+		Variance[int]()(Throw[int](assert.AnError)),
+	)
+	is.Equal([]float64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathStdDev(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		StdDev[int]()(Just(2, 4, 4, 4, 5, 5, 7, 9)),
+	)
+	is.Equal([]float64{2}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		StdDev[int]()(Empty[int]()),
+	)
+	is.Len(values, 1)
+	is.True(math.IsNaN(values[0]))
+	is.NoError(err)
+
+	values, err = Collect(
+		StdDev[int]()(Throw[int](assert.AnError)),
+	)
+	is.Equal([]float64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathMovingAverage(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		MovingAverage[int](3)(Just(1, 2, 3, 4, 5, 6, 7)),
+	)
+	is.Equal([]float64{1, 1.5, 2, 3, 4, 5, 6}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MovingAverage[int](1)(Just(1, 2, 3)),
+	)
+	is.Equal([]float64{1, 2, 3}, values)
+	is.NoError(err)
+
+	// compensated sum: no drift after a large value leaves the window
+	values, err = Collect(
+		MovingAverage[float64](2)(Just(1e20, 1.0, 2.0, 3.0, 4.0)),
+	)
+	is.Equal([]float64{1e20, 5e19, 1.5, 2.5, 3.5}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MovingAverage[int](3)(Empty[int]()),
+	)
+	is.Equal([]float64{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MovingAverage[int](3)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]float64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithValue(ErrMovingAverageWrongWindow, func() {
+		_ = MovingAverage[int](0)
+	})
+}
+
+func TestOperatorMathMovingAverageTime(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	subject := NewPublishSubject[int]()
+	values := []float64{}
+
+	sub := MovingAverageTime[int](50 * time.Millisecond)(subject).Subscribe(
+		OnNext(func(value float64) {
+			values = append(values, value)
+		}),
+	)
+	defer sub.Unsubscribe()
+
+	subject.Next(1)
+	subject.Next(3)
+	time.Sleep(80 * time.Millisecond)
+	subject.Next(5)
+	subject.Next(7)
+	subject.Complete()
+
+	is.Equal([]float64{1, 2, 5, 6}, values)
+
+	obs, err := Collect(
+		MovingAverageTime[int](time.Second)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]float64{}, obs)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithValue(ErrMovingAverageTimeWrongDuration, func() {
+		_ = MovingAverageTime[int](0)
+	})
+}
+
+func TestOperatorMathEWMA(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		EWMA[int](0.5)(Just(10, 20, 20, 0)),
+	)
+	is.Equal([]float64{10, 15, 17.5, 8.75}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		EWMA[int](1)(Just(1, 2, 3)),
+	)
+	is.Equal([]float64{1, 2, 3}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		EWMA[int](0.5)(Empty[int]()),
+	)
+	is.Equal([]float64{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		EWMA[int](0.5)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]float64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithValue(ErrEWMAWrongAlpha, func() {
+		_ = EWMA[int](0)
+	})
+	is.PanicsWithValue(ErrEWMAWrongAlpha, func() {
+		_ = EWMA[int](1.5)
+	})
+}
+
+func TestOperatorMathHistogram(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		Histogram[float64]([]float64{1, 5, 10})(Just(0.5, 1, 1.5, 5, 7, 10, 11, math.NaN(), math.Inf(-1))),
+	)
+	is.Equal([][]int64{{3, 2, 2, 1}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Histogram[int](nil)(Just(1, 2, 3)),
+	)
+	is.Equal([][]int64{{3}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Histogram[int]([]float64{1})(Empty[int]()),
+	)
+	is.Equal([][]int64{{0, 0}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Histogram[int]([]float64{1})(Throw[int](assert.AnError)),
+	)
+	is.Equal([][]int64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithValue(ErrHistogramWrongBuckets, func() {
+		_ = Histogram[int]([]float64{1, 1})
+	})
+	is.PanicsWithValue(ErrHistogramWrongBuckets, func() {
+		_ = Histogram[int]([]float64{2, 1})
+	})
+	is.PanicsWithValue(ErrHistogramWrongInterval, func() {
+		_ = WithHistogramInterval(0)
+	})
+}
+
+func TestOperatorMathHistogramWithInterval(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		Histogram[int64]([]float64{1}, WithHistogramInterval(30*time.Millisecond))(
+			Pipe1(
+				Interval(20*time.Millisecond),
+				Take[int64](4),
+			),
+		),
+	)
+	is.NoError(err)
+	is.GreaterOrEqual(len(values), 3)
+	is.Equal([]int64{2, 2}, values[len(values)-1])
+
+	for i := 1; i < len(values); i++ {
+		is.LessOrEqual(values[i-1][0]+values[i-1][1], values[i][0]+values[i][1])
+	}
+}
+
+func TestOperatorMathTopK(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		TopK[int](3)(Just(5, 1, 9, 3, 7, 9, 2)),
+	)
+	is.Equal([]int{9, 9, 7}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		TopK[int](10)(Just(2, 3, 1)),
+	)
+	is.Equal([]int{3, 2, 1}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		TopK[int](0)(Just(2, 3, 1)),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		TopK[int](3)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithValue(ErrTopKWrongCount, func() {
+		_ = TopK[int](-1)
+	})
+}
+
+func TestOperatorMathTopKBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type user struct {
+		name string
+		age  int
+	}
+
+	byAge := func(a, b user) bool {
+		return a.age < b.age
+	}
+
+	values, err := Collect(
+		TopKBy(2, byAge)(Just(user{"a", 20}, user{"b", 40}, user{"c", 30}, user{"d", 40}, user{"e", 40})),
+	)
+	is.Equal([]user{{"b", 40}, {"d", 40}}, values)
+	is.NoError(err)
+
+	is.PanicsWithValue(ErrTopKWrongCount, func() {
+		_ = TopKBy(-1, byAge)
+	})
+}
+
+func TestOperatorMathBottomK(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		BottomK[string](2)(Just("b", "d", "a", "c")),
+	)
+	is.Equal([]string{"a", "b"}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		BottomK[string](2)(Empty[string]()),
+	)
+	is.Equal([]string{}, values)
+	is.NoError(err)
+
+	is.PanicsWithValue(ErrBottomKWrongCount, func() {
+		_ = BottomK[string](-1)
+	})
+}
+
+func TestOperatorMathBottomKBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		BottomKBy(2, func(a, b lo.Tuple2[string, int]) bool {
+			return a.B < b.B
+		})(Just(lo.T2("a", 3), lo.T2("b", 1), lo.T2("c", 2), lo.T2("d", 1))),
+	)
+	is.Equal([]lo.Tuple2[string, int]{lo.T2("b", 1), lo.T2("d", 1)}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		BottomKBy(2, func(a, b lo.Tuple2[string, int]) bool {
+			return a.B < b.B
+		})(Throw[lo.Tuple2[string, int]](assert.AnError)),
+	)
+	is.Equal([]lo.Tuple2[string, int]{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathMode(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		Mode[string]()(Just("a", "b", "a", "c", "b", "a")),
+	)
+	is.Equal([]string{"a"}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Mode[string]()(Just("c", "b", "a", "b", "c")),
+	)
+	is.Equal([]string{"c", "b"}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Mode[string]()(Empty[string]()),
+	)
+	is.Equal([]string{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Mode[string]()(Throw[string](assert.AnError)),
+	)
+	is.Equal([]string{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	// heavy hitter survives a long tail of unique values
+	source := []int{}
+	for i := 0; i < 1000; i++ {
+		source = append(source, 42, 1000+i)
+	}
+
+	ints, err := Collect(
+		Mode[int](WithFrequencyMaxKeys(4))(Just(source...)),
+	)
+	is.Equal([]int{42}, ints)
+	is.NoError(err)
+}
+
+func TestOperatorMathCountBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		CountBy(func(s string) int { return len(s) })(Just("a", "bb", "c", "ddd", "ee")),
+	)
+	is.Equal([]map[int]int64{{1: 2, 2: 2, 3: 1}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		CountBy(func(s string) int { return len(s) })(Empty[string]()),
+	)
+	is.Equal([]map[int]int64{{}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		CountBy(func(s string) int { return len(s) })(Throw[string](assert.AnError)),
+	)
+	is.Equal([]map[int]int64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	// bounded memory: the least frequent key is evicted
+	values, err = Collect(
+		CountBy(func(v int) int { return v }, WithFrequencyMaxKeys(2))(Just(1, 1, 1, 2, 3)),
+	)
+	is.Equal([]map[int]int64{{1: 3, 3: 2}}, values)
+	is.NoError(err)
+
+	is.PanicsWithValue(ErrFrequencyWrongMaxKeys, func() {
+		_ = WithFrequencyMaxKeys(0)
+	})
+}
+
+func TestOperatorMathRunningStats(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		RunningStats[int]()(Just(2, 4, 4, 4, 5, 5, 7, 9)),
+	)
+	is.Len(values, 8)
+	is.Equal(Stats{Count: 1, Sum: 2, Min: 2, Max: 2, Mean: 2, StdDev: 0}, values[0])
+	is.Equal(Stats{Count: 2, Sum: 6, Min: 2, Max: 4, Mean: 3, StdDev: 1}, values[1])
+	is.Equal(Stats{Count: 8, Sum: 40, Min: 2, Max: 9, Mean: 5, StdDev: 2}, values[7])
+	is.NoError(err)
+
+	values, err = Collect(
+		RunningStats[float64]()(Just(-1.5, -3.0)),
+	)
+	is.Equal([]Stats{
+		{Count: 1, Sum: -1.5, Min: -1.5, Max: -1.5, Mean: -1.5, StdDev: 0},
+		{Count: 2, Sum: -4.5, Min: -3, Max: -1.5, Mean: -2.25, StdDev: 0.75},
+	}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		RunningStats[int]()(Empty[int]()),
+	)
+	is.Equal([]Stats{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		RunningStats[int]()(Throw[int](assert.AnError)),
+	)
+	is.Equal([]Stats{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathSumBig(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		SumBig[int64]()(Just(int64(math.MaxInt64), int64(math.MaxInt64), 2)),
+	)
+	is.NoError(err)
+	is.Len(values, 1)
+	is.Equal("18446744073709551616", values[0].String())
+
+	values, err = Collect(
+		SumBig[uint64]()(Just(uint64(math.MaxUint64), 1)),
+	)
+	is.NoError(err)
+	is.Len(values, 1)
+	is.Equal("18446744073709551616", values[0].String())
+
+	values, err = Collect(
+		SumBig[int8]()(Just[int8](-128, -128, 1)),
+	)
+	is.NoError(err)
+	is.Len(values, 1)
+	is.Equal("-255", values[0].String())
+
+	values, err = Collect(
+		SumBig[int]()(Empty[int]()),
+	)
+	is.NoError(err)
+	is.Len(values, 1)
+	is.Equal("0", values[0].String())
+
+	values, err = Collect(
+		SumBig[int]()(Throw[int](assert.AnError)),
+	)
+	is.Equal([]*big.Int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathSumBigFloat(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// float64 would lose the small values
+	values, err := Collect(
+		SumBigFloat[float64]()(Just(1e20, 1, 1, -1e20)),
+	)
+	is.NoError(err)
+	is.Len(values, 1)
+	is.Equal("2", values[0].Text('g', 10))
+
+	values, err = Collect(
+		SumBigFloat[float64]()(Just(math.MaxFloat64, math.MaxFloat64)),
+	)
+	is.NoError(err)
+	is.Len(values, 1)
+	is.False(values[0].IsInf())
+	is.Equal(1, values[0].Cmp(big.NewFloat(math.MaxFloat64)))
+
+	values, err = Collect(
+		SumBigFloat[uint64]()(Just(uint64(math.MaxUint64), 1)),
+	)
+	is.NoError(err)
+	is.Len(values, 1)
+	is.Equal("18446744073709551616", values[0].Text('f', 0))
+
+	values, err = Collect(
+		SumBigFloat[float64]()(Just(1, math.Inf(1), 2)),
+	)
+	is.NoError(err)
+	is.Len(values, 1)
+	is.True(values[0].IsInf())
+
+	values, err = Collect(
+		SumBigFloat[float64]()(Just(1, math.NaN())),
+	)
+	is.Equal([]*big.Float{}, values)
+	is.EqualError(err, ErrSumBigFloatNaN.Error())
+
+	values, err = Collect(
+		SumBigFloat[float64]()(Just(math.Inf(1), math.Inf(-1))),
+	)
+	is.Equal([]*big.Float{}, values)
+	is.EqualError(err, ErrSumBigFloatNaN.Error())
+
+	values, err = Collect(
+		SumBigFloat[float64]()(Throw[float64](assert.AnError)),
+	)
+	is.Equal([]*big.Float{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathAverageBig(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		AverageBig[int64]()(Just(int64(math.MaxInt64), int64(math.MaxInt64))),
+	)
+	is.NoError(err)
+	is.Len(values, 1)
+	is.Equal("9223372036854775807", values[0].Text('f', 0))
+
+	values, err = Collect(
+		AverageBig[float64]()(Just(1.5, 2.5, 5)),
+	)
+	is.NoError(err)
+	is.Len(values, 1)
+	f, _ := values[0].Float64()
+	is.Equal(3.0, f)
+
+	values, err = Collect(
+		AverageBig[int]()(Empty[int]()),
+	)
+	is.Equal([]*big.Float{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		AverageBig[float32]()(Just[float32](1, float32(math.NaN()))),
+	)
+	is.Equal([]*big.Float{}, values)
+	is.EqualError(err, ErrAverageBigNaN.Error())
+
+	values, err = Collect(
+		AverageBig[int]()(Throw[int](assert.AnError)),
+	)
+	is.Equal([]*big.Float{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathCovariance(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		Covariance[float64]()(Just(lo.T2(1.0, 2.0), lo.T2(2.0, 4.0), lo.T2(3.0, 6.0))),
+	)
+	is.NoError(err)
+	is.Len(values, 1)
+	is.InDelta(4.0/3, values[0], 1e-12)
+
+	values, err = Collect(
+		Covariance[int]()(Zip2(Just(1, 2, 3, 4), Just(4, 3, 2, 1))),
+	)
+	is.NoError(err)
+	is.Equal([]float64{-1.25}, values)
+
+	values, err = Collect(
+		Covariance[int]()(Empty[lo.Tuple2[int, int]]()),
+	)
+	is.NoError(err)
+	is.Len(values, 1)
+	is.True(math.IsNaN(values[0]))
+
+	values, err = Collect(
+		Covariance[int]()(Throw[lo.Tuple2[int, int]](assert.AnError)),
+	)
+	is.Equal([]float64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathCorrelation(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := Collect(
+		Correlation[float64]()(Just(lo.T2(1.0, 2.0), lo.T2(2.0, 4.0), lo.T2(3.0, 6.0))),
+	)
+	is.NoError(err)
+	is.Equal([]float64{1}, values)
+
+	values, err = Collect(
+		Correlation[int]()(Zip2(Just(1, 2, 3, 4), Just(4, 3, 2, 1))),
+	)
+	is.NoError(err)
+	is.Equal([]float64{-1}, values)
+
+	values, err = Collect(
+		Correlation[int]()(Just(lo.T2(1, 5), lo.T2(2, 1), lo.T2(3, 4), lo.T2(4, 2))),
+	)
+	is.NoError(err)
+	is.Len(values, 1)
+	is.InDelta(-3/math.Sqrt(50), values[0], 1e-12)
+
+	// constant series
+	values, err = Collect(
+		Correlation[int]()(Just(lo.T2(1, 5), lo.T2(2, 5))),
+	)
+	is.NoError(err)
+	is.Len(values, 1)
+	is.True(math.IsNaN(values[0]))
+
+	values, err = Collect(
+		Correlation[int]()(Empty[lo.Tuple2[int, int]]()),
+	)
+	is.NoError(err)
+	is.Len(values, 1)
+	is.True(math.IsNaN(values[0]))
+
+	values, err = Collect(
+		Correlation[int]()(Throw[lo.Tuple2[int, int]](assert.AnError)),
+	)
+	is.Equal([]float64{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorMathRatePerSecond(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	subject := NewPublishSubject[int]()
+	values := []float64{}
+
+	sub := RatePerSecond[int]()(subject).Subscribe(
+		OnNext(func(value float64) {
+			values = append(values, value)
+		}),
+	)
+	defer sub.Unsubscribe()
+
+	subject.Next(100)
+	time.Sleep(100 * time.Millisecond)
+	subject.Next(110)
+	time.Sleep(100 * time.Millisecond)
+	subject.Next(5) // counter reset
+	subject.Complete()
+
+	is.Len(values, 2)
+	is.InDelta(100, values[0], 30) // 10 per 100ms
+	is.LessOrEqual(values[0], 100.0)
+	is.InDelta(50, values[1], 15) // 5 per 100ms
+	is.LessOrEqual(values[1], 50.0)
+
+	obs, err := Collect(
+		RatePerSecond[int]()(Just(42)),
+	)
+	is.Equal([]float64{}, obs)
+	is.NoError(err)
+
+	obs, err = Collect(
+		RatePerSecond[int]()(Throw[int](assert.AnError)),
+	)
+	is.Equal([]float64{}, obs)
+	is.EqualError(err, assert.AnError.Error())
+}
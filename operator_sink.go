@@ -16,8 +16,11 @@ package ro
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"time"
+
+	"github.com/samber/ro/internal/constraints"
 )
 
 // ToSlice collects all items from the observable into a slice. It is a sink
@@ -48,6 +51,21 @@ func ToSlice[T any]() func(Observable[T]) Observable[[]T] {
 	}
 }
 
+// ToSortedSlice collects all items from the observable into a slice sorted in
+// ascending order. It is a sink operator so it emit a single value. It emits the
+// slice when the source completes. If the source is empty, it emits an empty slice.
+func ToSortedSlice[T constraints.Ordered]() func(Observable[T]) Observable[[]T] {
+	return func(source Observable[T]) Observable[[]T] {
+		return Map(func(slice []T) []T {
+			sort.Slice(slice, func(i, j int) bool {
+				return slice[i] < slice[j]
+			})
+
+			return slice
+		})(ToSlice[T]()(source))
+	}
+}
+
 // ToMap collects all items from the observable into a map. It is a sink
 // operator so it emit a single value. It emits the map when the source
 // completes. If the source is empty, it emits an empty map.
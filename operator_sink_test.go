@@ -47,6 +47,30 @@ func TestOperatorSinkToSlice(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorSinkToSortedSlice(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		ToSortedSlice[string]()(Just("b", "c", "a", "b")),
+	)
+	is.Equal([][]string{{"a", "b", "b", "c"}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ToSortedSlice[string]()(Empty[string]()),
+	)
+	is.Equal([][]string{{}}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		ToSortedSlice[string]()(Throw[string](assert.AnError)),
+	)
+	is.Equal([][]string{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorSinkToMap(t *testing.T) {
 	t.Parallel()
 	testWithTimeout(t, 100*time.Millisecond)
@@ -0,0 +1,137 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ro
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperatorObserver(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	values := []int{}
+	var errs []error
+	completed := 0
+
+	destination := NewObserver(
+		func(value int) { values = append(values, value) },
+		func(err error) { errs = append(errs, err) },
+		func() { completed++ },
+	)
+
+	observer := mapOperator[int, int]{project: func(v int) int { return v * 2 }}.observer(destination)
+	observer.Next(1)
+	observer.NextWithContext(context.Background(), 2)
+	is.False(observer.IsClosed())
+
+	observer.Complete()
+	is.True(observer.IsClosed())
+	is.True(observer.IsCompleted())
+	is.False(observer.HasThrown())
+
+	// dropped after completion
+	observer.Next(3)
+	observer.Error(assert.AnError)
+	observer.Complete()
+
+	is.Equal([]int{2, 4}, values)
+	is.Empty(errs)
+	is.Equal(1, completed)
+
+	destination = NewObserver(
+		func(value int) { values = append(values, value) },
+		func(err error) { errs = append(errs, err) },
+		func() { completed++ },
+	)
+
+	observer = filterOperator[int]{predicate: func(v int) bool { return v%2 == 0 }}.observer(destination)
+	observer.Next(3)
+	observer.Next(4)
+	observer.Error(assert.AnError)
+	is.Equal([]int{2, 4, 4}, values)
+	is.True(observer.IsClosed())
+	is.True(observer.HasThrown())
+	is.Equal([]error{assert.AnError}, errs)
+}
+
+func TestOperatorObserverPanic(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	// a panic in the project function is converted into an error
+	values, err := Collect(
+		Map(func(v int) int {
+			if v == 2 {
+				panic(assert.AnError)
+			}
+
+			return v
+		})(Just(1, 2, 3)),
+	)
+	is.Equal([]int{1}, values)
+	is.ErrorIs(err, assert.AnError)
+
+	values, err = Collect(
+		Filter(func(v int) bool {
+			if v == 3 {
+				panic("boom")
+			}
+
+			return true
+		})(Just(1, 2, 3, 4)),
+	)
+	is.Equal([]int{1, 2}, values)
+	is.EqualError(err, "ro.Observer: unexpected error: boom")
+}
+
+type panickingObserver struct {
+	Observer[int]
+}
+
+func (o panickingObserver) ErrorWithContext(ctx context.Context, err error) {
+	panic(err)
+}
+
+func (o panickingObserver) CompleteWithContext(ctx context.Context) {
+	panic("complete")
+}
+
+func TestOperatorObserverPanicInDestination(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	// a panic in the error or complete callback is reported to OnUnhandledError,
+	// not propagated to the source
+	destination := panickingObserver{Observer: NoopObserver[int]()}
+
+	observer := mapOperator[int, int]{project: func(v int) int { return v }}.observer(destination)
+	is.NotPanics(func() {
+		observer.Error(assert.AnError)
+	})
+
+	observer = mapOperator[int, int]{project: func(v int) int { return v }}.observer(destination)
+	is.NotPanics(func() {
+		observer.Complete()
+	})
+	is.True(observer.IsCompleted())
+}
@@ -16,6 +16,7 @@ package ro
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -28,9 +29,11 @@ import (
 // Map applies a given project function to each item emitted by an Observable and emits the result.
 // Play: https://go.dev/play/p/JhTBEQFQGYr
 func Map[T, R any](project func(item T) R) func(Observable[T]) Observable[R] {
-	return MapIWithContext(func(ctx context.Context, v T, _ int64) (context.Context, R) {
-		return ctx, project(v)
-	})
+	op := mapOperator[T, R]{project: project}
+
+	return func(source Observable[T]) Observable[R] {
+		return newOperatorObservable[T, R](source, op)
+	}
 }
 
 // MapWithContext applies a given project function to each item emitted by an Observable and emits the result.
@@ -153,6 +156,406 @@ func MapErrIWithContext[T, R any](project func(ctx context.Context, item T, inde
 	}
 }
 
+// Chain collapses a chain of homogeneous transformations into a single operator.
+// Each stage returns the transformed item and whether it must be kept: when a
+// stage returns false, the item is dropped and the next stages are skipped.
+//
+// Chain(f, g) behaves like Pipe2(Map(f'), Filter(g')), but calls the stages
+// directly instead of going through one subscriber per stage. It is a
+// performance escape hatch for hot pipelines of simple Map/Filter stages.
+func Chain[T any](stages ...func(item T) (T, bool)) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						for _, stage := range stages {
+							var ok bool
+
+							value, ok = stage(value)
+							if !ok {
+								return
+							}
+						}
+
+						destination.NextWithContext(ctx, value)
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// MapParallelOption configures the MapParallel operator.
+type MapParallelOption func(config *mapParallelConfig)
+
+type mapParallelConfig struct {
+	ordered bool
+}
+
+// WithOrdered configures MapParallel to emit results in the order of the source
+// items instead of completion order. Default is false.
+func WithOrdered(ordered bool) MapParallelOption {
+	return func(config *mapParallelConfig) {
+		config.ordered = ordered
+	}
+}
+
+// MapParallel applies a given project function to each item emitted by an Observable
+// on a pool of `workers` goroutines and emits the results. By default, results are
+// emitted as soon as they are computed. Use WithOrdered(true) to re-sequence them in
+// the order of the source items.
+//
+// At most 2*workers items are processed or awaiting emission at any time: when the
+// limit is reached, the source is blocked until a result is emitted.
+//
+// A panic in the project function is converted into an error notification.
+func MapParallel[T, R any](project func(item T) R, workers int, opts ...MapParallelOption) func(Observable[T]) Observable[R] {
+	if workers <= 0 {
+		panic(ErrMapParallelWrongWorkers)
+	}
+
+	config := mapParallelConfig{
+		ordered: false,
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	type job struct {
+		ctx   context.Context
+		index int64
+		value T
+	}
+
+	return func(source Observable[T]) Observable[R] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[R]) Teardown {
+			jobs := make(chan job, 2*workers)
+			inflight := make(chan struct{}, 2*workers)
+			done := make(chan struct{})
+
+			var once sync.Once
+			stop := func() {
+				once.Do(func() {
+					close(done)
+				})
+			}
+
+			// mu serializes notifications sent to the destination.
+			var mu sync.Mutex
+			stopped := false
+			pending := map[int64]lo.Tuple2[context.Context, R]{}
+			next := int64(0)
+
+			emit := func(ctx context.Context, index int64, value R) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				if stopped {
+					return
+				}
+
+				if !config.ordered {
+					destination.NextWithContext(ctx, value)
+					<-inflight
+					return
+				}
+
+				pending[index] = lo.T2(ctx, value)
+
+				for {
+					result, ok := pending[next]
+					if !ok {
+						break
+					}
+
+					delete(pending, next)
+					next++
+
+					destination.NextWithContext(result.A, result.B)
+					<-inflight
+				}
+			}
+
+			fail := func(ctx context.Context, err error) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				if stopped {
+					return
+				}
+
+				stopped = true
+				stop()
+				destination.ErrorWithContext(ctx, err)
+			}
+
+			var wg sync.WaitGroup
+			wg.Add(workers)
+
+			for i := 0; i < workers; i++ {
+				go func() {
+					defer wg.Done()
+
+					for {
+						select {
+						case <-done:
+							return
+						case j, ok := <-jobs:
+							if !ok {
+								return
+							}
+
+							lo.TryCatchWithErrorValue(
+								func() error {
+									emit(j.ctx, j.index, project(j.value))
+									return nil
+								},
+								func(e any) {
+									fail(j.ctx, newObserverError(recoverValueToError(e)))
+								},
+							)
+						}
+					}
+				}()
+			}
+
+			index := int64(0)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						select {
+						case inflight <- struct{}{}:
+						case <-done:
+							return
+						}
+
+						jobs <- job{ctx: ctx, index: index, value: value}
+						index++
+					},
+					fail,
+					func(ctx context.Context) {
+						close(jobs)
+
+						go func() {
+							wg.Wait()
+
+							mu.Lock()
+							defer mu.Unlock()
+
+							if !stopped {
+								stopped = true
+								destination.CompleteWithContext(ctx)
+							}
+						}()
+					},
+				),
+			)
+
+			return func() {
+				stop()
+				sub.Unsubscribe()
+			}
+		})
+	}
+}
+
+// KeyedSequential applies a given project function to each item emitted by an
+// Observable on a pool of `workers` goroutines and emits the results. Items
+// sharing the same key are processed sequentially, in order, on the same
+// worker, while items of different keys are processed in parallel: this is
+// the usual pattern for per-entity event processing. Results of a given key
+// are emitted in order; results of different keys are emitted as soon as they
+// are computed.
+//
+// A key is pinned to a worker while it has items being processed or waiting,
+// then released, so that workers are balanced. At most 2*workers items are
+// processed or awaiting processing at any time: when the limit is reached, the
+// source is blocked.
+//
+// A panic in the project function is converted into an error notification.
+func KeyedSequential[T any, K comparable, R any](key func(item T) K, project func(item T) R, workers int) func(Observable[T]) Observable[R] {
+	if workers <= 0 {
+		panic(ErrKeyedSequentialWrongWorkers)
+	}
+
+	type job struct {
+		ctx   context.Context
+		key   K
+		value T
+	}
+
+	type keyState struct {
+		worker  int
+		pending int
+	}
+
+	return func(source Observable[T]) Observable[R] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[R]) Teardown {
+			jobs := make([]chan job, workers)
+			for i := range jobs {
+				jobs[i] = make(chan job, 2*workers)
+			}
+
+			inflight := make(chan struct{}, 2*workers)
+			done := make(chan struct{})
+
+			var once sync.Once
+			stop := func() {
+				once.Do(func() {
+					close(done)
+				})
+			}
+
+			// muKeys protects the assignment of keys to workers.
+			muKeys := xsync.NewMutexWithSpinlock()
+			keys := map[K]*keyState{}
+			loads := make([]int, workers)
+
+			assign := func(k K) int {
+				muKeys.Lock()
+				defer muKeys.Unlock()
+
+				state, ok := keys[k]
+				if !ok {
+					worker := 0
+					for i := range loads {
+						if loads[i] < loads[worker] {
+							worker = i
+						}
+					}
+
+					state = &keyState{worker: worker}
+					keys[k] = state
+				}
+
+				state.pending++
+				loads[state.worker]++
+
+				return state.worker
+			}
+
+			release := func(k K) {
+				muKeys.Lock()
+				defer muKeys.Unlock()
+
+				state := keys[k]
+				state.pending--
+				loads[state.worker]--
+
+				if state.pending == 0 {
+					delete(keys, k)
+				}
+			}
+
+			// mu serializes notifications sent to the destination.
+			var mu sync.Mutex
+			stopped := false
+
+			emit := func(ctx context.Context, value R) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				if !stopped {
+					destination.NextWithContext(ctx, value)
+				}
+			}
+
+			fail := func(ctx context.Context, err error) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				if stopped {
+					return
+				}
+
+				stopped = true
+				stop()
+				destination.ErrorWithContext(ctx, err)
+			}
+
+			var wg sync.WaitGroup
+			wg.Add(workers)
+
+			for i := 0; i < workers; i++ {
+				go func(jobs <-chan job) {
+					defer wg.Done()
+
+					for {
+						select {
+						case <-done:
+							return
+						case j, ok := <-jobs:
+							if !ok {
+								return
+							}
+
+							lo.TryCatchWithErrorValue(
+								func() error {
+									emit(j.ctx, project(j.value))
+									return nil
+								},
+								func(e any) {
+									fail(j.ctx, newObserverError(recoverValueToError(e)))
+								},
+							)
+
+							release(j.key)
+							<-inflight
+						}
+					}
+				}(jobs[i])
+			}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						select {
+						case inflight <- struct{}{}:
+						case <-done:
+							return
+						}
+
+						k := key(value)
+						jobs[assign(k)] <- job{ctx: ctx, key: k, value: value}
+					},
+					fail,
+					func(ctx context.Context) {
+						for i := range jobs {
+							close(jobs[i])
+						}
+
+						go func() {
+							wg.Wait()
+
+							mu.Lock()
+							defer mu.Unlock()
+
+							if !stopped {
+								stopped = true
+								destination.CompleteWithContext(ctx)
+							}
+						}()
+					},
+				),
+			)
+
+			return func() {
+				stop()
+				sub.Unsubscribe()
+			}
+		})
+	}
+}
+
 // FlatMap transforms the items emitted by an Observable into Observables,
 // then flatten the emissions from those into a single Observable.
 // Play: https://go.dev/play/p/QBkDMwskibT
@@ -208,7 +611,8 @@ func FlatMapIWithContext[T, R any](project func(ctx context.Context, item T, ind
 	}
 }
 
-// Flatten flattens an Observable of Observables into a single Observable.
+// Flatten flattens an Observable of slices into a single Observable, emitting
+// the items of each slice in order.
 // Play: https://go.dev/play/p/vUyrQ4GO87S
 func Flatten[T any]() func(Observable[[]T]) Observable[T] {
 	return func(source Observable[[]T]) Observable[T] {
@@ -231,6 +635,136 @@ func Flatten[T any]() func(Observable[[]T]) Observable[T] {
 	}
 }
 
+// FlattenSlice is an alias for Flatten. It is the inverse of the Buffer
+// operators: batches produced upstream are un-batched downstream.
+func FlattenSlice[T any]() func(Observable[[]T]) Observable[T] {
+	return Flatten[T]()
+}
+
+// FlattenOption configures the FlattenObservable operator.
+type FlattenOption func(config *flattenConfig)
+
+type flattenConfig struct {
+	concurrency int
+}
+
+// WithFlattenConcurrency bounds the number of inner Observables subscribed
+// concurrently by FlattenObservable. The other inner Observables are queued, and
+// subscribed in order as the active ones complete. Default is unbounded.
+func WithFlattenConcurrency(concurrency int) FlattenOption {
+	if concurrency <= 0 {
+		panic(ErrFlattenWrongConcurrency)
+	}
+
+	return func(config *flattenConfig) {
+		config.concurrency = concurrency
+	}
+}
+
+// FlattenObservable flattens an Observable of Observables into a single
+// Observable, merging the values of the inner Observables as they are emitted.
+// It completes when the source and every inner Observable are done. Without
+// options, it behaves like MergeAll. See WithFlattenConcurrency.
+func FlattenObservable[T any](opts ...FlattenOption) func(Observable[Observable[T]]) Observable[T] {
+	config := flattenConfig{}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	if config.concurrency == 0 {
+		return MergeAll[T]()
+	}
+
+	concurrency := config.concurrency
+
+	return func(sources Observable[Observable[T]]) Observable[T] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			var mu sync.Mutex
+
+			queue := []lo.Tuple2[context.Context, Observable[T]]{}
+			active := 0
+			sourcesDone := false
+
+			var completeCtx context.Context
+
+			subscriptions := NewSubscription(nil)
+
+			var subscribe func(ctx context.Context, source Observable[T])
+			subscribe = func(ctx context.Context, source Observable[T]) {
+				sub := source.SubscribeWithContext(
+					ctx,
+					NewObserverWithContext(
+						destination.NextWithContext,
+						destination.ErrorWithContext,
+						func(ctx context.Context) {
+							mu.Lock()
+
+							if len(queue) > 0 {
+								// the slot is handed over to the next queued Observable
+								next := queue[0]
+								queue[0] = lo.Tuple2[context.Context, Observable[T]]{}
+								queue = queue[1:]
+								mu.Unlock()
+
+								subscribe(next.A, next.B)
+
+								return
+							}
+
+							active--
+							done := active == 0 && sourcesDone
+							mu.Unlock()
+
+							if done {
+								destination.CompleteWithContext(completeCtx)
+							}
+						},
+					),
+				)
+
+				subscriptions.AddUnsubscribable(sub)
+			}
+
+			subscriptions.AddUnsubscribable(
+				sources.SubscribeWithContext(
+					subscriberCtx,
+					NewObserverWithContext(
+						func(ctx context.Context, source Observable[T]) {
+							mu.Lock()
+
+							if active >= concurrency {
+								queue = append(queue, lo.T2(ctx, source))
+								mu.Unlock()
+
+								return
+							}
+
+							active++
+							mu.Unlock()
+
+							subscribe(ctx, source)
+						},
+						destination.ErrorWithContext,
+						func(ctx context.Context) {
+							mu.Lock()
+							sourcesDone = true
+							completeCtx = ctx
+							done := active == 0
+							mu.Unlock()
+
+							if done {
+								destination.CompleteWithContext(ctx)
+							}
+						},
+					),
+				),
+			)
+
+			return subscriptions.Unsubscribe
+		})
+	}
+}
+
 // Cast converts each value emitted by an Observable into a specified type.
 // Play: https://go.dev/play/p/XUdqodfFyT6
 func Cast[T, U any]() func(Observable[T]) Observable[U] {
@@ -256,6 +790,33 @@ func Cast[T, U any]() func(Observable[T]) Observable[U] {
 	}
 }
 
+// ToEntries converts each value emitted by an Observable into a key/value entry,
+// using the key and value selectors.
+func ToEntries[T any, K comparable, V any](keySelector func(item T) K, valueSelector func(item T) V) func(Observable[T]) Observable[lo.Entry[K, V]] {
+	return Map(func(item T) lo.Entry[K, V] {
+		return lo.Entry[K, V]{
+			Key:   keySelector(item),
+			Value: valueSelector(item),
+		}
+	})
+}
+
+// Keys emits the key of each entry emitted by an Observable. See FromMap and
+// ToEntries.
+func Keys[K comparable, V any]() func(Observable[lo.Entry[K, V]]) Observable[K] {
+	return Map(func(entry lo.Entry[K, V]) K {
+		return entry.Key
+	})
+}
+
+// Values emits the value of each entry emitted by an Observable. See FromMap
+// and ToEntries.
+func Values[K comparable, V any]() func(Observable[lo.Entry[K, V]]) Observable[V] {
+	return Map(func(entry lo.Entry[K, V]) V {
+		return entry.Value
+	})
+}
+
 // Scan applies an accumulator function over an Observable and emits each intermediate result.
 // Play: https://go.dev/play/p/gAzVq-a0Jiz
 func Scan[T, R any](reduce func(accumulator R, item T) R, seed R) func(Observable[T]) Observable[R] {
@@ -388,27 +949,146 @@ func GroupByIWithContext[T any, K comparable](iteratee func(ctx context.Context,
 	}
 }
 
+// BufferOption configures the buffering operators (BufferWhen, BufferWithTime,
+// BufferWithCount, BufferWithTimeOrCount). See WithBufferReuse,
+// WithBufferMaxSize and WithBufferEmitEmpty.
+type BufferOption func(config *bufferConfig)
+
+type bufferConfig struct {
+	reuse     bool
+	maxSize   int
+	emitEmpty bool
+}
+
+func newBufferConfig(opts []BufferOption) bufferConfig {
+	config := bufferConfig{
+		emitEmpty: true,
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return config
+}
+
+// size returns the size of the buffers, bounded by WithBufferMaxSize.
+func (c bufferConfig) size(size int) int {
+	if c.maxSize > 0 && c.maxSize < size {
+		return c.maxSize
+	}
+
+	return size
+}
+
+// WithBufferMaxSize emits the buffer as soon as it holds maxSize items, without
+// waiting for the boundary. For example, BufferWithTime(d, WithBufferMaxSize(n))
+// emits every d, or every n items.
+func WithBufferMaxSize(maxSize int) BufferOption {
+	if maxSize < 1 {
+		panic(ErrBufferWrongMaxSize)
+	}
+
+	return func(config *bufferConfig) {
+		config.maxSize = maxSize
+	}
+}
+
+// WithBufferEmitEmpty sets whether empty buffers are emitted when a boundary is
+// reached (BufferWhen, BufferWithTime, BufferWithTimeOrCount). Default is true.
+func WithBufferEmitEmpty(emitEmpty bool) BufferOption {
+	return func(config *bufferConfig) {
+		config.emitEmpty = emitEmpty
+	}
+}
+
+// WithBufferReuse recycles the emitted slices: once the downstream observer
+// returns from Next, the slice is cleared and filled with the next buffer. Long
+// running, high-rate pipelines then stop allocating one slice per buffer.
+//
+// The emitted slice is only valid during the Next callback: observers must copy
+// it to retain it, and must not hand it to an asynchronous stage (ObserveOn,
+// MergeMap...).
+func WithBufferReuse() BufferOption {
+	return func(config *bufferConfig) {
+		config.reuse = true
+	}
+}
+
+// bufferArena hands out the slices filled by the buffering operators. When
+// reuse is enabled, emitted slices are recycled instead of being left to the GC.
+// bufferArena is not safe for concurrent use.
+type bufferArena[T any] struct {
+	reuse    bool
+	capacity int
+	free     [][]T
+}
+
+func newBufferArena[T any](config bufferConfig, capacity int) *bufferArena[T] {
+	return &bufferArena[T]{
+		reuse:    config.reuse,
+		capacity: capacity,
+	}
+}
+
+func (a *bufferArena[T]) get() []T {
+	if n := len(a.free); n > 0 {
+		buffer := a.free[n-1]
+		a.free[n-1] = nil
+		a.free = a.free[:n-1]
+
+		return buffer
+	}
+
+	return make([]T, 0, a.capacity)
+}
+
+func (a *bufferArena[T]) put(buffer []T) {
+	if !a.reuse {
+		return
+	}
+
+	var zero T
+	for i := range buffer {
+		buffer[i] = zero // do not pin emitted values in memory
+	}
+
+	a.free = append(a.free, buffer[:0])
+}
+
 // BufferWhen buffers the items emitted by an Observable until a second Observable emits an item.
 // Then it emits the buffer and starts a new buffer. It repeats this process until the source Observable completes.
 // If the boundary Observable completes, the buffer is emitted and the source Observable completes.
 // If the source Observable errors, the buffer is emitted and the error is propagated.
+// See WithBufferReuse to recycle the emitted slices, and WithBufferMaxSize and
+// WithBufferEmitEmpty to bound or skip the buffers.
 // Play: https://go.dev/play/p/w8c_zuaLl9l
-func BufferWhen[T, B any](boundary Observable[B]) func(Observable[T]) Observable[[]T] {
+func BufferWhen[T, B any](boundary Observable[B], opts ...BufferOption) func(Observable[T]) Observable[[]T] {
+	config := newBufferConfig(opts)
+
 	return func(source Observable[T]) Observable[[]T] {
 		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[[]T]) Teardown {
-			buffer := []T{}
+			arena := newBufferArena[T](config, 0)
+			buffer := arena.get()
 			mu := xsync.NewMutexWithSpinlock()
 
 			flush := func(ctx context.Context) {
-				// send even if buffer is empty
 				mu.Lock()
 
+				if len(buffer) == 0 && !config.emitEmpty {
+					mu.Unlock()
+					return
+				}
+
 				tmp := buffer
-				buffer = []T{}
+				buffer = arena.get()
 
 				mu.Unlock()
 
 				destination.NextWithContext(ctx, tmp)
+
+				mu.Lock()
+				arena.put(tmp)
+				mu.Unlock()
 			}
 
 			subscriptions := NewSubscription(nil)
@@ -421,8 +1101,13 @@ func BufferWhen[T, B any](boundary Observable[B]) func(Observable[T]) Observable
 							mu.Lock()
 
 							buffer = append(buffer, value)
+							isFull := config.maxSize > 0 && len(buffer) >= config.maxSize
 
 							mu.Unlock()
+
+							if isFull {
+								flush(ctx)
+							}
 						},
 						destination.ErrorWithContext,
 						func(ctx context.Context) {
@@ -465,9 +1150,10 @@ func BufferWhen[T, B any](boundary Observable[B]) func(Observable[T]) Observable
 // It emits the buffer and starts a new buffer. It repeats this process until the source Observable completes.
 // If the source Observable errors, the buffer is emitted and the error is propagated. If the source Observable completes,
 // the buffer is emitted and the complete notification is propagated. If the specified time or count is reached,
-// the buffer is emitted and a new buffer is started.
+// the buffer is emitted and a new buffer is started. See WithBufferReuse to recycle the emitted slices, and
+// WithBufferEmitEmpty to skip the empty buffers.
 // Play: https://go.dev/play/p/NyiF19jUdQD
-func BufferWithTimeOrCount[T any](size int, duration time.Duration) func(Observable[T]) Observable[[]T] {
+func BufferWithTimeOrCount[T any](size int, duration time.Duration, opts ...BufferOption) func(Observable[T]) Observable[[]T] {
 	if size < 1 {
 		panic(ErrBufferWithTimeOrCountWrongSize)
 	}
@@ -476,21 +1162,33 @@ func BufferWithTimeOrCount[T any](size int, duration time.Duration) func(Observa
 		panic(ErrBufferWithTimeOrCountWrongDuration)
 	}
 
+	config := newBufferConfig(opts)
+	size = config.size(size)
+
 	return func(source Observable[T]) Observable[[]T] {
 		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[[]T]) Teardown {
-			buffer := []T{}
+			arena := newBufferArena[T](config, 0)
+			buffer := arena.get()
 			mu := xsync.NewMutexWithSpinlock()
 
 			flush := func(ctx context.Context) {
-				// send even if buffer is empty
 				mu.Lock()
 
+				if len(buffer) == 0 && !config.emitEmpty {
+					mu.Unlock()
+					return
+				}
+
 				tmp := buffer
-				buffer = []T{}
+				buffer = arena.get()
 
 				mu.Unlock()
 
 				destination.NextWithContext(ctx, tmp)
+
+				mu.Lock()
+				arena.put(tmp)
+				mu.Unlock()
 			}
 
 			subscriptions := NewSubscription(nil)
@@ -553,16 +1251,20 @@ func BufferWithTimeOrCount[T any](size int, duration time.Duration) func(Observa
 // source Observable completes. If the source Observable errors, the buffer is emitted
 // and the error is propagated. If the source Observable completes, the buffer is emitted
 // and the complete notification is propagated. If the specified count is reached, the buffer
-// is emitted and a new buffer is started.
+// is emitted and a new buffer is started. See WithBufferReuse to recycle the emitted slices.
 // Play: https://go.dev/play/p/IXhDtSybE4R
-func BufferWithCount[T any](size int) func(Observable[T]) Observable[[]T] {
+func BufferWithCount[T any](size int, opts ...BufferOption) func(Observable[T]) Observable[[]T] {
 	if size < 1 {
 		panic(ErrBufferWithCountWrongSize)
 	}
 
+	config := newBufferConfig(opts)
+	size = config.size(size)
+
 	return func(source Observable[T]) Observable[[]T] {
 		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[[]T]) Teardown {
-			buffer := make([]T, 0, size)
+			arena := newBufferArena[T](config, size)
+			buffer := arena.get()
 
 			sub := source.SubscribeWithContext(
 				subscriberCtx,
@@ -570,8 +1272,11 @@ func BufferWithCount[T any](size int) func(Observable[T]) Observable[[]T] {
 					func(ctx context.Context, value T) {
 						buffer = append(buffer, value)
 						if len(buffer) >= size {
-							destination.NextWithContext(ctx, buffer)
-							buffer = make([]T, 0, size)
+							tmp := buffer
+							buffer = arena.get()
+
+							destination.NextWithContext(ctx, tmp)
+							arena.put(tmp)
 						}
 					},
 					destination.ErrorWithContext,
@@ -599,13 +1304,235 @@ func BufferWithCount[T any](size int) func(Observable[T]) Observable[[]T] {
 // Observable completes. If the source Observable errors, the buffer is emitted and the error
 // is propagated. If the source Observable completes, the buffer is emitted and the complete
 // notification is propagated. If the specified time is reached, the buffer is emitted and a new buffer is started.
+// See WithBufferReuse to recycle the emitted slices, and WithBufferMaxSize and
+// WithBufferEmitEmpty to bound or skip the buffers.
 // Play: https://go.dev/play/p/TfOhP-f_O45
-func BufferWithTime[T any](duration time.Duration) func(Observable[T]) Observable[[]T] {
+func BufferWithTime[T any](duration time.Duration, opts ...BufferOption) func(Observable[T]) Observable[[]T] {
 	if duration <= 0 {
 		panic(ErrBufferWithTimeWrongDuration)
 	}
 
-	return BufferWhen[T](Interval(duration))
+	return BufferWhen[T](Interval(duration), opts...)
+}
+
+// EventTimeWindow is a window of items emitted by BufferWithEventTime.
+type EventTimeWindow[T any] struct {
+	// Start is the inclusive start of the window, in event time.
+	Start time.Time
+	// End is the exclusive end of the window, in event time.
+	End time.Time
+	// Items are the items of the window, in order of arrival.
+	Items []T
+	// Update is true when the window was already emitted, and is emitted
+	// again with late items. See EventTimeConfig.AllowedLateness.
+	Update bool
+}
+
+// EventTimeConfig is the configuration for the BufferWithEventTime operator.
+type EventTimeConfig[T any] struct {
+	// MaxOutOfOrderness bounds the delay of out-of-order items. The watermark
+	// is the greatest event time received minus MaxOutOfOrderness: a window is
+	// emitted once the watermark passes its end.
+	MaxOutOfOrderness time.Duration
+	// AllowedLateness is the time a window is kept after being emitted. An
+	// item received meanwhile is added to the window, which is emitted again
+	// with Update set.
+	AllowedLateness time.Duration
+	// LateItems receives the items whose window was discarded. When nil, they
+	// are reported to OnDroppedNotification.
+	LateItems Observer[T]
+}
+
+// BufferWithEventTime buffers the items of the source Observable into
+// tumbling windows of the given size, according to the event time returned by
+// timestamp, rather than to the time of arrival. Windows are emitted by
+// increasing start once the watermark passes their end, and the pending
+// windows are emitted when the source completes. Empty windows are not
+// emitted. See EventTimeConfig for the handling of out-of-order and late items.
+func BufferWithEventTime[T any](timestamp func(item T) time.Time, size time.Duration, config EventTimeConfig[T]) func(Observable[T]) Observable[EventTimeWindow[T]] {
+	if size <= 0 {
+		panic(ErrBufferWithEventTimeWrongSize)
+	}
+
+	if config.MaxOutOfOrderness < 0 {
+		panic(ErrBufferWithEventTimeWrongOutOfOrderness)
+	}
+
+	if config.AllowedLateness < 0 {
+		panic(ErrBufferWithEventTimeWrongAllowedLateness)
+	}
+
+	return func(source Observable[T]) Observable[EventTimeWindow[T]] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[EventTimeWindow[T]]) Teardown {
+			type window struct {
+				start time.Time
+				items []T
+				fired bool
+			}
+
+			windows := map[int64]*window{}
+			var watermark time.Time
+			hasWatermark := false
+
+			emit := func(ctx context.Context, w *window) {
+				update := w.fired
+				w.fired = true
+
+				destination.NextWithContext(ctx, EventTimeWindow[T]{
+					Start:  w.start,
+					End:    w.start.Add(size),
+					Items:  append(make([]T, 0, len(w.items)), w.items...),
+					Update: update,
+				})
+			}
+
+			// sortedWindows returns the windows matching the predicate, by
+			// increasing start.
+			sortedWindows := func(predicate func(w *window) bool) []*window {
+				selected := []*window{}
+				for _, w := range windows {
+					if predicate(w) {
+						selected = append(selected, w)
+					}
+				}
+
+				sort.Slice(selected, func(i, j int) bool {
+					return selected[i].start.Before(selected[j].start)
+				})
+
+				return selected
+			}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						ts := timestamp(value)
+						start := ts.Truncate(size)
+
+						if hasWatermark && !start.Add(size+config.AllowedLateness).After(watermark) {
+							if config.LateItems != nil {
+								config.LateItems.NextWithContext(ctx, value)
+							} else {
+								OnDroppedNotification(ctx, NewNotificationNext(value))
+							}
+
+							return
+						}
+
+						w, ok := windows[start.UnixNano()]
+						if !ok {
+							w = &window{start: start}
+							windows[start.UnixNano()] = w
+						}
+
+						w.items = append(w.items, value)
+
+						if w.fired {
+							emit(ctx, w)
+						}
+
+						if next := ts.Add(-config.MaxOutOfOrderness); !hasWatermark || next.After(watermark) {
+							watermark = next
+							hasWatermark = true
+						}
+
+						for _, w := range sortedWindows(func(w *window) bool { return !w.fired && !w.start.Add(size).After(watermark) }) {
+							emit(ctx, w)
+						}
+
+						for key, w := range windows {
+							if w.fired && !w.start.Add(size+config.AllowedLateness).After(watermark) {
+								delete(windows, key)
+							}
+						}
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						for _, w := range sortedWindows(func(w *window) bool { return !w.fired }) {
+							emit(ctx, w)
+						}
+
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// PrioritizeByWithCount reorders the items of the source Observable within
+// batches of size items: each batch is emitted by decreasing priority, items of
+// equal priority keeping their order. The last batch is emitted when the
+// source completes.
+func PrioritizeByWithCount[T any](priority func(item T) int, size int) func(Observable[T]) Observable[T] {
+	if size <= 0 {
+		panic(ErrPrioritizeByWithCountWrongSize)
+	}
+
+	return prioritizeBy(priority, BufferWithCount[T](size))
+}
+
+// PrioritizeByWithTime reorders the items of the source Observable within
+// windows of the given duration: the items received during a window are
+// emitted at its end, by decreasing priority, items of equal priority keeping
+// their order. It lets urgent items overtake the backlog of a saturated
+// consumer, at the cost of a delay of up to one window.
+func PrioritizeByWithTime[T any](priority func(item T) int, duration time.Duration) func(Observable[T]) Observable[T] {
+	if duration <= 0 {
+		panic(ErrPrioritizeByWithTimeWrongDuration)
+	}
+
+	return prioritizeBy(priority, BufferWithTime[T](duration))
+}
+
+// PrioritizeByWithTimeOrCount reorders the items of the source Observable
+// within windows closed after the given duration or once size items were
+// received, whichever happens first. See PrioritizeByWithTime.
+func PrioritizeByWithTimeOrCount[T any](priority func(item T) int, size int, duration time.Duration) func(Observable[T]) Observable[T] {
+	if size <= 0 {
+		panic(ErrPrioritizeByWithTimeOrCountWrongSize)
+	}
+
+	if duration <= 0 {
+		panic(ErrPrioritizeByWithTimeOrCountWrongDuration)
+	}
+
+	return prioritizeBy(priority, BufferWithTimeOrCount[T](size, duration))
+}
+
+func prioritizeBy[T any](priority func(item T) int, buffer func(Observable[T]) Observable[[]T]) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			var prioritized []lo.Tuple2[int, T]
+
+			sub := buffer(source).SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, batch []T) {
+						prioritized = prioritized[:0]
+						for _, item := range batch {
+							prioritized = append(prioritized, lo.T2(priority(item), item))
+						}
+
+						sort.SliceStable(prioritized, func(i, j int) bool {
+							return prioritized[i].A > prioritized[j].A
+						})
+
+						for _, item := range prioritized {
+							destination.NextWithContext(ctx, item.B)
+						}
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
 }
 
 // WindowWhen emits an Observable that represents a window of items emitted by the source Observable.
@@ -15,11 +15,15 @@
 package ro
 
 import (
+	"fmt"
 	"io/fs"
 	"os"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -179,6 +183,220 @@ func TestOperatorTransformationMapErrI(t *testing.T) { //nolint:paralleltest
 	// @TODO: Implement tests
 }
 
+func TestOperatorTransformationChain(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	double := func(v int) (int, bool) { return v * 2, true }
+	multipleOf4 := func(v int) (int, bool) { return v, v%4 == 0 }
+	inc := func(v int) (int, bool) { return v + 1, true }
+
+	values, err := Collect(
+		Chain(double, multipleOf4, inc)(Just(1, 2, 3, 4, 5)),
+	)
+	is.Equal([]int{5, 9}, values)
+	is.NoError(err)
+
+	// same result as the equivalent standard pipeline
+	expected, err := Collect(
+		Pipe3(
+			Just(1, 2, 3, 4, 5),
+			Map(func(v int) int { return v * 2 }),
+			Filter(func(v int) bool { return v%4 == 0 }),
+			Map(func(v int) int { return v + 1 }),
+		),
+	)
+	is.Equal(expected, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Chain[int]()(Just(1, 2, 3)),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	calls := 0
+	values, err = Collect(
+		Chain(
+			func(v int) (int, bool) { return v, false },
+			func(v int) (int, bool) {
+				calls++
+				return v, true
+			},
+		)(Just(1, 2, 3)),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+	is.Zero(calls)
+
+	values, err = Collect(
+		Chain(double)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorTransformationMapParallel(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	slowFirst := func(v int) int {
+		// the first items are the slowest, so that they complete last
+		time.Sleep(time.Duration(10-v) * time.Millisecond)
+		return v * 2
+	}
+
+	values, err := Collect(
+		MapParallel(slowFirst, 4, WithOrdered(true))(Just(1, 2, 3, 4, 5, 6, 7, 8, 9)),
+	)
+	is.Equal([]int{2, 4, 6, 8, 10, 12, 14, 16, 18}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MapParallel(slowFirst, 4)(Just(1, 2, 3, 4, 5, 6, 7, 8, 9)),
+	)
+	is.ElementsMatch([]int{2, 4, 6, 8, 10, 12, 14, 16, 18}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MapParallel(func(v int) int { return v }, 1, WithOrdered(true))(Empty[int]()),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		MapParallel(func(v int) int { return v }, 2)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	values, err = Collect(
+		MapParallel(func(v int) int {
+			if v == 3 {
+				panic(assert.AnError)
+			}
+			return v
+		}, 2, WithOrdered(true))(Just(1, 2, 3, 4)),
+	)
+	is.ErrorIs(err, assert.AnError)
+	is.NotContains(values, 3)
+
+	// early unsubscription
+	values, err = Collect(
+		Take[int](3)(MapParallel(func(v int64) int { return int(v) }, 4, WithOrdered(true))(Range(0, 1000))),
+	)
+	is.Equal([]int{0, 1, 2}, values)
+	is.NoError(err)
+
+	is.PanicsWithValue(ErrMapParallelWrongWorkers, func() {
+		_ = MapParallel(func(v int) int { return v }, 0)
+	})
+}
+
+func TestOperatorTransformationKeyedSequential(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	type event struct {
+		entity string
+		seq    int
+	}
+
+	// Items of the same key are processed in order, never concurrently.
+	var mu sync.Mutex
+	running := map[string]bool{}
+	overlap := false
+	var active, maxActive int32
+
+	process := func(e event) event {
+		mu.Lock()
+		if running[e.entity] {
+			overlap = true
+		}
+		running[e.entity] = true
+		mu.Unlock()
+
+		if n := atomic.AddInt32(&active, 1); n > atomic.LoadInt32(&maxActive) {
+			atomic.StoreInt32(&maxActive, n)
+		}
+
+		time.Sleep(time.Duration(5-e.seq) * time.Millisecond)
+		atomic.AddInt32(&active, -1)
+
+		mu.Lock()
+		running[e.entity] = false
+		mu.Unlock()
+
+		return e
+	}
+
+	source := []event{}
+	for seq := 0; seq < 5; seq++ {
+		for _, entity := range []string{"a", "b", "c", "d"} {
+			source = append(source, event{entity, seq})
+		}
+	}
+
+	values, err := Collect(
+		KeyedSequential(func(e event) string { return e.entity }, process, 4)(Just(source...)),
+	)
+	is.NoError(err)
+	is.Len(values, 20)
+	is.False(overlap)
+	is.Greater(atomic.LoadInt32(&maxActive), int32(1))
+
+	last := map[string]int{}
+	for _, value := range values {
+		if seq, ok := last[value.entity]; ok {
+			is.Less(seq, value.seq)
+		}
+		last[value.entity] = value.seq
+	}
+
+	values2, err := Collect(
+		KeyedSequential(func(v int) int { return v % 2 }, func(v int) int { return v * 2 }, 1)(Just(1, 2, 3, 4)),
+	)
+	is.Equal([]int{2, 4, 6, 8}, values2)
+	is.NoError(err)
+
+	values2, err = Collect(
+		KeyedSequential(func(v int) int { return v }, func(v int) int { return v }, 2)(Empty[int]()),
+	)
+	is.Equal([]int{}, values2)
+	is.NoError(err)
+
+	values2, err = Collect(
+		KeyedSequential(func(v int) int { return v }, func(v int) int { return v }, 2)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int{}, values2)
+	is.EqualError(err, assert.AnError.Error())
+
+	values2, err = Collect(
+		KeyedSequential(func(v int) int { return v % 2 }, func(v int) int {
+			if v == 3 {
+				panic(assert.AnError)
+			}
+			return v
+		}, 2)(Just(1, 2, 3, 4)),
+	)
+	is.ErrorIs(err, assert.AnError)
+	is.NotContains(values2, 3)
+
+	// early unsubscription
+	values2, err = Collect(
+		Take[int](3)(KeyedSequential(func(v int64) int64 { return 0 }, func(v int64) int { return int(v) }, 4)(Range(0, 1000))),
+	)
+	is.Equal([]int{0, 1, 2}, values2)
+	is.NoError(err)
+
+	is.PanicsWithValue(ErrKeyedSequentialWrongWorkers, func() {
+		_ = KeyedSequential(func(v int) int { return v }, func(v int) int { return v }, 0)
+	})
+}
+
 func TestOperatorTransformationFlatMap(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -254,6 +472,101 @@ func TestOperatorTransformationFlatten(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorTransformationFlattenSlice(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(
+		Pipe2(
+			Just(1, 2, 3, 4, 5),
+			BufferWithCount[int](2),
+			FlattenSlice[int](),
+		),
+	)
+	is.Equal([]int{1, 2, 3, 4, 5}, values)
+	is.NoError(err)
+}
+
+func TestOperatorTransformationFlattenObservable(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	var current, peak int32
+
+	inner := func(value int) Observable[int] {
+		return NewObservable(func(observer Observer[int]) Teardown {
+			n := atomic.AddInt32(&current, 1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+
+			go func() {
+				time.Sleep(5 * time.Millisecond)
+				atomic.AddInt32(&current, -1)
+				observer.Next(value)
+				observer.Complete()
+			}()
+
+			return nil
+		})
+	}
+
+	values, err := Collect(
+		FlattenObservable[int](WithFlattenConcurrency(1))(Just(inner(1), inner(2), inner(3))),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+	is.EqualValues(1, atomic.LoadInt32(&peak))
+
+	atomic.StoreInt32(&peak, 0)
+
+	values, err = Collect(
+		FlattenObservable[int](WithFlattenConcurrency(2))(Just(inner(1), inner(2), inner(3), inner(4), inner(5))),
+	)
+	is.ElementsMatch([]int{1, 2, 3, 4, 5}, values)
+	is.NoError(err)
+	is.EqualValues(2, atomic.LoadInt32(&peak))
+
+	values, err = Collect(
+		FlattenObservable[int]()(Just(Just(1, 2), Just(3))),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		FlattenObservable[int](WithFlattenConcurrency(2))(Just(Just(1, 2), Just(3))),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		FlattenObservable[int](WithFlattenConcurrency(2))(Empty[Observable[int]]()),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		FlattenObservable[int](WithFlattenConcurrency(1))(Just(Just(1), Throw[int](assert.AnError), Just(2))),
+	)
+	is.Equal([]int{1}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	values, err = Collect(
+		FlattenObservable[int](WithFlattenConcurrency(1))(Throw[Observable[int]](assert.AnError)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithValue(ErrFlattenWrongConcurrency, func() {
+		WithFlattenConcurrency(0)
+	})
+}
+
 func TestOperatorTransformationCast(t *testing.T) {
 	t.Parallel()
 	testWithTimeout(t, 100*time.Millisecond)
@@ -290,6 +603,55 @@ func TestOperatorTransformationCast(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorTransformationToEntries(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	type user struct {
+		id   int
+		name string
+	}
+
+	entries, err := Collect(
+		ToEntries(
+			func(u user) int { return u.id },
+			func(u user) string { return u.name },
+		)(Just(user{1, "alice"}, user{2, "bob"})),
+	)
+	is.Equal([]lo.Entry[int, string]{{Key: 1, Value: "alice"}, {Key: 2, Value: "bob"}}, entries)
+	is.NoError(err)
+
+	entries, err = Collect(
+		ToEntries(
+			func(u user) int { return u.id },
+			func(u user) string { return u.name },
+		)(Throw[user](assert.AnError)),
+	)
+	is.Equal([]lo.Entry[int, string]{}, entries)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorTransformationKeysValues(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	source := Just(lo.Entry[string, int]{Key: "a", Value: 1}, lo.Entry[string, int]{Key: "b", Value: 2})
+
+	keys, err := Collect(Keys[string, int]()(source))
+	is.Equal([]string{"a", "b"}, keys)
+	is.NoError(err)
+
+	values, err := Collect(Values[string, int]()(source))
+	is.Equal([]int{1, 2}, values)
+	is.NoError(err)
+
+	keys, err = Collect(Keys[string, int]()(Throw[lo.Entry[string, int]](assert.AnError)))
+	is.Equal([]string{}, keys)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorTransformationScan(t *testing.T) {
 	t.Parallel()
 	testWithTimeout(t, 100*time.Millisecond)
@@ -648,6 +1010,353 @@ func TestOperatorTransformationBufferWithTime(t *testing.T) { //nolint:parallelt
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorTransformationBufferOptions(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	boundary := NewPublishSubject[int]()
+	source := NewPublishSubject[int]()
+	values := [][]int{}
+
+	sub := BufferWhen[int, int](boundary, WithBufferMaxSize(2), WithBufferEmitEmpty(false))(source).Subscribe(OnNext(func(buffer []int) {
+		values = append(values, buffer)
+	}))
+
+	source.Next(1)
+	source.Next(2)
+	source.Next(3)
+	boundary.Next(0)
+	boundary.Next(0)
+	source.Next(4)
+	source.Next(5)
+	boundary.Next(0)
+	source.Complete()
+
+	is.Equal([][]int{{1, 2}, {3}, {4, 5}}, values)
+	sub.Unsubscribe()
+
+	result, err := Collect(
+		Pipe1(
+			Just(1, 2, 3, 4, 5),
+			BufferWithTime[int](time.Second, WithBufferMaxSize(2)),
+		),
+	)
+	is.Equal([][]int{{1, 2}, {3, 4}, {5}}, result)
+	is.NoError(err)
+
+	result, err = Collect(
+		Pipe1(
+			Empty[int](),
+			BufferWithTime[int](time.Second, WithBufferEmitEmpty(false)),
+		),
+	)
+	is.Equal([][]int{}, result)
+	is.NoError(err)
+
+	result, err = Collect(
+		Pipe1(
+			Just(1, 2, 3, 4, 5),
+			BufferWithCount[int](3, WithBufferMaxSize(2)),
+		),
+	)
+	is.Equal([][]int{{1, 2}, {3, 4}, {5}}, result)
+	is.NoError(err)
+
+	result, err = Collect(
+		Pipe1(
+			Just(1, 2, 3, 4),
+			BufferWithTimeOrCount[int](3, time.Second, WithBufferMaxSize(2), WithBufferEmitEmpty(false)),
+		),
+	)
+	is.Equal([][]int{{1, 2}, {3, 4}}, result)
+	is.NoError(err)
+
+	is.PanicsWithValue(ErrBufferWrongMaxSize, func() {
+		WithBufferMaxSize(0)
+	})
+}
+
+func TestOperatorTransformationBufferWithBufferReuse(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	// copy emitted slices, since they are recycled after each Next
+	collect := func(obs Observable[[]int]) ([][]int, []*int) {
+		values := [][]int{}
+		backing := []*int{}
+
+		obs.Subscribe(OnNext(func(buffer []int) {
+			values = append(values, append([]int{}, buffer...))
+			if len(buffer) > 0 {
+				backing = append(backing, &buffer[:1][0])
+			}
+		}))
+
+		return values, backing
+	}
+
+	values, backing := collect(BufferWithCount[int](2, WithBufferReuse())(Just(1, 2, 3, 4, 5, 6, 7)))
+	is.Equal([][]int{{1, 2}, {3, 4}, {5, 6}, {7}}, values)
+	is.Len(backing, 4)
+	// two slices alternate: the one being filled and the one being emitted
+	is.True(backing[0] == backing[2])
+	is.True(backing[1] == backing[3])
+	is.False(backing[0] == backing[1])
+
+	values, backing = collect(BufferWithCount[int](2)(Just(1, 2, 3, 4, 5, 6, 7)))
+	is.Equal([][]int{{1, 2}, {3, 4}, {5, 6}, {7}}, values)
+	is.False(backing[0] == backing[2])
+
+	boundary := NewPublishSubject[int]()
+	source := NewPublishSubject[int]()
+	values = [][]int{}
+
+	sub := BufferWhen[int, int](boundary, WithBufferReuse())(source).Subscribe(OnNext(func(buffer []int) {
+		values = append(values, append([]int{}, buffer...))
+	}))
+
+	source.Next(1)
+	source.Next(2)
+	boundary.Next(0)
+	source.Next(3)
+	boundary.Next(0)
+	source.Next(4)
+	source.Next(5)
+	boundary.Next(0)
+	source.Complete()
+
+	is.Equal([][]int{{1, 2}, {3}, {4, 5}, {}}, values)
+	sub.Unsubscribe()
+
+	values2, err := Collect(
+		Pipe2(
+			Just(1, 2, 3, 4, 5),
+			BufferWithTimeOrCount[int](2, time.Second, WithBufferReuse()),
+			Map(func(buffer []int) int { return len(buffer) }),
+		),
+	)
+	is.Equal([]int{2, 2, 1}, values2)
+	is.NoError(err)
+}
+
+func TestOperatorTransformationBufferArena(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	arena := newBufferArena[*int](bufferConfig{reuse: true}, 4)
+	buffer := arena.get()
+	is.Equal(4, cap(buffer))
+
+	value := 42
+	buffer = append(buffer, &value, &value)
+	arena.put(buffer)
+
+	recycled := arena.get()
+	is.Empty(recycled)
+	is.Equal(4, cap(recycled))
+	// emitted values are cleared, not pinned in memory
+	is.Nil(recycled[:2][0])
+	is.Nil(recycled[:2][1])
+
+	arena = newBufferArena[*int](bufferConfig{}, 0)
+	arena.put(buffer)
+	is.Empty(arena.free)
+}
+
+func TestOperatorTransformationBufferWithEventTime(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	type event struct {
+		name string
+		at   int
+	}
+
+	origin := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	timestamp := func(e event) time.Time { return origin.Add(time.Duration(e.at) * time.Second) }
+	second := func(s int) time.Time { return origin.Add(time.Duration(s) * time.Second) }
+	names := func(items []event) []string {
+		out := make([]string, 0, len(items))
+		for _, item := range items {
+			out = append(out, item.name)
+		}
+		return out
+	}
+	summarize := func(windows []EventTimeWindow[event]) []string {
+		out := make([]string, 0, len(windows))
+		for _, w := range windows {
+			out = append(out, fmt.Sprintf("%d-%d%v%v", int(w.Start.Sub(origin).Seconds()), int(w.End.Sub(origin).Seconds()), names(w.Items), lo.Ternary(w.Update, " update", "")))
+		}
+		return out
+	}
+
+	is.PanicsWithValue(ErrBufferWithEventTimeWrongSize, func() {
+		_ = BufferWithEventTime(timestamp, 0, EventTimeConfig[event]{})
+	})
+	is.PanicsWithValue(ErrBufferWithEventTimeWrongOutOfOrderness, func() {
+		_ = BufferWithEventTime(timestamp, time.Second, EventTimeConfig[event]{MaxOutOfOrderness: -1})
+	})
+	is.PanicsWithValue(ErrBufferWithEventTimeWrongAllowedLateness, func() {
+		_ = BufferWithEventTime(timestamp, time.Second, EventTimeConfig[event]{AllowedLateness: -1})
+	})
+
+	// In-order items, late items routed to the side output.
+	late := NewReplaySubject[event](10)
+	values, err := Collect(
+		BufferWithEventTime(timestamp, 10*time.Second, EventTimeConfig[event]{LateItems: late})(
+			Just(event{"a", 1}, event{"b", 5}, event{"c", 12}, event{"d", 25}, event{"e", 3}, event{"f", 27}),
+		),
+	)
+	is.Equal([]string{"0-10[a b]", "10-20[c]", "20-30[d f]"}, summarize(values))
+	is.NoError(err)
+	is.Equal(second(0), values[0].Start)
+	is.Equal(second(10), values[0].End)
+	late.Complete()
+	lateValues, err := Collect[event](late)
+	is.Equal([]string{"e"}, names(lateValues))
+	is.NoError(err)
+
+	// Bounded out-of-orderness delays the emission of the windows.
+	late = NewReplaySubject[event](10)
+	values, err = Collect(
+		BufferWithEventTime(timestamp, 10*time.Second, EventTimeConfig[event]{MaxOutOfOrderness: 5 * time.Second, LateItems: late})(
+			Just(event{"a", 1}, event{"c", 12}, event{"b", 8}, event{"d", 16}, event{"e", 9}),
+		),
+	)
+	is.Equal([]string{"0-10[a b]", "10-20[c d]"}, summarize(values))
+	is.NoError(err)
+	late.Complete()
+	lateValues, err = Collect[event](late)
+	is.Equal([]string{"e"}, names(lateValues))
+	is.NoError(err)
+
+	// Allowed lateness emits updated windows.
+	late = NewReplaySubject[event](10)
+	values, err = Collect(
+		BufferWithEventTime(timestamp, 10*time.Second, EventTimeConfig[event]{AllowedLateness: 10 * time.Second, LateItems: late})(
+			Just(event{"a", 1}, event{"c", 12}, event{"b", 5}, event{"d", 21}, event{"e", 2}),
+		),
+	)
+	is.Equal([]string{"0-10[a]", "0-10[a b] update", "10-20[c]", "20-30[d]"}, summarize(values))
+	is.NoError(err)
+	late.Complete()
+	lateValues, err = Collect[event](late)
+	is.Equal([]string{"e"}, names(lateValues))
+	is.NoError(err)
+
+	values, err = Collect(
+		BufferWithEventTime(timestamp, 10*time.Second, EventTimeConfig[event]{})(Empty[event]()),
+	)
+	is.Equal([]EventTimeWindow[event]{}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		BufferWithEventTime(timestamp, 10*time.Second, EventTimeConfig[event]{})(
+			Concat(Just(event{"a", 1}, event{"b", 12}), Throw[event](assert.AnError)),
+		),
+	)
+	is.Equal([]string{"0-10[a]"}, summarize(values))
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorTransformationPrioritizeBy(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	type job struct {
+		name     string
+		priority int
+	}
+
+	priority := func(item job) int { return item.priority }
+	names := func(items []job) []string {
+		out := make([]string, 0, len(items))
+		for _, item := range items {
+			out = append(out, item.name)
+		}
+		return out
+	}
+
+	is.PanicsWithValue(ErrPrioritizeByWithCountWrongSize, func() {
+		_ = PrioritizeByWithCount(priority, 0)
+	})
+	is.PanicsWithValue(ErrPrioritizeByWithTimeWrongDuration, func() {
+		_ = PrioritizeByWithTime(priority, 0)
+	})
+	is.PanicsWithValue(ErrPrioritizeByWithTimeOrCountWrongSize, func() {
+		_ = PrioritizeByWithTimeOrCount(priority, 0, time.Second)
+	})
+	is.PanicsWithValue(ErrPrioritizeByWithTimeOrCountWrongDuration, func() {
+		_ = PrioritizeByWithTimeOrCount(priority, 1, 0)
+	})
+
+	jobs := Just(
+		job{"a", 1},
+		job{"b", 3},
+		job{"c", 1},
+		job{"d", 2},
+		job{"e", 5},
+	)
+
+	values, err := Collect(PrioritizeByWithCount(priority, 3)(jobs))
+	is.Equal([]string{"b", "a", "c", "e", "d"}, names(values))
+	is.NoError(err)
+
+	values, err = Collect(PrioritizeByWithTime(priority, 50*time.Millisecond)(jobs))
+	is.Equal([]string{"e", "b", "d", "a", "c"}, names(values))
+	is.NoError(err)
+
+	values, err = Collect(PrioritizeByWithTimeOrCount(priority, 2, 50*time.Millisecond)(jobs))
+	is.Equal([]string{"b", "a", "d", "c", "e"}, names(values))
+	is.NoError(err)
+
+	// Items are reordered within time windows only.
+	values, err = Collect(
+		PrioritizeByWithTime(priority, 30*time.Millisecond)(
+			NewObservable(func(destination Observer[job]) Teardown {
+				go func() {
+					destination.Next(job{"a", 1})
+					destination.Next(job{"b", 2})
+					time.Sleep(45 * time.Millisecond)
+					destination.Next(job{"c", 3})
+					destination.Complete()
+				}()
+
+				return nil
+			}),
+		),
+	)
+	is.Equal([]string{"b", "a", "c"}, names(values))
+	is.NoError(err)
+
+	values, err = Collect(
+		PrioritizeByWithCount(priority, 2)(
+			NewObservable(func(destination Observer[job]) Teardown {
+				destination.Next(job{"a", 1})
+				destination.Next(job{"b", 2})
+				destination.Next(job{"c", 3})
+				destination.Error(assert.AnError)
+
+				return nil
+			}),
+		),
+	)
+	is.Equal([]string{"b", "a"}, names(values))
+	is.EqualError(err, assert.AnError.Error())
+
+	values, err = Collect(PrioritizeByWithCount(priority, 3)(Empty[job]()))
+	is.Equal([]job{}, values)
+	is.NoError(err)
+
+	values, err = Collect(PrioritizeByWithCount(priority, 3)(Throw[job](assert.AnError)))
+	is.Equal([]job{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorTransformationWindowWhen(t *testing.T) { //nolint:paralleltest
 	// @TODO: Implement tests
 }
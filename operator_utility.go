@@ -16,11 +16,14 @@ package ro
 
 import (
 	"context"
+	"errors"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/samber/lo"
+	"github.com/samber/ro/internal/xrand"
 	"github.com/samber/ro/internal/xsync"
 	"github.com/samber/ro/internal/xtime"
 )
@@ -218,6 +221,78 @@ func DoOnFinalize[T any](onFinalize func()) func(Observable[T]) Observable[T] {
 	return TapOnFinalize[T](onFinalize)
 }
 
+// TapOnTerminate allows you to perform side effects when the source Observable
+// completes or errors, without modifying the emitted items. The callback is
+// called before the notification is forwarded.
+func TapOnTerminate[T any](onTerminate func()) func(Observable[T]) Observable[T] {
+	return TapOnTerminateWithContext[T](func(ctx context.Context) {
+		onTerminate()
+	})
+}
+
+// TapOnTerminateWithContext allows you to perform side effects when the source
+// Observable completes or errors, without modifying the emitted items. The
+// callback is called before the notification is forwarded.
+func TapOnTerminateWithContext[T any](onTerminate func(ctx context.Context)) func(Observable[T]) Observable[T] {
+	return TapWithContext(
+		func(ctx context.Context, value T) {},
+		func(ctx context.Context, err error) {
+			onTerminate(ctx)
+		},
+		onTerminate,
+	)
+}
+
+// DoOnTerminate is an alias to TapOnTerminate.
+func DoOnTerminate[T any](onTerminate func()) func(Observable[T]) Observable[T] {
+	return TapOnTerminate[T](onTerminate)
+}
+
+// DoOnTerminateWithContext is an alias to TapOnTerminateWithContext.
+func DoOnTerminateWithContext[T any](onTerminate func(ctx context.Context)) func(Observable[T]) Observable[T] {
+	return TapOnTerminateWithContext[T](onTerminate)
+}
+
+// TapOnUnsubscribe allows you to perform side effects when the subscription is
+// canceled by the downstream, before the source Observable completes or errors.
+// Unlike TapOnFinalize, the callback is not called after a termination. It is
+// called after the source is unsubscribed.
+func TapOnUnsubscribe[T any](onUnsubscribe func()) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			terminated := int32(0)
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					destination.NextWithContext,
+					func(ctx context.Context, err error) {
+						atomic.StoreInt32(&terminated, 1)
+						destination.ErrorWithContext(ctx, err)
+					},
+					func(ctx context.Context) {
+						atomic.StoreInt32(&terminated, 1)
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return func() {
+				sub.Unsubscribe()
+
+				if atomic.LoadInt32(&terminated) == 0 {
+					onUnsubscribe()
+				}
+			}
+		})
+	}
+}
+
+// DoOnUnsubscribe is an alias to TapOnUnsubscribe.
+func DoOnUnsubscribe[T any](onUnsubscribe func()) func(Observable[T]) Observable[T] {
+	return TapOnUnsubscribe[T](onUnsubscribe)
+}
+
 // IntervalValue is a value emitted by the `TimeInterval` operator.
 type IntervalValue[T any] struct {
 	Value    T
@@ -388,6 +463,538 @@ func DelayEach[T any](duration time.Duration) func(Observable[T]) Observable[T]
 	}
 }
 
+// Pace enforces a minimum gap between the emissions of the source Observable.
+// Items arriving faster are buffered and emitted in order, one per minInterval,
+// which smooths bursty sources before slow sinks. Items arriving after the gap
+// elapsed are emitted without delay. Error and Complete notifications are
+// forwarded once the buffered items have been emitted.
+func Pace[T any](minInterval time.Duration) func(Observable[T]) Observable[T] {
+	if minInterval <= 0 {
+		panic(ErrPaceWrongInterval)
+	}
+
+	return func(source Observable[T]) Observable[T] {
+		return NewObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			// A single timer is pending at a time, and the next one is scheduled
+			// after the previous emission returned: emissions are serialized and
+			// never reordered.
+			mu := xsync.NewMutexWithSpinlock()
+			queue := []lo.Tuple2[context.Context, Notification[T]]{}
+			scheduled := false
+			closed := false
+			var timer *time.Timer
+			var nextAt time.Time
+
+			// delayOf must be called with the lock held.
+			delayOf := func(notif Notification[T]) time.Duration {
+				if notif.Kind != KindNext {
+					return 0
+				}
+
+				if delay := time.Until(nextAt); delay > 0 {
+					return delay
+				}
+
+				return 0
+			}
+
+			var consume func()
+			consume = func() {
+				mu.Lock()
+
+				if closed || len(queue) == 0 {
+					scheduled = false
+					mu.Unlock()
+					return
+				}
+
+				first := queue[0]
+				queue = queue[1:]
+
+				if first.B.Kind == KindNext {
+					nextAt = time.Now().Add(minInterval)
+				}
+
+				mu.Unlock()
+
+				_ = processNotificationWithObserverAndContext(
+					first.A,
+					first.B,
+					destination,
+				)
+
+				mu.Lock()
+
+				if closed || len(queue) == 0 {
+					scheduled = false
+					mu.Unlock()
+					return
+				}
+
+				timer = time.AfterFunc(delayOf(queue[0].B), consume)
+
+				mu.Unlock()
+			}
+
+			produce := func(ctx context.Context, notif Notification[T]) {
+				mu.Lock()
+
+				if closed {
+					mu.Unlock()
+					return
+				}
+
+				queue = append(queue, lo.T2(ctx, notif))
+
+				if !scheduled {
+					scheduled = true
+					timer = time.AfterFunc(delayOf(notif), consume)
+				}
+
+				mu.Unlock()
+			}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						produce(ctx, NewNotificationNext(value))
+					},
+					func(ctx context.Context, err error) {
+						produce(ctx, NewNotificationError[T](err))
+					},
+					func(ctx context.Context) {
+						produce(ctx, NewNotificationComplete[T]())
+					},
+				),
+			)
+
+			return func() {
+				sub.Unsubscribe()
+
+				mu.Lock()
+
+				closed = true
+				queue = nil
+
+				if timer != nil {
+					timer.Stop()
+				}
+
+				mu.Unlock()
+			}
+		})
+	}
+}
+
+// Bulkhead bounds the number of concurrent subscriptions to the Observables it
+// wraps. It is meant for the inner Observables of asynchronous stages, such as
+// MergeMap or FlatMap, to isolate a slow or failing dependency: the slots are
+// shared by every subscription to the returned operator, and a slot is held
+// until the subscription completes, errors or is canceled.
+//
+// When the maxConcurrent slots are busy, up to maxQueued subscriptions wait for
+// a slot, blocking the caller. The excess is rejected with a
+// *BufferOverflowError, matching ErrBulkheadFull and ErrBufferOverflow. A
+// waiting subscription whose context is canceled emits the context error.
+func Bulkhead[T any](maxConcurrent, maxQueued int) func(Observable[T]) Observable[T] {
+	if maxConcurrent <= 0 {
+		panic(ErrBulkheadWrongMaxConcurrent)
+	}
+
+	if maxQueued < 0 {
+		panic(ErrBulkheadWrongMaxQueued)
+	}
+
+	b := &bulkhead{
+		maxConcurrent: maxConcurrent,
+		maxQueued:     maxQueued,
+	}
+
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			if err := b.acquire(subscriberCtx); err != nil {
+				destination.ErrorWithContext(subscriberCtx, err)
+				return nil
+			}
+
+			var once sync.Once
+			release := func() {
+				once.Do(b.release)
+			}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					destination.NextWithContext,
+					func(ctx context.Context, err error) {
+						release()
+						destination.ErrorWithContext(ctx, err)
+					},
+					func(ctx context.Context) {
+						release()
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return func() {
+				sub.Unsubscribe()
+				release()
+			}
+		})
+	}
+}
+
+type bulkhead struct {
+	mu            sync.Mutex
+	maxConcurrent int
+	maxQueued     int
+	active        int
+	// FIFO of the subscriptions waiting for a slot. A slot is handed over by
+	// closing the channel.
+	waiters []chan struct{}
+}
+
+func (b *bulkhead) acquire(ctx context.Context) error {
+	b.mu.Lock()
+
+	if b.active < b.maxConcurrent {
+		b.active++
+		b.mu.Unlock()
+		return nil
+	}
+
+	if len(b.waiters) >= b.maxQueued {
+		b.mu.Unlock()
+		return newBufferOverflowError("ro.Bulkhead", b.maxQueued, ErrBulkheadFull)
+	}
+
+	ready := make(chan struct{})
+	b.waiters = append(b.waiters, ready)
+
+	b.mu.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		for i, waiter := range b.waiters {
+			if waiter == ready {
+				b.waiters = append(b.waiters[:i], b.waiters[i+1:]...)
+				return ctx.Err()
+			}
+		}
+
+		// The slot was handed over meanwhile: give it back.
+		b.releaseLocked()
+
+		return ctx.Err()
+	}
+}
+
+func (b *bulkhead) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.releaseLocked()
+}
+
+func (b *bulkhead) releaseLocked() {
+	if len(b.waiters) > 0 {
+		close(b.waiters[0])
+		b.waiters = b.waiters[1:]
+
+		return
+	}
+
+	b.active--
+}
+
+// ShedLoadStats reports the activity of the ShedLoad operator.
+type ShedLoadStats struct {
+	// Received is the number of items received so far.
+	Received int64
+	// Dropped is the number of items dropped so far.
+	Dropped int64
+	// Latency is the latest latency returned by the probe.
+	Latency time.Duration
+	// DropProbability is the probability of dropping a low-priority item at
+	// the given latency.
+	DropProbability float64
+}
+
+// ShedLoadOption configures the ShedLoad operator.
+type ShedLoadOption[T any] func(config *shedLoadConfig[T])
+
+type shedLoadConfig[T any] struct {
+	priority    func(item T) int
+	minPriority int
+	onDrop      func(stats ShedLoadStats)
+}
+
+// WithShedLoadPriority protects the items whose priority is greater or equal to
+// minPriority: they are never dropped.
+func WithShedLoadPriority[T any](priority func(item T) int, minPriority int) ShedLoadOption[T] {
+	return func(config *shedLoadConfig[T]) {
+		config.priority = priority
+		config.minPriority = minPriority
+	}
+}
+
+// WithShedLoadOnDrop registers a callback receiving the statistics of the
+// operator each time an item is dropped, for instance to feed a metric.
+func WithShedLoadOnDrop[T any](onDrop func(stats ShedLoadStats)) ShedLoadOption[T] {
+	return func(config *shedLoadConfig[T]) {
+		config.onDrop = onDrop
+	}
+}
+
+// ShedLoad drops items probabilistically when the downstream latency, as
+// returned by probe for each item, exceeds targetLatency. The probability of
+// dropping an item grows linearly from 0 at targetLatency to 1 at twice
+// targetLatency. See WithShedLoadPriority to drop low-priority items only.
+//
+// Dropped items are reported to OnDroppedNotification, as a *DroppedError, and
+// to the WithShedLoadOnDrop callback.
+func ShedLoad[T any](targetLatency time.Duration, probe func() time.Duration, opts ...ShedLoadOption[T]) func(Observable[T]) Observable[T] {
+	if targetLatency <= 0 {
+		panic(ErrShedLoadWrongTargetLatency)
+	}
+
+	config := shedLoadConfig[T]{}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			var received, dropped int64
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						received++
+
+						if config.priority != nil && config.priority(value) >= config.minPriority {
+							destination.NextWithContext(ctx, value)
+							return
+						}
+
+						latency := probe()
+						probability := float64(latency-targetLatency) / float64(targetLatency)
+
+						if probability <= 0 || xrand.Float64() >= probability {
+							destination.NextWithContext(ctx, value)
+							return
+						}
+
+						dropped++
+
+						OnDroppedNotification(ctx, newDroppedError("ro.ShedLoad", value, nil))
+
+						if config.onDrop != nil {
+							config.onDrop(ShedLoadStats{
+								Received:        received,
+								Dropped:         dropped,
+								Latency:         latency,
+								DropProbability: math.Min(probability, 1),
+							})
+						}
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// PausableOption configures the Pausable operator.
+type PausableOption func(config *pausableConfig)
+
+type pausableConfig struct {
+	drop bool
+}
+
+// WithPausableDrop drops the items received while paused, instead of
+// buffering them. Dropped items are reported to OnDroppedNotification, as a
+// *DroppedError.
+func WithPausableDrop() PausableOption {
+	return func(config *pausableConfig) {
+		config.drop = true
+	}
+}
+
+// Pausable returns a handle pausing and resuming the Observables piped through
+// its Operator method, without tearing down the pipeline. While paused, items
+// are buffered and emitted on Resume, or dropped with WithPausableDrop.
+//
+// The handle is shared by every subscription to the Operator. The buffer is
+// unbounded.
+func Pausable[T any](opts ...PausableOption) *PausableHandle[T] {
+	config := pausableConfig{}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return &PausableHandle[T]{
+		config: config,
+		states: map[*pausableState[T]]struct{}{},
+	}
+}
+
+// PausableHandle pauses and resumes the Observables piped through Operator.
+// See Pausable.
+type PausableHandle[T any] struct {
+	config pausableConfig
+	paused int32
+
+	mu     sync.Mutex
+	states map[*pausableState[T]]struct{}
+}
+
+// Pause stops the emissions of the Observables piped through Operator.
+func (h *PausableHandle[T]) Pause() {
+	atomic.StoreInt32(&h.paused, 1)
+}
+
+// Resume restarts the emissions of the Observables piped through Operator,
+// starting with the buffered items.
+func (h *PausableHandle[T]) Resume() {
+	if !atomic.CompareAndSwapInt32(&h.paused, 1, 0) {
+		return
+	}
+
+	h.mu.Lock()
+	states := make([]*pausableState[T], 0, len(h.states))
+	for state := range h.states {
+		states = append(states, state)
+	}
+	h.mu.Unlock()
+
+	for _, state := range states {
+		state.drain()
+	}
+}
+
+// IsPaused returns true when the handle is paused.
+func (h *PausableHandle[T]) IsPaused() bool {
+	return atomic.LoadInt32(&h.paused) == 1
+}
+
+// Operator is the operator to pipe, such as `Pipe1(source, handle.Operator)`.
+func (h *PausableHandle[T]) Operator(source Observable[T]) Observable[T] {
+	return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+		state := &pausableState[T]{
+			handle:      h,
+			destination: destination,
+		}
+
+		h.mu.Lock()
+		h.states[state] = struct{}{}
+		h.mu.Unlock()
+
+		sub := source.SubscribeWithContext(
+			subscriberCtx,
+			NewObserverWithContext(
+				func(ctx context.Context, value T) {
+					state.push(ctx, NewNotificationNext(value))
+				},
+				func(ctx context.Context, err error) {
+					state.push(ctx, NewNotificationError[T](err))
+				},
+				func(ctx context.Context) {
+					state.push(ctx, NewNotificationComplete[T]())
+				},
+			),
+		)
+
+		return func() {
+			sub.Unsubscribe()
+
+			h.mu.Lock()
+			delete(h.states, state)
+			h.mu.Unlock()
+
+			state.mu.Lock()
+			state.queue = nil
+			state.mu.Unlock()
+		}
+	})
+}
+
+// pausableState is the state of a subscription to PausableHandle.Operator.
+// Like Delay, it uses a double mutex to prevent message reordering: one to
+// protect the queue, and one to protect the calls to the destination.
+type pausableState[T any] struct {
+	handle      *PausableHandle[T]
+	destination Observer[T]
+
+	mu       sync.Mutex
+	muNext   sync.Mutex
+	queue    []lo.Tuple2[context.Context, Notification[T]]
+	draining bool
+}
+
+func (s *pausableState[T]) push(ctx context.Context, notif Notification[T]) {
+	s.mu.Lock()
+
+	if s.handle.IsPaused() || s.draining || len(s.queue) > 0 {
+		if notif.Kind == KindNext && s.handle.config.drop && s.handle.IsPaused() {
+			s.mu.Unlock()
+			OnDroppedNotification(ctx, newDroppedError("ro.Pausable", notif.Value, nil))
+			return
+		}
+
+		s.queue = append(s.queue, lo.T2(ctx, notif))
+		s.mu.Unlock()
+
+		return
+	}
+
+	s.muNext.Lock()
+	s.mu.Unlock()
+
+	_ = processNotificationWithObserverAndContext(ctx, notif, s.destination)
+
+	s.muNext.Unlock()
+}
+
+func (s *pausableState[T]) drain() {
+	s.mu.Lock()
+
+	if s.draining {
+		s.mu.Unlock()
+		return
+	}
+
+	s.draining = true
+
+	for len(s.queue) > 0 && !s.handle.IsPaused() {
+		first := s.queue[0]
+		s.queue = s.queue[1:]
+
+		s.muNext.Lock()
+		s.mu.Unlock()
+
+		_ = processNotificationWithObserverAndContext(first.A, first.B, s.destination)
+
+		s.muNext.Unlock()
+		s.mu.Lock()
+	}
+
+	s.draining = false
+
+	s.mu.Unlock()
+}
+
 // RepeatWith repeats the source Observable a specified number of times.
 // This is a pipeable operator. The creation operator equivalent is `Repeat`.
 //
@@ -433,6 +1040,7 @@ func RepeatWith[T any](count int64) func(Observable[T]) Observable[T] {
 }
 
 // Timeout raises an error if the source Observable does not emit any item within the specified duration.
+// The error is a *TimeoutError, matching ErrTimeout.
 // Play: https://go.dev/play/p/t0xKoj-_AqZ
 func Timeout[T any](duration time.Duration) func(Observable[T]) Observable[T] {
 	return func(source Observable[T]) Observable[T] {
@@ -444,7 +1052,7 @@ func Timeout[T any](duration time.Duration) func(Observable[T]) Observable[T] {
 			lastCtx.Store(subscriberCtx) // if no value is emitted, we use the subscriber context
 
 			timer := time.AfterFunc(duration, func() {
-				destination.ErrorWithContext(lastCtx.Load().(context.Context), newTimeoutError(duration)) //nolint:errcheck,forcetypeassert
+				destination.ErrorWithContext(lastCtx.Load().(context.Context), newTimeoutError("ro.Timeout", duration)) //nolint:errcheck,forcetypeassert
 			})
 
 			sub = source.SubscribeWithContext(
@@ -476,6 +1084,111 @@ func Timeout[T any](duration time.Duration) func(Observable[T]) Observable[T] {
 	}
 }
 
+// DeadlinePerItemOption configures the DeadlinePerItem operator.
+type DeadlinePerItemOption func(config *deadlinePerItemConfig)
+
+type deadlinePerItemConfig struct {
+	report bool
+	fail   bool
+}
+
+// WithDeadlinePerItemReport reports the items breaching their deadline to
+// OnDroppedNotification, as a *DroppedError wrapping a *TimeoutError. The item
+// has already been emitted: the report tells that its processing has been
+// abandoned by the operators honoring the context.
+func WithDeadlinePerItemReport() DeadlinePerItemOption {
+	return func(config *deadlinePerItemConfig) {
+		config.report = true
+	}
+}
+
+// WithDeadlinePerItemError makes DeadlinePerItem raise a *TimeoutError,
+// matching ErrTimeout, once the observer returns from an item breaching its
+// deadline.
+func WithDeadlinePerItemError() DeadlinePerItemOption {
+	return func(config *deadlinePerItemConfig) {
+		config.fail = true
+	}
+}
+
+// DeadlinePerItem gives the downstream observer `duration` to process each
+// item: the item is emitted with a child context expiring after `duration`, so
+// that the operators honoring the context abandon it once the deadline is
+// exceeded. The context is canceled when the observer returns: to bound the
+// processing of an asynchronous stage, place DeadlinePerItem after the
+// asynchronous boundary (ObserveOn, MergeMap...).
+//
+// As soon as the deadline is exceeded, and while the observer is still
+// processing the item, onBreach is called from another goroutine with the item
+// and its original context, for instance to send the item to a dead-letter
+// queue. onBreach may be nil. Then the breach is escalated according to the
+// options: see WithDeadlinePerItemReport and WithDeadlinePerItemError. By
+// default, the next items are processed as usual.
+func DeadlinePerItem[T any](duration time.Duration, onBreach func(ctx context.Context, item T), opts ...DeadlinePerItemOption) func(Observable[T]) Observable[T] {
+	if duration <= 0 {
+		panic(ErrDeadlinePerItemWrongDuration)
+	}
+
+	config := deadlinePerItemConfig{}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return func(source Observable[T]) Observable[T] {
+		return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						itemCtx, cancel := context.WithTimeout(ctx, duration)
+						defer cancel()
+
+						var state int32 // 0 - processing, 1 - processed in time, 2 - breached
+
+						// The watcher is released by cancel() when the item is
+						// processed in time.
+						breached := make(chan struct{})
+						go func() {
+							<-itemCtx.Done()
+
+							if !errors.Is(itemCtx.Err(), context.DeadlineExceeded) || !atomic.CompareAndSwapInt32(&state, 0, 2) {
+								return
+							}
+
+							defer close(breached)
+
+							if config.report {
+								OnDroppedNotification(ctx, newDroppedError("ro.DeadlinePerItem", value, newTimeoutError("ro.DeadlinePerItem", duration)))
+							}
+
+							if onBreach != nil {
+								onBreach(ctx, value)
+							}
+						}()
+
+						destination.NextWithContext(itemCtx, value)
+
+						if atomic.CompareAndSwapInt32(&state, 0, 1) {
+							return
+						}
+
+						// Escalates once the breach has been reported.
+						<-breached
+
+						if config.fail {
+							destination.ErrorWithContext(ctx, newTimeoutError("ro.DeadlinePerItem", duration))
+						}
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
 // Materialize converts the source Observable into a stream of Notification instances.
 // Play: https://go.dev/play/p/ZHtPviPoqWK
 func Materialize[T any]() func(Observable[T]) Observable[Notification[T]] {
@@ -15,6 +15,8 @@
 package ro
 
 import (
+	"context"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -237,6 +239,83 @@ func TestOperatorUtilityTapOnFinalize(t *testing.T) {
 	is.EqualValues(6, atomic.LoadInt32(&count))
 }
 
+func TestOperatorUtilityTapOnTerminate(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var count int32
+
+	onTerminate := func() {
+		atomic.AddInt32(&count, 1)
+	}
+
+	values, err := Collect(TapOnTerminate[int](onTerminate)(Just(1, 2, 3)))
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+	is.EqualValues(1, atomic.LoadInt32(&count))
+
+	_, err = Collect(TapOnTerminate[int](onTerminate)(Throw[int](assert.AnError)))
+	is.EqualError(err, assert.AnError.Error())
+	is.EqualValues(2, atomic.LoadInt32(&count))
+
+	// not called on unsubscription
+	sub := TapOnTerminate[struct{}](onTerminate)(Never()).Subscribe(NoopObserver[struct{}]())
+	sub.Unsubscribe()
+	is.EqualValues(2, atomic.LoadInt32(&count))
+
+	// called before the notification is forwarded
+	var order []string
+
+	_, _ = Collect(
+		Pipe2(
+			Empty[int](),
+			DoOnTerminateWithContext[int](func(ctx context.Context) {
+				order = append(order, "terminate")
+			}),
+			DoOnComplete[int](func() {
+				order = append(order, "complete")
+			}),
+		),
+	)
+	is.Equal([]string{"terminate", "complete"}, order)
+}
+
+func TestOperatorUtilityTapOnUnsubscribe(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var count int32
+
+	onUnsubscribe := func() {
+		atomic.AddInt32(&count, 1)
+	}
+
+	// not called on termination
+	_, _ = Collect(TapOnUnsubscribe[int](onUnsubscribe)(Just(1, 2, 3)))
+	_, _ = Collect(TapOnUnsubscribe[int](onUnsubscribe)(Throw[int](assert.AnError)))
+	is.EqualValues(0, atomic.LoadInt32(&count))
+
+	// called on unsubscription
+	sub := DoOnUnsubscribe[struct{}](onUnsubscribe)(Never()).Subscribe(NoopObserver[struct{}]())
+	is.EqualValues(0, atomic.LoadInt32(&count))
+	sub.Unsubscribe()
+	is.EqualValues(1, atomic.LoadInt32(&count))
+	sub.Unsubscribe()
+	is.EqualValues(1, atomic.LoadInt32(&count))
+
+	// called when a downstream operator stops the stream
+	values, err := Collect(
+		Pipe2(
+			Interval(time.Millisecond),
+			TapOnUnsubscribe[int64](onUnsubscribe),
+			Take[int64](1),
+		),
+	)
+	is.Equal([]int64{0}, values)
+	is.NoError(err)
+	is.EqualValues(2, atomic.LoadInt32(&count))
+}
+
 func TestOperatorUtilityTimeInterval(t *testing.T) { //nolint:paralleltest
 	// t.Parallel()
 	testWithTimeout(t, 500*time.Millisecond)
@@ -355,6 +434,357 @@ func TestOperatorUtilityDelay(t *testing.T) { //nolint:paralleltest
 	is.EqualError(err, assert.AnError.Error())
 }
 
+func TestOperatorUtilityPace(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	is.PanicsWithValue(ErrPaceWrongInterval, func() {
+		_ = Pace[int](0)
+	})
+	is.PanicsWithValue(ErrPaceWrongInterval, func() {
+		_ = Pace[int](-1)
+	})
+
+	// A burst is spread evenly.
+	values, err := Collect(
+		Pipe2(
+			Just(1, 2, 3, 4),
+			Pace[int](50*time.Millisecond),
+			TimeInterval[int](),
+		),
+	)
+	is.NoError(err)
+	is.Len(values, 4)
+	is.Equal([]int{1, 2, 3, 4}, lo.Map(values, func(item IntervalValue[int], _ int) int { return item.Value }))
+	for _, value := range values[1:] {
+		is.GreaterOrEqual(value.Interval, 45*time.Millisecond)
+	}
+
+	// Items arriving after the gap are not delayed.
+	start := time.Now()
+	values2, err := Collect(
+		Pace[int](20 * time.Millisecond)(
+			NewObservable(func(destination Observer[int]) Teardown {
+				destination.Next(1)
+				time.Sleep(50 * time.Millisecond)
+				destination.Next(2)
+				destination.Complete()
+
+				return nil
+			}),
+		),
+	)
+	is.Equal([]int{1, 2}, values2)
+	is.NoError(err)
+	is.Less(time.Since(start), 90*time.Millisecond)
+
+	// The Error signal is sent after the buffered items.
+	values2, err = Collect(
+		Pace[int](10 * time.Millisecond)(
+			NewObservable(func(destination Observer[int]) Teardown {
+				destination.Next(1)
+				destination.Next(2)
+				destination.Error(assert.AnError)
+
+				return nil
+			}),
+		),
+	)
+	is.Equal([]int{1, 2}, values2)
+	is.EqualError(err, assert.AnError.Error())
+
+	values2, err = Collect(
+		Pace[int](10 * time.Millisecond)(Empty[int]()),
+	)
+	is.Equal([]int{}, values2)
+	is.NoError(err)
+
+	values2, err = Collect(
+		Pace[int](10 * time.Millisecond)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int{}, values2)
+	is.EqualError(err, assert.AnError.Error())
+
+	// Buffered items are dropped on unsubscription.
+	var count int32
+	sub := Pace[int](50 * time.Millisecond)(Just(1, 2, 3)).Subscribe(
+		OnNext(func(int) { atomic.AddInt32(&count, 1) }),
+	)
+	time.Sleep(20 * time.Millisecond)
+	sub.Unsubscribe()
+	time.Sleep(100 * time.Millisecond)
+	is.EqualValues(1, atomic.LoadInt32(&count))
+}
+
+func TestOperatorUtilityBulkhead(t *testing.T) { //nolint:paralleltest
+	// t.Parallel()
+	testWithTimeout(t, 1000*time.Millisecond)
+	is := assert.New(t)
+
+	is.PanicsWithValue(ErrBulkheadWrongMaxConcurrent, func() {
+		_ = Bulkhead[int](0, 0)
+	})
+	is.PanicsWithValue(ErrBulkheadWrongMaxQueued, func() {
+		_ = Bulkhead[int](1, -1)
+	})
+
+	// Synchronous inner Observables release their slot immediately.
+	bulkhead := Bulkhead[int](1, 0)
+	values, err := Collect(
+		MergeMap(func(item int) Observable[int] {
+			return bulkhead(Of(item))
+		})(Just(1, 2, 3)),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	// The excess is rejected.
+	bulkhead = Bulkhead[int](2, 0)
+	values, err = Collect(
+		MergeMap(func(item int) Observable[int] {
+			return bulkhead(Delay[int](20 * time.Millisecond)(Of(item)))
+		})(Just(1, 2, 3)),
+	)
+	is.Equal([]int{}, values)
+	is.ErrorIs(err, ErrBulkheadFull)
+	is.ErrorIs(err, ErrBufferOverflow)
+	is.EqualError(err, "ro.Bulkhead: buffer overflow (capacity 0)")
+
+	var overflowErr *BufferOverflowError
+	is.ErrorAs(err, &overflowErr)
+	is.Equal("ro.Bulkhead", overflowErr.Operator)
+	is.Equal(0, overflowErr.Capacity)
+
+	// The excess is queued.
+	var active, maxActive int32
+	bulkhead = Bulkhead[int](1, 10)
+	values, err = Collect(
+		MergeMap(func(item int) Observable[int] {
+			return bulkhead(
+				Pipe3(
+					Of(item),
+					TapOnSubscribe[int](func() {
+						if n := atomic.AddInt32(&active, 1); n > atomic.LoadInt32(&maxActive) {
+							atomic.StoreInt32(&maxActive, n)
+						}
+					}),
+					Delay[int](10*time.Millisecond),
+					TapOnComplete[int](func() {
+						atomic.AddInt32(&active, -1)
+					}),
+				),
+			)
+		})(Just(1, 2, 3)),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+	is.EqualValues(1, atomic.LoadInt32(&maxActive))
+
+	// A queued subscription gives up on context cancellation, and unsubscribing
+	// releases the slot.
+	bulkhead = Bulkhead[int](1, 1)
+	sub := bulkhead(NewObservable(func(destination Observer[int]) Teardown { return nil })).Subscribe(NoopObserver[int]())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	values, _, err = CollectWithContext(ctx, bulkhead(Of(1)))
+	is.Equal([]int{}, values)
+	is.ErrorIs(err, context.DeadlineExceeded)
+
+	sub.Unsubscribe()
+	values, err = Collect(bulkhead(Of(1)))
+	is.Equal([]int{1}, values)
+	is.NoError(err)
+}
+
+func TestOperatorUtilityShedLoad(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	is.PanicsWithValue(ErrShedLoadWrongTargetLatency, func() {
+		_ = ShedLoad[int](0, func() time.Duration { return 0 })
+	})
+
+	latency := func(d time.Duration) func() time.Duration {
+		return func() time.Duration { return d }
+	}
+
+	// Below the target, nothing is dropped.
+	values, err := Collect(
+		ShedLoad[int](100*time.Millisecond, latency(100*time.Millisecond))(Just(1, 2, 3)),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	// At twice the target, everything is dropped.
+	var stats []ShedLoadStats
+	values, err = Collect(
+		ShedLoad(
+			100*time.Millisecond,
+			latency(300*time.Millisecond),
+			WithShedLoadOnDrop[int](func(s ShedLoadStats) {
+				stats = append(stats, s)
+			}),
+		)(Just(1, 2, 3)),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+	is.Equal([]ShedLoadStats{
+		{Received: 1, Dropped: 1, Latency: 300 * time.Millisecond, DropProbability: 1},
+		{Received: 2, Dropped: 2, Latency: 300 * time.Millisecond, DropProbability: 1},
+		{Received: 3, Dropped: 3, Latency: 300 * time.Millisecond, DropProbability: 1},
+	}, stats)
+
+	// High-priority items are never dropped.
+	values, err = Collect(
+		ShedLoad(
+			100*time.Millisecond,
+			latency(200*time.Millisecond),
+			WithShedLoadPriority(func(item int) int { return item }, 3),
+		)(Just(1, 2, 3, 4, 5)),
+	)
+	is.Equal([]int{3, 4, 5}, values)
+	is.NoError(err)
+
+	// In between, items are dropped with a probability.
+	var dropped int64
+	values2, err := Collect(
+		ShedLoad(
+			100*time.Millisecond,
+			latency(150*time.Millisecond),
+			WithShedLoadOnDrop[int64](func(s ShedLoadStats) {
+				is.InDelta(0.5, s.DropProbability, 1e-9)
+				dropped = s.Dropped
+			}),
+		)(Range(0, 1000)),
+	)
+	is.NoError(err)
+	is.EqualValues(1000, int64(len(values2))+dropped)
+	is.Greater(len(values2), 300)
+	is.Less(len(values2), 700)
+
+	values, err = Collect(
+		ShedLoad[int](100*time.Millisecond, latency(0))(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestOperatorUtilityPausable(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	// Items are buffered while paused.
+	handle := Pausable[int]()
+	subject := NewPublishSubject[int]()
+
+	var values []int
+	var completed bool
+	sub := handle.Operator(subject).Subscribe(NewObserver(
+		func(value int) { values = append(values, value) },
+		func(err error) {},
+		func() { completed = true },
+	))
+	defer sub.Unsubscribe()
+
+	is.False(handle.IsPaused())
+	subject.Next(1)
+	handle.Pause()
+	is.True(handle.IsPaused())
+	subject.Next(2)
+	subject.Next(3)
+	is.Equal([]int{1}, values)
+
+	handle.Resume()
+	is.False(handle.IsPaused())
+	is.Equal([]int{1, 2, 3}, values)
+
+	subject.Next(4)
+	handle.Pause()
+	subject.Next(5)
+	subject.Complete()
+	is.Equal([]int{1, 2, 3, 4}, values)
+	is.False(completed)
+
+	handle.Resume()
+	is.Equal([]int{1, 2, 3, 4, 5}, values)
+	is.True(completed)
+
+	// Resume is a no-op when not paused.
+	handle.Resume()
+	is.Equal([]int{1, 2, 3, 4, 5}, values)
+
+	// Items are dropped while paused.
+	handle = Pausable[int](WithPausableDrop())
+	subject = NewPublishSubject[int]()
+
+	values = nil
+	completed = false
+	sub = handle.Operator(subject).Subscribe(NewObserver(
+		func(value int) { values = append(values, value) },
+		func(err error) {},
+		func() { completed = true },
+	))
+	defer sub.Unsubscribe()
+
+	subject.Next(1)
+	handle.Pause()
+	subject.Next(2)
+	handle.Resume()
+	subject.Next(3)
+	handle.Pause()
+	subject.Complete()
+	is.Equal([]int{1, 3}, values)
+	is.False(completed)
+	handle.Resume()
+	is.True(completed)
+
+	// The handle is shared by every subscription.
+	handle = Pausable[int]()
+	handle.Pause()
+
+	source := Pipe1(Just(1, 2, 3), handle.Operator)
+	var values1, values2 []int
+	sub1 := source.Subscribe(OnNext(func(value int) { values1 = append(values1, value) }))
+	defer sub1.Unsubscribe()
+	sub2 := source.Subscribe(OnNext(func(value int) { values2 = append(values2, value) }))
+	defer sub2.Unsubscribe()
+	is.Empty(values1)
+	is.Empty(values2)
+
+	handle.Resume()
+	is.Equal([]int{1, 2, 3}, values1)
+	is.Equal([]int{1, 2, 3}, values2)
+
+	// Buffered items are discarded on unsubscription.
+	handle = Pausable[int]()
+	handle.Pause()
+	values = nil
+	sub = Pipe1(Just(1, 2, 3), handle.Operator).Subscribe(OnNext(func(value int) { values = append(values, value) }))
+	sub.Unsubscribe()
+	handle.Resume()
+	is.Empty(values)
+
+	// Errors are forwarded after the buffered items.
+	handle = Pausable[int]()
+	handle.Pause()
+	var err error
+	values = nil
+	sub = Pipe1(Concat(Just(1), Throw[int](assert.AnError)), handle.Operator).Subscribe(NewObserver(
+		func(value int) { values = append(values, value) },
+		func(e error) { err = e },
+		func() {},
+	))
+	defer sub.Unsubscribe()
+	is.Nil(err)
+	handle.Resume()
+	is.Equal([]int{1}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorUtilityRepeatWith(t *testing.T) { //nolint:paralleltest
 	// t.Parallel()
 	testWithTimeout(t, 200*time.Millisecond)
@@ -476,6 +906,108 @@ func TestOperatorUtilityTimeout(t *testing.T) { //nolint:paralleltest
 	is.EqualError(err, assert.AnError.Error())
 }
 
+// newDeadlinePerItemProbe returns an onBreach callback recording the items
+// breaching their deadline, and an operator blocking on even items until their
+// breach has been reported, so that breaches do not depend on timing.
+func newDeadlinePerItemProbe(t *testing.T) (func(context.Context, int), func(Observable[int]) Observable[int], func() []int) {
+	t.Helper()
+	is := assert.New(t)
+
+	var mu sync.Mutex
+
+	breached := []int{}
+	reported := make(chan int, 10)
+
+	onBreach := func(ctx context.Context, value int) {
+		is.NoError(ctx.Err())
+
+		mu.Lock()
+		breached = append(breached, value)
+		mu.Unlock()
+
+		reported <- value
+	}
+
+	process := TapOnNextWithContext(func(ctx context.Context, value int) {
+		_, ok := ctx.Deadline()
+		is.True(ok)
+
+		if value%2 == 0 {
+			<-ctx.Done()
+			is.ErrorIs(ctx.Err(), context.DeadlineExceeded)
+			is.Equal(value, <-reported)
+		}
+	})
+
+	return onBreach, process, func() []int {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return append([]int{}, breached...)
+	}
+}
+
+func TestOperatorUtilityDeadlinePerItem(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 2000*time.Millisecond)
+	is := assert.New(t)
+
+	is.PanicsWithValue(ErrDeadlinePerItemWrongDuration, func() {
+		DeadlinePerItem[int](0, nil)
+	})
+
+	onBreach, process, breached := newDeadlinePerItemProbe(t)
+
+	values, err := Collect(
+		Pipe2(
+			Just(1, 2, 3, 4),
+			DeadlinePerItem(100*time.Millisecond, onBreach),
+			process,
+		),
+	)
+	is.Equal([]int{1, 2, 3, 4}, values)
+	is.NoError(err)
+	is.Equal([]int{2, 4}, breached())
+
+	onBreach, process, breached = newDeadlinePerItemProbe(t)
+
+	values, err = Collect(
+		Pipe2(
+			Just(1, 2, 3, 4),
+			DeadlinePerItem(100*time.Millisecond, onBreach, WithDeadlinePerItemError()),
+			process,
+		),
+	)
+	is.Equal([]int{1, 2}, values)
+	is.ErrorIs(err, ErrTimeout)
+	is.EqualError(err, "ro.DeadlinePerItem: timeout after 100ms")
+	is.Equal([]int{2}, breached())
+
+	var timeoutErr *TimeoutError
+	is.ErrorAs(err, &timeoutErr)
+	is.Equal("ro.DeadlinePerItem", timeoutErr.Operator)
+	is.Equal(100*time.Millisecond, timeoutErr.Duration)
+
+	onBreach, process, breached = newDeadlinePerItemProbe(t)
+
+	values, err = Collect(
+		Pipe2(
+			Just(1, 2, 3),
+			DeadlinePerItem(100*time.Millisecond, onBreach, WithDeadlinePerItemReport()),
+			process,
+		),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+	is.Equal([]int{2}, breached())
+
+	values, err = Collect(
+		DeadlinePerItem[int](100*time.Millisecond, nil)(Throw[int](assert.AnError)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
 func TestOperatorUtilityMaterialize(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
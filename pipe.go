@@ -15,7 +15,10 @@
 package ro
 
 import (
+	"context"
 	"reflect"
+	"runtime"
+	"strings"
 )
 
 // Pipe builds a composition of operators that will be chained to transform
@@ -62,7 +65,35 @@ func Pipe[First, Last any](source Observable[First], operators ...any) Observabl
 
 	v, _ := o.Interface().(Observable[Last])
 
-	return v
+	return describePipe(newPipeDescription(source, operators...), v)
+}
+
+// PipeSame is a typesafe 🎉 implementation of Pipe, for any number of
+// operators that do not change the type of the items, such as long
+// normalization chains that do not fit the PipeX arities.
+//
+// `PipeOpSame()` is the operator version of `PipeSame()`.
+func PipeSame[T any](source Observable[T], operators ...func(Observable[T]) Observable[T]) Observable[T] {
+	description := newPipeDescription(source)
+	if description != nil {
+		for _, operator := range operators {
+			description.operators = append(description.operators, operator)
+		}
+	}
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+	}
+
+	for i, operator := range operators {
+		if PipeStageErrors {
+			operator = withStage(i+1, operator)
+		}
+
+		source = operator(source)
+	}
+
+	return describePipe(description, source)
 }
 
 // Pipe1 is a typesafe 🎉 implementation of Pipe, that takes a source and 1 operator.
@@ -72,7 +103,14 @@ func Pipe1[A, B any](
 	source Observable[A],
 	operator1 func(Observable[A]) Observable[B],
 ) Observable[B] {
-	return operator1(source)
+	description := newPipeDescription(source, operator1)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+	}
+
+	return describePipe(description, operator1(source))
 }
 
 // Pipe2 is a typesafe 🎉 implementation of Pipe, that takes a source and 2 operators.
@@ -83,9 +121,17 @@ func Pipe2[A, B, C any](
 	operator1 func(Observable[A]) Observable[B],
 	operator2 func(Observable[B]) Observable[C],
 ) Observable[C] {
-	return operator2(
+	description := newPipeDescription(source, operator1, operator2)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+	}
+
+	return describePipe(description, operator2(
 		operator1(source),
-	)
+	))
 }
 
 // Pipe3 is a typesafe 🎉 implementation of Pipe, that takes a source and 3 operators.
@@ -97,11 +143,20 @@ func Pipe3[A, B, C, D any](
 	operator2 func(Observable[B]) Observable[C],
 	operator3 func(Observable[C]) Observable[D],
 ) Observable[D] {
-	return operator3(
+	description := newPipeDescription(source, operator1, operator2, operator3)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+	}
+
+	return describePipe(description, operator3(
 		operator2(
 			operator1(source),
 		),
-	)
+	))
 }
 
 // Pipe4 is a typesafe 🎉 implementation of Pipe, that takes a source and 4 operators.
@@ -114,13 +169,23 @@ func Pipe4[A, B, C, D, E any](
 	operator3 func(Observable[C]) Observable[D],
 	operator4 func(Observable[D]) Observable[E],
 ) Observable[E] {
-	return operator4(
+	description := newPipeDescription(source, operator1, operator2, operator3, operator4)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+		operator4 = withStage(4, operator4)
+	}
+
+	return describePipe(description, operator4(
 		operator3(
 			operator2(
 				operator1(source),
 			),
 		),
-	)
+	))
 }
 
 // Pipe5 is a typesafe 🎉 implementation of Pipe, that takes a source and 5 operators.
@@ -134,7 +199,18 @@ func Pipe5[A, B, C, D, E, F any](
 	operator4 func(Observable[D]) Observable[E],
 	operator5 func(Observable[E]) Observable[F],
 ) Observable[F] {
-	return operator5(
+	description := newPipeDescription(source, operator1, operator2, operator3, operator4, operator5)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+		operator4 = withStage(4, operator4)
+		operator5 = withStage(5, operator5)
+	}
+
+	return describePipe(description, operator5(
 		operator4(
 			operator3(
 				operator2(
@@ -142,7 +218,7 @@ func Pipe5[A, B, C, D, E, F any](
 				),
 			),
 		),
-	)
+	))
 }
 
 // Pipe6 is a typesafe 🎉 implementation of Pipe, that takes a source and 6 operators.
@@ -157,7 +233,19 @@ func Pipe6[A, B, C, D, E, F, G any](
 	operator5 func(Observable[E]) Observable[F],
 	operator6 func(Observable[F]) Observable[G],
 ) Observable[G] {
-	return operator6(
+	description := newPipeDescription(source, operator1, operator2, operator3, operator4, operator5, operator6)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+		operator4 = withStage(4, operator4)
+		operator5 = withStage(5, operator5)
+		operator6 = withStage(6, operator6)
+	}
+
+	return describePipe(description, operator6(
 		operator5(
 			operator4(
 				operator3(
@@ -167,7 +255,7 @@ func Pipe6[A, B, C, D, E, F, G any](
 				),
 			),
 		),
-	)
+	))
 }
 
 // Pipe7 is a typesafe 🎉 implementation of Pipe, that takes a source and 7 operators.
@@ -183,7 +271,20 @@ func Pipe7[A, B, C, D, E, F, G, H any](
 	operator6 func(Observable[F]) Observable[G],
 	operator7 func(Observable[G]) Observable[H],
 ) Observable[H] {
-	return operator7(
+	description := newPipeDescription(source, operator1, operator2, operator3, operator4, operator5, operator6, operator7)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+		operator4 = withStage(4, operator4)
+		operator5 = withStage(5, operator5)
+		operator6 = withStage(6, operator6)
+		operator7 = withStage(7, operator7)
+	}
+
+	return describePipe(description, operator7(
 		operator6(
 			operator5(
 				operator4(
@@ -195,7 +296,7 @@ func Pipe7[A, B, C, D, E, F, G, H any](
 				),
 			),
 		),
-	)
+	))
 }
 
 // Pipe8 is a typesafe 🎉 implementation of Pipe, that takes a source and 8 operators.
@@ -212,7 +313,21 @@ func Pipe8[A, B, C, D, E, F, G, H, I any](
 	operator7 func(Observable[G]) Observable[H],
 	operator8 func(Observable[H]) Observable[I],
 ) Observable[I] {
-	return operator8(
+	description := newPipeDescription(source, operator1, operator2, operator3, operator4, operator5, operator6, operator7, operator8)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+		operator4 = withStage(4, operator4)
+		operator5 = withStage(5, operator5)
+		operator6 = withStage(6, operator6)
+		operator7 = withStage(7, operator7)
+		operator8 = withStage(8, operator8)
+	}
+
+	return describePipe(description, operator8(
 		operator7(
 			operator6(
 				operator5(
@@ -226,7 +341,7 @@ func Pipe8[A, B, C, D, E, F, G, H, I any](
 				),
 			),
 		),
-	)
+	))
 }
 
 // Pipe9 is a typesafe 🎉 implementation of Pipe, that takes a source and 9 operators.
@@ -244,7 +359,22 @@ func Pipe9[A, B, C, D, E, F, G, H, I, J any](
 	operator8 func(Observable[H]) Observable[I],
 	operator9 func(Observable[I]) Observable[J],
 ) Observable[J] {
-	return operator9(
+	description := newPipeDescription(source, operator1, operator2, operator3, operator4, operator5, operator6, operator7, operator8, operator9)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+		operator4 = withStage(4, operator4)
+		operator5 = withStage(5, operator5)
+		operator6 = withStage(6, operator6)
+		operator7 = withStage(7, operator7)
+		operator8 = withStage(8, operator8)
+		operator9 = withStage(9, operator9)
+	}
+
+	return describePipe(description, operator9(
 		operator8(
 			operator7(
 				operator6(
@@ -260,7 +390,7 @@ func Pipe9[A, B, C, D, E, F, G, H, I, J any](
 				),
 			),
 		),
-	)
+	))
 }
 
 // Pipe10 is a typesafe 🎉 implementation of Pipe, that takes a source and 10 operators.
@@ -279,7 +409,23 @@ func Pipe10[A, B, C, D, E, F, G, H, I, J, K any](
 	operator9 func(Observable[I]) Observable[J],
 	operator10 func(Observable[J]) Observable[K],
 ) Observable[K] {
-	return operator10(
+	description := newPipeDescription(source, operator1, operator2, operator3, operator4, operator5, operator6, operator7, operator8, operator9, operator10)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+		operator4 = withStage(4, operator4)
+		operator5 = withStage(5, operator5)
+		operator6 = withStage(6, operator6)
+		operator7 = withStage(7, operator7)
+		operator8 = withStage(8, operator8)
+		operator9 = withStage(9, operator9)
+		operator10 = withStage(10, operator10)
+	}
+
+	return describePipe(description, operator10(
 		operator9(
 			operator8(
 				operator7(
@@ -297,7 +443,7 @@ func Pipe10[A, B, C, D, E, F, G, H, I, J, K any](
 				),
 			),
 		),
-	)
+	))
 }
 
 // Pipe11 is a typesafe 🎉 implementation of Pipe, that takes a source and 11 operators.
@@ -317,7 +463,24 @@ func Pipe11[A, B, C, D, E, F, G, H, I, J, K, L any](
 	operator10 func(Observable[J]) Observable[K],
 	operator11 func(Observable[K]) Observable[L],
 ) Observable[L] {
-	return operator11(
+	description := newPipeDescription(source, operator1, operator2, operator3, operator4, operator5, operator6, operator7, operator8, operator9, operator10, operator11)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+		operator4 = withStage(4, operator4)
+		operator5 = withStage(5, operator5)
+		operator6 = withStage(6, operator6)
+		operator7 = withStage(7, operator7)
+		operator8 = withStage(8, operator8)
+		operator9 = withStage(9, operator9)
+		operator10 = withStage(10, operator10)
+		operator11 = withStage(11, operator11)
+	}
+
+	return describePipe(description, operator11(
 		operator10(
 			operator9(
 				operator8(
@@ -337,7 +500,7 @@ func Pipe11[A, B, C, D, E, F, G, H, I, J, K, L any](
 				),
 			),
 		),
-	)
+	))
 }
 
 // Pipe12 is a typesafe 🎉 implementation of Pipe, that takes a source and 12 operators.
@@ -358,7 +521,25 @@ func Pipe12[A, B, C, D, E, F, G, H, I, J, K, L, M any](
 	operator11 func(Observable[K]) Observable[L],
 	operator12 func(Observable[L]) Observable[M],
 ) Observable[M] {
-	return operator12(
+	description := newPipeDescription(source, operator1, operator2, operator3, operator4, operator5, operator6, operator7, operator8, operator9, operator10, operator11, operator12)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+		operator4 = withStage(4, operator4)
+		operator5 = withStage(5, operator5)
+		operator6 = withStage(6, operator6)
+		operator7 = withStage(7, operator7)
+		operator8 = withStage(8, operator8)
+		operator9 = withStage(9, operator9)
+		operator10 = withStage(10, operator10)
+		operator11 = withStage(11, operator11)
+		operator12 = withStage(12, operator12)
+	}
+
+	return describePipe(description, operator12(
 		operator11(
 			operator10(
 				operator9(
@@ -380,7 +561,7 @@ func Pipe12[A, B, C, D, E, F, G, H, I, J, K, L, M any](
 				),
 			),
 		),
-	)
+	))
 }
 
 // Pipe13 is a typesafe 🎉 implementation of Pipe, that takes a source and 13 operators.
@@ -402,7 +583,26 @@ func Pipe13[A, B, C, D, E, F, G, H, I, J, K, L, M, N any](
 	operator12 func(Observable[L]) Observable[M],
 	operator13 func(Observable[M]) Observable[N],
 ) Observable[N] {
-	return operator13(
+	description := newPipeDescription(source, operator1, operator2, operator3, operator4, operator5, operator6, operator7, operator8, operator9, operator10, operator11, operator12, operator13)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+		operator4 = withStage(4, operator4)
+		operator5 = withStage(5, operator5)
+		operator6 = withStage(6, operator6)
+		operator7 = withStage(7, operator7)
+		operator8 = withStage(8, operator8)
+		operator9 = withStage(9, operator9)
+		operator10 = withStage(10, operator10)
+		operator11 = withStage(11, operator11)
+		operator12 = withStage(12, operator12)
+		operator13 = withStage(13, operator13)
+	}
+
+	return describePipe(description, operator13(
 		operator12(
 			operator11(
 				operator10(
@@ -426,7 +626,7 @@ func Pipe13[A, B, C, D, E, F, G, H, I, J, K, L, M, N any](
 				),
 			),
 		),
-	)
+	))
 }
 
 // Pipe14 is a typesafe 🎉 implementation of Pipe, that takes a source and 14 operators.
@@ -449,7 +649,27 @@ func Pipe14[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O any](
 	operator13 func(Observable[M]) Observable[N],
 	operator14 func(Observable[N]) Observable[O],
 ) Observable[O] {
-	return operator14(
+	description := newPipeDescription(source, operator1, operator2, operator3, operator4, operator5, operator6, operator7, operator8, operator9, operator10, operator11, operator12, operator13, operator14)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+		operator4 = withStage(4, operator4)
+		operator5 = withStage(5, operator5)
+		operator6 = withStage(6, operator6)
+		operator7 = withStage(7, operator7)
+		operator8 = withStage(8, operator8)
+		operator9 = withStage(9, operator9)
+		operator10 = withStage(10, operator10)
+		operator11 = withStage(11, operator11)
+		operator12 = withStage(12, operator12)
+		operator13 = withStage(13, operator13)
+		operator14 = withStage(14, operator14)
+	}
+
+	return describePipe(description, operator14(
 		operator13(
 			operator12(
 				operator11(
@@ -475,7 +695,7 @@ func Pipe14[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O any](
 				),
 			),
 		),
-	)
+	))
 }
 
 // Pipe15 is a typesafe 🎉 implementation of Pipe, that takes a source and 15 operators.
@@ -499,7 +719,28 @@ func Pipe15[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P any](
 	operator14 func(Observable[N]) Observable[O],
 	operator15 func(Observable[O]) Observable[P],
 ) Observable[P] {
-	return operator15(
+	description := newPipeDescription(source, operator1, operator2, operator3, operator4, operator5, operator6, operator7, operator8, operator9, operator10, operator11, operator12, operator13, operator14, operator15)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+		operator4 = withStage(4, operator4)
+		operator5 = withStage(5, operator5)
+		operator6 = withStage(6, operator6)
+		operator7 = withStage(7, operator7)
+		operator8 = withStage(8, operator8)
+		operator9 = withStage(9, operator9)
+		operator10 = withStage(10, operator10)
+		operator11 = withStage(11, operator11)
+		operator12 = withStage(12, operator12)
+		operator13 = withStage(13, operator13)
+		operator14 = withStage(14, operator14)
+		operator15 = withStage(15, operator15)
+	}
+
+	return describePipe(description, operator15(
 		operator14(
 			operator13(
 				operator12(
@@ -527,7 +768,7 @@ func Pipe15[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P any](
 				),
 			),
 		),
-	)
+	))
 }
 
 // Pipe16 is a typesafe 🎉 implementation of Pipe, that takes a source and 16 operators.
@@ -552,7 +793,29 @@ func Pipe16[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q any](
 	operator15 func(Observable[O]) Observable[P],
 	operator16 func(Observable[P]) Observable[Q],
 ) Observable[Q] {
-	return operator16(
+	description := newPipeDescription(source, operator1, operator2, operator3, operator4, operator5, operator6, operator7, operator8, operator9, operator10, operator11, operator12, operator13, operator14, operator15, operator16)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+		operator4 = withStage(4, operator4)
+		operator5 = withStage(5, operator5)
+		operator6 = withStage(6, operator6)
+		operator7 = withStage(7, operator7)
+		operator8 = withStage(8, operator8)
+		operator9 = withStage(9, operator9)
+		operator10 = withStage(10, operator10)
+		operator11 = withStage(11, operator11)
+		operator12 = withStage(12, operator12)
+		operator13 = withStage(13, operator13)
+		operator14 = withStage(14, operator14)
+		operator15 = withStage(15, operator15)
+		operator16 = withStage(16, operator16)
+	}
+
+	return describePipe(description, operator16(
 		operator15(
 			operator14(
 				operator13(
@@ -582,7 +845,7 @@ func Pipe16[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q any](
 				),
 			),
 		),
-	)
+	))
 }
 
 // Pipe17 is a typesafe 🎉 implementation of Pipe, that takes a source and 17 operators.
@@ -608,7 +871,30 @@ func Pipe17[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R any](
 	operator16 func(Observable[P]) Observable[Q],
 	operator17 func(Observable[Q]) Observable[R],
 ) Observable[R] {
-	return operator17(
+	description := newPipeDescription(source, operator1, operator2, operator3, operator4, operator5, operator6, operator7, operator8, operator9, operator10, operator11, operator12, operator13, operator14, operator15, operator16, operator17)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+		operator4 = withStage(4, operator4)
+		operator5 = withStage(5, operator5)
+		operator6 = withStage(6, operator6)
+		operator7 = withStage(7, operator7)
+		operator8 = withStage(8, operator8)
+		operator9 = withStage(9, operator9)
+		operator10 = withStage(10, operator10)
+		operator11 = withStage(11, operator11)
+		operator12 = withStage(12, operator12)
+		operator13 = withStage(13, operator13)
+		operator14 = withStage(14, operator14)
+		operator15 = withStage(15, operator15)
+		operator16 = withStage(16, operator16)
+		operator17 = withStage(17, operator17)
+	}
+
+	return describePipe(description, operator17(
 		operator16(
 			operator15(
 				operator14(
@@ -640,7 +926,7 @@ func Pipe17[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R any](
 				),
 			),
 		),
-	)
+	))
 }
 
 // Pipe18 is a typesafe 🎉 implementation of Pipe, that takes a source and 18 operators.
@@ -667,7 +953,31 @@ func Pipe18[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S any](
 	operator17 func(Observable[Q]) Observable[R],
 	operator18 func(Observable[R]) Observable[S],
 ) Observable[S] {
-	return operator18(
+	description := newPipeDescription(source, operator1, operator2, operator3, operator4, operator5, operator6, operator7, operator8, operator9, operator10, operator11, operator12, operator13, operator14, operator15, operator16, operator17, operator18)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+		operator4 = withStage(4, operator4)
+		operator5 = withStage(5, operator5)
+		operator6 = withStage(6, operator6)
+		operator7 = withStage(7, operator7)
+		operator8 = withStage(8, operator8)
+		operator9 = withStage(9, operator9)
+		operator10 = withStage(10, operator10)
+		operator11 = withStage(11, operator11)
+		operator12 = withStage(12, operator12)
+		operator13 = withStage(13, operator13)
+		operator14 = withStage(14, operator14)
+		operator15 = withStage(15, operator15)
+		operator16 = withStage(16, operator16)
+		operator17 = withStage(17, operator17)
+		operator18 = withStage(18, operator18)
+	}
+
+	return describePipe(description, operator18(
 		operator17(
 			operator16(
 				operator15(
@@ -701,7 +1011,7 @@ func Pipe18[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S any](
 				),
 			),
 		),
-	)
+	))
 }
 
 // Pipe19 is a typesafe 🎉 implementation of Pipe, that takes a source and 19 operators.
@@ -729,7 +1039,32 @@ func Pipe19[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T any](
 	operator18 func(Observable[R]) Observable[S],
 	operator19 func(Observable[S]) Observable[T],
 ) Observable[T] {
-	return operator19(
+	description := newPipeDescription(source, operator1, operator2, operator3, operator4, operator5, operator6, operator7, operator8, operator9, operator10, operator11, operator12, operator13, operator14, operator15, operator16, operator17, operator18, operator19)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+		operator4 = withStage(4, operator4)
+		operator5 = withStage(5, operator5)
+		operator6 = withStage(6, operator6)
+		operator7 = withStage(7, operator7)
+		operator8 = withStage(8, operator8)
+		operator9 = withStage(9, operator9)
+		operator10 = withStage(10, operator10)
+		operator11 = withStage(11, operator11)
+		operator12 = withStage(12, operator12)
+		operator13 = withStage(13, operator13)
+		operator14 = withStage(14, operator14)
+		operator15 = withStage(15, operator15)
+		operator16 = withStage(16, operator16)
+		operator17 = withStage(17, operator17)
+		operator18 = withStage(18, operator18)
+		operator19 = withStage(19, operator19)
+	}
+
+	return describePipe(description, operator19(
 		operator18(
 			operator17(
 				operator16(
@@ -765,7 +1100,7 @@ func Pipe19[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T any](
 				),
 			),
 		),
-	)
+	))
 }
 
 // Pipe20 is a typesafe 🎉 implementation of Pipe, that takes a source and 20 operators.
@@ -794,7 +1129,33 @@ func Pipe20[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U any](
 	operator19 func(Observable[S]) Observable[T],
 	operator20 func(Observable[T]) Observable[U],
 ) Observable[U] {
-	return operator20(
+	description := newPipeDescription(source, operator1, operator2, operator3, operator4, operator5, operator6, operator7, operator8, operator9, operator10, operator11, operator12, operator13, operator14, operator15, operator16, operator17, operator18, operator19, operator20)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+		operator4 = withStage(4, operator4)
+		operator5 = withStage(5, operator5)
+		operator6 = withStage(6, operator6)
+		operator7 = withStage(7, operator7)
+		operator8 = withStage(8, operator8)
+		operator9 = withStage(9, operator9)
+		operator10 = withStage(10, operator10)
+		operator11 = withStage(11, operator11)
+		operator12 = withStage(12, operator12)
+		operator13 = withStage(13, operator13)
+		operator14 = withStage(14, operator14)
+		operator15 = withStage(15, operator15)
+		operator16 = withStage(16, operator16)
+		operator17 = withStage(17, operator17)
+		operator18 = withStage(18, operator18)
+		operator19 = withStage(19, operator19)
+		operator20 = withStage(20, operator20)
+	}
+
+	return describePipe(description, operator20(
 		operator19(
 			operator18(
 				operator17(
@@ -832,7 +1193,7 @@ func Pipe20[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U any](
 				),
 			),
 		),
-	)
+	))
 }
 
 // Pipe21 is a typesafe 🎉 implementation of Pipe, that takes a source and 21 operators.
@@ -862,7 +1223,34 @@ func Pipe21[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V any
 	operator20 func(Observable[T]) Observable[U],
 	operator21 func(Observable[U]) Observable[V],
 ) Observable[V] {
-	return operator21(
+	description := newPipeDescription(source, operator1, operator2, operator3, operator4, operator5, operator6, operator7, operator8, operator9, operator10, operator11, operator12, operator13, operator14, operator15, operator16, operator17, operator18, operator19, operator20, operator21)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+		operator4 = withStage(4, operator4)
+		operator5 = withStage(5, operator5)
+		operator6 = withStage(6, operator6)
+		operator7 = withStage(7, operator7)
+		operator8 = withStage(8, operator8)
+		operator9 = withStage(9, operator9)
+		operator10 = withStage(10, operator10)
+		operator11 = withStage(11, operator11)
+		operator12 = withStage(12, operator12)
+		operator13 = withStage(13, operator13)
+		operator14 = withStage(14, operator14)
+		operator15 = withStage(15, operator15)
+		operator16 = withStage(16, operator16)
+		operator17 = withStage(17, operator17)
+		operator18 = withStage(18, operator18)
+		operator19 = withStage(19, operator19)
+		operator20 = withStage(20, operator20)
+		operator21 = withStage(21, operator21)
+	}
+
+	return describePipe(description, operator21(
 		operator20(
 			operator19(
 				operator18(
@@ -902,7 +1290,7 @@ func Pipe21[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V any
 				),
 			),
 		),
-	)
+	))
 }
 
 // Pipe22 is a typesafe 🎉 implementation of Pipe, that takes a source and 22 operators.
@@ -933,7 +1321,35 @@ func Pipe22[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W
 	operator21 func(Observable[U]) Observable[V],
 	operator22 func(Observable[V]) Observable[W],
 ) Observable[W] {
-	return operator22(
+	description := newPipeDescription(source, operator1, operator2, operator3, operator4, operator5, operator6, operator7, operator8, operator9, operator10, operator11, operator12, operator13, operator14, operator15, operator16, operator17, operator18, operator19, operator20, operator21, operator22)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+		operator4 = withStage(4, operator4)
+		operator5 = withStage(5, operator5)
+		operator6 = withStage(6, operator6)
+		operator7 = withStage(7, operator7)
+		operator8 = withStage(8, operator8)
+		operator9 = withStage(9, operator9)
+		operator10 = withStage(10, operator10)
+		operator11 = withStage(11, operator11)
+		operator12 = withStage(12, operator12)
+		operator13 = withStage(13, operator13)
+		operator14 = withStage(14, operator14)
+		operator15 = withStage(15, operator15)
+		operator16 = withStage(16, operator16)
+		operator17 = withStage(17, operator17)
+		operator18 = withStage(18, operator18)
+		operator19 = withStage(19, operator19)
+		operator20 = withStage(20, operator20)
+		operator21 = withStage(21, operator21)
+		operator22 = withStage(22, operator22)
+	}
+
+	return describePipe(description, operator22(
 		operator21(
 			operator20(
 				operator19(
@@ -975,7 +1391,7 @@ func Pipe22[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W
 				),
 			),
 		),
-	)
+	))
 }
 
 // Pipe23 is a typesafe 🎉 implementation of Pipe, that takes a source and 23 operators.
@@ -1007,7 +1423,36 @@ func Pipe23[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W,
 	operator22 func(Observable[V]) Observable[W],
 	operator23 func(Observable[W]) Observable[X],
 ) Observable[X] {
-	return operator23(
+	description := newPipeDescription(source, operator1, operator2, operator3, operator4, operator5, operator6, operator7, operator8, operator9, operator10, operator11, operator12, operator13, operator14, operator15, operator16, operator17, operator18, operator19, operator20, operator21, operator22, operator23)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+		operator4 = withStage(4, operator4)
+		operator5 = withStage(5, operator5)
+		operator6 = withStage(6, operator6)
+		operator7 = withStage(7, operator7)
+		operator8 = withStage(8, operator8)
+		operator9 = withStage(9, operator9)
+		operator10 = withStage(10, operator10)
+		operator11 = withStage(11, operator11)
+		operator12 = withStage(12, operator12)
+		operator13 = withStage(13, operator13)
+		operator14 = withStage(14, operator14)
+		operator15 = withStage(15, operator15)
+		operator16 = withStage(16, operator16)
+		operator17 = withStage(17, operator17)
+		operator18 = withStage(18, operator18)
+		operator19 = withStage(19, operator19)
+		operator20 = withStage(20, operator20)
+		operator21 = withStage(21, operator21)
+		operator22 = withStage(22, operator22)
+		operator23 = withStage(23, operator23)
+	}
+
+	return describePipe(description, operator23(
 		operator22(
 			operator21(
 				operator20(
@@ -1051,7 +1496,7 @@ func Pipe23[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W,
 				),
 			),
 		),
-	)
+	))
 }
 
 // Pipe24 is a typesafe 🎉 implementation of Pipe, that takes a source and 24 operators.
@@ -1084,7 +1529,37 @@ func Pipe24[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W,
 	operator23 func(Observable[W]) Observable[X],
 	operator24 func(Observable[X]) Observable[Y],
 ) Observable[Y] {
-	return operator24(
+	description := newPipeDescription(source, operator1, operator2, operator3, operator4, operator5, operator6, operator7, operator8, operator9, operator10, operator11, operator12, operator13, operator14, operator15, operator16, operator17, operator18, operator19, operator20, operator21, operator22, operator23, operator24)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+		operator4 = withStage(4, operator4)
+		operator5 = withStage(5, operator5)
+		operator6 = withStage(6, operator6)
+		operator7 = withStage(7, operator7)
+		operator8 = withStage(8, operator8)
+		operator9 = withStage(9, operator9)
+		operator10 = withStage(10, operator10)
+		operator11 = withStage(11, operator11)
+		operator12 = withStage(12, operator12)
+		operator13 = withStage(13, operator13)
+		operator14 = withStage(14, operator14)
+		operator15 = withStage(15, operator15)
+		operator16 = withStage(16, operator16)
+		operator17 = withStage(17, operator17)
+		operator18 = withStage(18, operator18)
+		operator19 = withStage(19, operator19)
+		operator20 = withStage(20, operator20)
+		operator21 = withStage(21, operator21)
+		operator22 = withStage(22, operator22)
+		operator23 = withStage(23, operator23)
+		operator24 = withStage(24, operator24)
+	}
+
+	return describePipe(description, operator24(
 		operator23(
 			operator22(
 				operator21(
@@ -1130,7 +1605,7 @@ func Pipe24[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W,
 				),
 			),
 		),
-	)
+	))
 }
 
 // Pipe25 is a typesafe 🎉 implementation of Pipe, that takes a source and 25 operators.
@@ -1164,7 +1639,38 @@ func Pipe25[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W,
 	operator24 func(Observable[X]) Observable[Y],
 	operator25 func(Observable[Y]) Observable[Z],
 ) Observable[Z] {
-	return operator25(
+	description := newPipeDescription(source, operator1, operator2, operator3, operator4, operator5, operator6, operator7, operator8, operator9, operator10, operator11, operator12, operator13, operator14, operator15, operator16, operator17, operator18, operator19, operator20, operator21, operator22, operator23, operator24, operator25)
+
+	if PipeStageErrors {
+		source = withSourceStage(source)
+		operator1 = withStage(1, operator1)
+		operator2 = withStage(2, operator2)
+		operator3 = withStage(3, operator3)
+		operator4 = withStage(4, operator4)
+		operator5 = withStage(5, operator5)
+		operator6 = withStage(6, operator6)
+		operator7 = withStage(7, operator7)
+		operator8 = withStage(8, operator8)
+		operator9 = withStage(9, operator9)
+		operator10 = withStage(10, operator10)
+		operator11 = withStage(11, operator11)
+		operator12 = withStage(12, operator12)
+		operator13 = withStage(13, operator13)
+		operator14 = withStage(14, operator14)
+		operator15 = withStage(15, operator15)
+		operator16 = withStage(16, operator16)
+		operator17 = withStage(17, operator17)
+		operator18 = withStage(18, operator18)
+		operator19 = withStage(19, operator19)
+		operator20 = withStage(20, operator20)
+		operator21 = withStage(21, operator21)
+		operator22 = withStage(22, operator22)
+		operator23 = withStage(23, operator23)
+		operator24 = withStage(24, operator24)
+		operator25 = withStage(25, operator25)
+	}
+
+	return describePipe(description, operator25(
 		operator24(
 			operator23(
 				operator22(
@@ -1212,7 +1718,7 @@ func Pipe25[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W,
 				),
 			),
 		),
-	)
+	))
 }
 
 // PipeOp is similar to Pipe, but can be used as an operator.
@@ -1222,6 +1728,27 @@ func PipeOp[First, Last any](operators ...any) func(Observable[First]) Observabl
 	}
 }
 
+// PipeOpSame is similar to PipeSame, but can be used as an operator.
+func PipeOpSame[T any](operators ...func(Observable[T]) Observable[T]) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		return PipeSame(source, operators...)
+	}
+}
+
+// Compose bundles operators that do not change the type of the items into a
+// single operator, so that a reusable chain can be defined once and dropped
+// into any Pipe slot. Unlike PipeOpSame, the bundle is a single stage of the
+// enclosing pipe: StageError reports it as "ro.Compose".
+func Compose[T any](operators ...func(Observable[T]) Observable[T]) func(Observable[T]) Observable[T] {
+	return func(source Observable[T]) Observable[T] {
+		for _, operator := range operators {
+			source = operator(source)
+		}
+
+		return source
+	}
+}
+
 // PipeOp1 is similar to Pipe1, but can be used as an operator.
 func PipeOp1[A, B any](
 	operator1 func(Observable[A]) Observable[B],
@@ -2121,3 +2648,68 @@ func PipeOp25[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V,
 		)
 	}
 }
+
+// withSourceStage attributes the errors emitted by the source of a pipe.
+// See PipeStageErrors.
+func withSourceStage[T any](source Observable[T]) Observable[T] {
+	return newStageObservable(source, 0, "", stageTypeName[T]())
+}
+
+// withStage attributes the errors emitted by the nth operator of a pipe.
+// See PipeStageErrors.
+func withStage[T, R any](index int, operator func(Observable[T]) Observable[R]) func(Observable[T]) Observable[R] {
+	name := stageOperatorName(operator)
+	typ := stageTypeName[R]()
+
+	return func(source Observable[T]) Observable[R] {
+		return newStageObservable(operator(source), index, name, typ)
+	}
+}
+
+func newStageObservable[T any](source Observable[T], index int, operator string, typ string) Observable[T] {
+	return NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination Observer[T]) Teardown {
+		sub := source.SubscribeWithContext(
+			subscriberCtx,
+			NewObserverWithContext(
+				destination.NextWithContext,
+				func(ctx context.Context, err error) {
+					destination.ErrorWithContext(ctx, newStageError(ctx, index, operator, typ, err))
+				},
+				destination.CompleteWithContext,
+			),
+		)
+
+		return sub.Unsubscribe
+	})
+}
+
+func stageTypeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
+
+// stageOperatorName returns the name of the function that declared the
+// operator closure: "github.com/samber/ro.Map[...].func1" becomes "ro.Map".
+func stageOperatorName(operator any) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(operator).Pointer())
+	if fn == nil {
+		return ""
+	}
+
+	name := fn.Name()
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+
+	name = strings.ReplaceAll(name, "[...]", "")
+
+	for {
+		i := strings.LastIndex(name, ".")
+		if i <= 0 || !strings.HasPrefix(name[i+1:], "func") {
+			break
+		}
+
+		name = name[:i]
+	}
+
+	return name
+}
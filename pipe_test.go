@@ -15,7 +15,9 @@
 package ro
 
 import (
+	"context"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -1331,3 +1333,190 @@ func TestPipeOp(t *testing.T) { //nolint:paralleltest
 func TestPipeOpX(t *testing.T) { //nolint:paralleltest
 	// @TODO: implement
 }
+
+func TestPipeSame(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	increment := Map(func(x int) int { return x + 1 })
+
+	operators := []func(Observable[int]) Observable[int]{}
+	for i := 0; i < 42; i++ {
+		operators = append(operators, increment)
+	}
+
+	values, err := Collect(
+		PipeSame(Just(1, 2, 3), operators...),
+	)
+	is.Equal([]int{43, 44, 45}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		PipeSame(Just(1, 2, 3)),
+	)
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe2(
+			Just(1, 2, 3),
+			PipeOpSame(increment, Filter(func(x int) bool { return x%2 == 0 })),
+			Map(func(x int) int { return x * 10 }),
+		),
+	)
+	is.Equal([]int{20, 40}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		PipeSame(Throw[int](assert.AnError), increment),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestCompose(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	sanitize := Compose(
+		Map(strings.TrimSpace),
+		Filter(func(s string) bool { return s != "" }),
+		Map(strings.ToLower),
+	)
+
+	values, err := Collect(
+		Pipe2(
+			Just(" Foo", "", "BAR ", "  "),
+			sanitize,
+			Map(func(s string) string { return s + "!" }),
+		),
+	)
+	is.Equal([]string{"foo!", "bar!"}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe1(Just("a", "b"), Compose[string]()),
+	)
+	is.Equal([]string{"a", "b"}, values)
+	is.NoError(err)
+
+	values, err = Collect(
+		Pipe1(Throw[string](assert.AnError), sanitize),
+	)
+	is.Equal([]string{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestPipeStageErrors(t *testing.T) { //nolint:paralleltest
+	// t.Parallel() // PipeStageErrors is a global setting
+	is := assert.New(t)
+
+	PipeStageErrors = true
+	defer func() { PipeStageErrors = false }()
+
+	// error emitted by an operator
+	values, err := Collect(
+		Pipe3(
+			Just(1, 2, 3),
+			Map(func(x int) int { return x * 2 }),
+			MapErr(func(x int) (string, error) {
+				if x == 4 {
+					return "", assert.AnError
+				}
+
+				return strconv.Itoa(x), nil
+			}),
+			Filter(func(x string) bool { return true }),
+		),
+	)
+	is.Equal([]string{"2"}, values)
+	is.ErrorIs(err, assert.AnError)
+
+	var stageErr *StageError
+	is.ErrorAs(err, &stageErr)
+	is.Equal(2, stageErr.Index)
+	is.Equal("ro.MapErrIWithContext", stageErr.Operator) // MapErr is built on top of MapErrIWithContext
+	is.Equal("string", stageErr.Type)
+	is.EqualError(err, "ro.Pipe: stage 2 ro.MapErrIWithContext (string): "+assert.AnError.Error())
+
+	// error emitted by the source
+	_, err = Collect(
+		Pipe2(
+			Throw[int](assert.AnError),
+			Map(func(x int) int { return x * 2 }),
+			Filter(func(x int) bool { return true }),
+		),
+	)
+	is.ErrorAs(err, &stageErr)
+	is.Equal(0, stageErr.Index)
+	is.Equal("", stageErr.Operator)
+	is.EqualError(err, "ro.Pipe: source (int): "+assert.AnError.Error())
+
+	// nested pipes keep the innermost stage
+	_, err = Collect(
+		Pipe2(
+			Just(1),
+			Map(func(x int) int { return x }),
+			PipeOp2(
+				Filter(func(x int) bool { return true }),
+				MapErr(func(x int) (int, error) { return 0, assert.AnError }),
+			),
+		),
+	)
+	is.ErrorAs(err, &stageErr)
+	is.Equal(2, stageErr.Index)
+	is.Equal("ro.MapErrIWithContext", stageErr.Operator) // MapErr is built on top of MapErrIWithContext
+
+	// PipeSame
+	_, err = Collect(
+		PipeSame(
+			Just(1),
+			Map(func(x int) int { return x }),
+			MapErr(func(x int) (int, error) { return 0, assert.AnError }),
+		),
+	)
+	is.ErrorAs(err, &stageErr)
+	is.Equal(2, stageErr.Index)
+	is.Equal("ro.MapErrIWithContext", stageErr.Operator) // MapErr is built on top of MapErrIWithContext
+
+	// Compose is a single stage
+	_, err = Collect(
+		Pipe2(
+			Just(1),
+			Map(func(x int) int { return x }),
+			Compose(
+				Filter(func(x int) bool { return true }),
+				MapErr(func(x int) (int, error) { return 0, assert.AnError }),
+			),
+		),
+	)
+	is.ErrorAs(err, &stageErr)
+	is.Equal(2, stageErr.Index)
+	is.Equal("ro.Compose", stageErr.Operator)
+
+	// pipeline metadata
+	_, _, err = CollectWithContext(
+		WithStageTag(WithPipelineName(context.Background(), "orders"), "tenant", "acme"),
+		Pipe1(
+			Just(1),
+			MapErr(func(x int) (int, error) { return 0, assert.AnError }),
+		),
+	)
+	is.ErrorAs(err, &stageErr)
+	is.Equal("orders", stageErr.Pipeline)
+	is.Equal(map[string]string{"tenant": "acme"}, stageErr.Tags)
+	is.EqualError(err, "ro.Pipe(orders): stage 1 ro.MapErrIWithContext (int): "+assert.AnError.Error())
+
+	// disabled
+	PipeStageErrors = false
+
+	_, err = Collect(
+		Pipe1(
+			Throw[int](assert.AnError),
+			Map(func(x int) int { return x * 2 }),
+		),
+	)
+	is.Equal(assert.AnError, err)
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rosql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/samber/ro"
+)
+
+var _ ro.Checkpointer = (*checkpointer)(nil)
+
+// NewCheckpointer creates a ro.Checkpointer storing the positions in a
+// database table. Since the placeholder syntax and the upsert statement depend
+// on the database, the queries are provided by the caller:
+//
+//   - loadQuery selects the position of a key, passed as first argument. No
+//     row means that no position has been saved yet.
+//   - saveQuery inserts or updates the position of a key. The key and the
+//     position are passed as first and second arguments.
+//
+// Example, with PostgreSQL:
+//
+//	checkpointer := rosql.NewCheckpointer(
+//		db,
+//		"SELECT position FROM checkpoints WHERE key = $1",
+//		"INSERT INTO checkpoints (key, position) VALUES ($1, $2) ON CONFLICT (key) DO UPDATE SET position = $2",
+//	)
+func NewCheckpointer(db *sql.DB, loadQuery string, saveQuery string) ro.Checkpointer {
+	return &checkpointer{
+		db:        db,
+		loadQuery: loadQuery,
+		saveQuery: saveQuery,
+	}
+}
+
+type checkpointer struct {
+	db        *sql.DB
+	loadQuery string
+	saveQuery string
+}
+
+func (c *checkpointer) Load(ctx context.Context, key string) (int64, bool, error) {
+	var offset int64
+
+	err := c.db.QueryRowContext(ctx, c.loadQuery, key).Scan(&offset)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, err
+	}
+
+	return offset, true, nil
+}
+
+func (c *checkpointer) Save(ctx context.Context, key string, offset int64) error {
+	_, err := c.db.ExecContext(ctx, c.saveQuery, key, offset)
+	return err
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rosql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+// checkpointTable is a fake database with a single key/position table. The
+// "save" query upserts a position, the "load" query selects it, and any other
+// query fails.
+type checkpointTable struct {
+	mu        sync.Mutex
+	positions map[string]int64
+}
+
+func (t *checkpointTable) Connect(context.Context) (driver.Conn, error) { return t, nil }
+func (t *checkpointTable) Driver() driver.Driver                        { return nil }
+func (t *checkpointTable) Close() error                                 { return nil }
+func (t *checkpointTable) Begin() (driver.Tx, error)                    { return nil, errors.New("not supported") }
+func (t *checkpointTable) Prepare(query string) (driver.Stmt, error) {
+	return &checkpointStmt{table: t, query: query}, nil
+}
+
+type checkpointStmt struct {
+	table *checkpointTable
+	query string
+}
+
+func (s *checkpointStmt) Close() error  { return nil }
+func (s *checkpointStmt) NumInput() int { return -1 }
+func (s *checkpointStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if s.query != "save" {
+		return nil, assert.AnError
+	}
+
+	s.table.mu.Lock()
+	defer s.table.mu.Unlock()
+
+	s.table.positions[args[0].(string)] = args[1].(int64) //nolint:forcetypeassert
+
+	return driver.RowsAffected(1), nil
+}
+
+func (s *checkpointStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if s.query != "load" {
+		return nil, assert.AnError
+	}
+
+	s.table.mu.Lock()
+	defer s.table.mu.Unlock()
+
+	rows := &checkpointRows{}
+	if position, ok := s.table.positions[args[0].(string)]; ok { //nolint:forcetypeassert
+		rows.values = []int64{position}
+	}
+
+	return rows, nil
+}
+
+type checkpointRows struct {
+	values []int64
+}
+
+func (r *checkpointRows) Columns() []string { return []string{"position"} }
+func (r *checkpointRows) Close() error      { return nil }
+func (r *checkpointRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+
+	dest[0] = r.values[0]
+	r.values = r.values[1:]
+
+	return nil
+}
+
+func TestCheckpointer(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+	ctx := context.Background()
+
+	db := sql.OpenDB(&checkpointTable{positions: map[string]int64{}})
+	t.Cleanup(func() { _ = db.Close() })
+
+	checkpointer := NewCheckpointer(db, "load", "save")
+
+	_, ok, err := checkpointer.Load(ctx, "a")
+	is.NoError(err)
+	is.False(ok)
+
+	values, err := ro.Collect(
+		ro.Checkpoint(checkpointer, "a", func(value int64) int64 { return value })(ro.Just[int64](1, 2, 3)),
+	)
+	is.NoError(err)
+	is.Equal([]int64{1, 2, 3}, values)
+
+	position, ok, err := checkpointer.Load(ctx, "a")
+	is.NoError(err)
+	is.True(ok)
+	is.EqualValues(3, position)
+
+	// query errors
+	checkpointer = NewCheckpointer(db, "wrong", "wrong")
+
+	_, _, err = checkpointer.Load(ctx, "a")
+	is.ErrorIs(err, assert.AnError)
+	is.ErrorIs(checkpointer.Save(ctx, "a", 1), assert.AnError)
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rosql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/samber/ro"
+)
+
+// FromRows creates an observable that emits the rows of a result set, one by
+// one, converted by the scan function. The rows are streamed: the result set
+// is never buffered in memory.
+//
+// The rows are closed when the observable completes, errors or is
+// unsubscribed. Scan errors, iteration errors (rows.Err) and close errors are
+// emitted as error notifications. A result set can be read only once, so the
+// observable must be subscribed a single time.
+//
+// Cancellation of the query is driven by the context passed to
+// db.QueryContext: iteration stops and rows.Err returns the context error.
+func FromRows[T any](rows *sql.Rows, scan func(*sql.Rows) (T, error)) ro.Observable[T] {
+	return ro.NewUnsafeObservableWithContext(func(ctx context.Context, destination ro.Observer[T]) ro.Teardown {
+		defer rows.Close() //nolint:errcheck
+
+		for rows.Next() {
+			if destination.IsClosed() {
+				return nil
+			}
+
+			value, err := scan(rows)
+			if err != nil {
+				destination.ErrorWithContext(ctx, err)
+				return nil
+			}
+
+			destination.NextWithContext(ctx, value)
+		}
+
+		if err := rows.Err(); err != nil {
+			destination.ErrorWithContext(ctx, err)
+			return nil
+		}
+
+		if err := rows.Close(); err != nil {
+			destination.ErrorWithContext(ctx, err)
+			return nil
+		}
+
+		destination.CompleteWithContext(ctx)
+
+		return nil
+	})
+}
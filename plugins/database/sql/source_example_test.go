@@ -0,0 +1,48 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rosql
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/samber/ro"
+)
+
+func ExampleFromRows() {
+	db := sql.OpenDB(fakeConnector{})
+	defer db.Close()
+
+	rows, err := db.Query("SELECT id, name FROM users")
+	if err != nil {
+		panic(err)
+	}
+
+	observable := FromRows(rows, func(rows *sql.Rows) (string, error) {
+		var id int64
+		var name string
+		err := rows.Scan(&id, &name)
+		return fmt.Sprintf("%d:%s", id, name), err
+	})
+
+	subscription := observable.Subscribe(ro.PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 1:Alice
+	// Next: 2:Bob
+	// Next: 3:Charlie
+	// Completed
+}
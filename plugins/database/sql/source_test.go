@@ -0,0 +1,181 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rosql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeConnector serves queries from an in-memory table of users. The query
+// "fail" returns an iteration error after the first row.
+type fakeConnector struct{}
+
+func (c fakeConnector) Connect(context.Context) (driver.Conn, error) { return fakeConn{}, nil }
+func (c fakeConnector) Driver() driver.Driver                        { return fakeDriver{} }
+
+type fakeDriver struct{}
+
+func (d fakeDriver) Open(string) (driver.Conn, error) { return fakeConn{}, nil }
+
+type fakeConn struct{}
+
+func (c fakeConn) Prepare(query string) (driver.Stmt, error) { return fakeStmt{query: query}, nil }
+func (c fakeConn) Close() error                              { return nil }
+func (c fakeConn) Begin() (driver.Tx, error)                 { return nil, errors.New("not supported") }
+
+type fakeStmt struct {
+	query string
+}
+
+func (s fakeStmt) Close() error  { return nil }
+func (s fakeStmt) NumInput() int { return -1 }
+func (s fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (s fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &fakeRows{
+		values: [][]driver.Value{{int64(1), "Alice"}, {int64(2), "Bob"}, {int64(3), "Charlie"}},
+		fail:   s.query == "fail",
+	}, nil
+}
+
+type fakeRows struct {
+	values [][]driver.Value
+	cursor int
+	fail   bool
+}
+
+func (r *fakeRows) Columns() []string { return []string{"id", "name"} }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.fail && r.cursor == 1 {
+		return assert.AnError
+	}
+
+	if r.cursor >= len(r.values) {
+		return io.EOF
+	}
+
+	copy(dest, r.values[r.cursor])
+	r.cursor++
+
+	return nil
+}
+
+type user struct {
+	ID   int64
+	Name string
+}
+
+func scanUser(rows *sql.Rows) (user, error) {
+	var u user
+	err := rows.Scan(&u.ID, &u.Name)
+	return u, err
+}
+
+func query(t *testing.T, q string) *sql.Rows {
+	t.Helper()
+
+	db := sql.OpenDB(fakeConnector{})
+	t.Cleanup(func() { _ = db.Close() })
+
+	rows, err := db.Query(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return rows
+}
+
+func isClosed(rows *sql.Rows) bool {
+	_, err := rows.Columns()
+	return err != nil
+}
+
+func TestFromRows(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	rows := query(t, "users")
+
+	values, err := ro.Collect(FromRows(rows, scanUser))
+	is.Equal([]user{{1, "Alice"}, {2, "Bob"}, {3, "Charlie"}}, values)
+	is.NoError(err)
+	is.True(isClosed(rows))
+}
+
+func TestFromRows_scanError(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	rows := query(t, "users")
+
+	values, err := ro.Collect(FromRows(rows, func(rows *sql.Rows) (user, error) {
+		var u user
+		err := rows.Scan(&u.ID) // wrong number of columns
+		return u, err
+	}))
+	is.Equal([]user{}, values)
+	is.Error(err)
+	is.True(isClosed(rows))
+
+	rows = query(t, "users")
+
+	values, err = ro.Collect(FromRows(rows, func(rows *sql.Rows) (user, error) {
+		panic(assert.AnError)
+	}))
+	is.Equal([]user{}, values)
+	is.ErrorIs(err, assert.AnError)
+	is.True(isClosed(rows))
+}
+
+func TestFromRows_iterationError(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	rows := query(t, "fail")
+
+	values, err := ro.Collect(FromRows(rows, scanUser))
+	is.Equal([]user{{1, "Alice"}}, values)
+	is.ErrorIs(err, assert.AnError)
+	is.True(isClosed(rows))
+}
+
+func TestFromRows_unsubscribe(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	rows := query(t, "users")
+
+	var subscriber ro.Subscriber[user]
+	values := []user{}
+
+	subscriber = ro.NewSubscriber(ro.OnNext(func(u user) {
+		values = append(values, u)
+		subscriber.Unsubscribe()
+	}))
+
+	FromRows(rows, scanUser).Subscribe(subscriber)
+	is.Equal([]user{{1, "Alice"}}, values)
+	is.True(isClosed(rows))
+}
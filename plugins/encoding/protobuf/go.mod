@@ -0,0 +1,21 @@
+module github.com/samber/ro/plugins/encoding/protobuf
+
+go 1.18
+
+require (
+	github.com/samber/ro v0.0.0
+	github.com/stretchr/testify v1.11.1
+	google.golang.org/protobuf v1.34.2
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/kr/text v0.2.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/samber/lo v1.53.0 // indirect
+	golang.org/x/exp v0.0.0-20240613232115-7f521ea00fb8 // indirect
+	golang.org/x/text v0.22.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/samber/ro => ../../..
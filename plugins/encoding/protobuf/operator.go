@@ -0,0 +1,56 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roprotobuf
+
+import (
+	"github.com/samber/ro"
+	"google.golang.org/protobuf/proto"
+)
+
+// Marshal encodes protobuf messages to the protobuf binary wire format.
+func Marshal[T proto.Message]() func(ro.Observable[T]) ro.Observable[[]byte] {
+	return ro.MapErr(func(v T) ([]byte, error) {
+		return proto.Marshal(v)
+	})
+}
+
+// MarshalWithOptions encodes protobuf messages to the protobuf binary wire
+// format, using the given options (deterministic output, size caching...).
+func MarshalWithOptions[T proto.Message](options proto.MarshalOptions) func(ro.Observable[T]) ro.Observable[[]byte] {
+	return ro.MapErr(func(v T) ([]byte, error) {
+		return options.Marshal(v)
+	})
+}
+
+// Unmarshal decodes protobuf binary data to messages. T must be a pointer to
+// a generated message type, such as *pb.User. A new message is allocated for
+// each value.
+func Unmarshal[T proto.Message]() func(ro.Observable[[]byte]) ro.Observable[T] {
+	return UnmarshalWithOptions[T](proto.UnmarshalOptions{})
+}
+
+// UnmarshalWithOptions decodes protobuf binary data to messages, using the
+// given options (unknown fields, extension resolver...). T must be a pointer
+// to a generated message type.
+func UnmarshalWithOptions[T proto.Message](options proto.UnmarshalOptions) func(ro.Observable[[]byte]) ro.Observable[T] {
+	return ro.MapErr(func(v []byte) (T, error) {
+		var zero T
+
+		// Generated messages support ProtoReflect on a nil pointer.
+		output, _ := zero.ProtoReflect().New().Interface().(T)
+		err := options.Unmarshal(v, output)
+		return output, err
+	})
+}
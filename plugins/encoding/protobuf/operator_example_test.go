@@ -0,0 +1,51 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roprotobuf
+
+import (
+	"github.com/samber/ro"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func ExampleMarshal() {
+	observable := ro.Pipe1(
+		ro.Just(wrapperspb.String("hello")),
+		Marshal[*wrapperspb.StringValue](),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[[]byte]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: [10 5 104 101 108 108 111]
+	// Completed
+}
+
+func ExampleUnmarshal() {
+	observable := ro.Pipe2(
+		ro.Just([]byte{10, 5, 104, 101, 108, 108, 111}),
+		Unmarshal[*wrapperspb.StringValue](),
+		ro.Map(func(msg *wrapperspb.StringValue) string {
+			return msg.GetValue()
+		}),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: hello
+	// Completed
+}
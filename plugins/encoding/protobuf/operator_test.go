@@ -0,0 +1,133 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roprotobuf
+
+import (
+	"testing"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestMarshal(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just(wrapperspb.String("hello"), wrapperspb.String("")),
+			Marshal[*wrapperspb.StringValue](),
+		),
+	)
+	is.Equal([][]byte{{10, 5, 104, 101, 108, 108, 111}, {}}, values)
+	is.NoError(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Empty[*wrapperspb.StringValue](),
+			Marshal[*wrapperspb.StringValue](),
+		),
+	)
+	is.Equal([][]byte{}, values)
+	is.NoError(err)
+}
+
+func TestMarshalWithOptions(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	input, err := structpb.NewStruct(map[string]any{"b": 2, "a": 1, "c": "three"})
+	is.NoError(err)
+
+	expected, err := proto.MarshalOptions{Deterministic: true}.Marshal(input)
+	is.NoError(err)
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just(input, input),
+			MarshalWithOptions[*structpb.Struct](proto.MarshalOptions{Deterministic: true}),
+		),
+	)
+	is.Equal([][]byte{expected, expected}, values)
+	is.NoError(err)
+}
+
+func TestUnmarshal(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just([]byte{10, 5, 104, 101, 108, 108, 111}, []byte{}),
+			Unmarshal[*wrapperspb.StringValue](),
+		),
+	)
+	is.Len(values, 2)
+	is.Equal("hello", values[0].GetValue())
+	is.Equal("", values[1].GetValue())
+	is.NotSame(values[0], values[1])
+	is.NoError(err)
+
+	// invalid wire format
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Just([]byte{10, 5, 104}),
+			Unmarshal[*wrapperspb.StringValue](),
+		),
+	)
+	is.Equal([]*wrapperspb.StringValue{}, values)
+	is.Error(err)
+}
+
+func TestUnmarshalWithOptions(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// field 2 is unknown to StringValue
+	encoded := []byte{10, 5, 104, 101, 108, 108, 111, 16, 1}
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just(encoded),
+			UnmarshalWithOptions[*wrapperspb.StringValue](proto.UnmarshalOptions{DiscardUnknown: true}),
+		),
+	)
+	is.Len(values, 1)
+	is.Equal("hello", values[0].GetValue())
+	is.Empty(values[0].ProtoReflect().GetUnknown())
+	is.NoError(err)
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	input, err := structpb.NewStruct(map[string]any{"name": "Alice", "age": 30})
+	is.NoError(err)
+
+	values, err := ro.Collect(
+		ro.Pipe2(
+			ro.Just(input),
+			Marshal[*structpb.Struct](),
+			Unmarshal[*structpb.Struct](),
+		),
+	)
+	is.Len(values, 1)
+	is.True(proto.Equal(input, values[0]))
+	is.NoError(err)
+}
@@ -17,7 +17,9 @@ package rohttpclient
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
+	"sync"
 
 	"github.com/samber/ro"
 )
@@ -61,3 +63,65 @@ func HTTPRequestJSON[T any](req *http.Request, client *http.Client) ro.Observabl
 		return t, err
 	})(HTTPRequest(req, client))
 }
+
+// HTTPResponseBodyChunkSize is the default size of the chunks emitted by
+// HTTPResponseBody.
+const HTTPResponseBodyChunkSize = 32 * 1024
+
+// HTTPResponseBody streams the body of a response, in chunks of at most
+// chunkSize bytes (HTTPResponseBodyChunkSize when chunkSize <= 0). Each chunk is
+// a new slice that can be retained by the observer.
+//
+// The body is read from a goroutine, and closed when the observable completes,
+// errors or is unsubscribed. Unsubscribing interrupts a pending read.
+func HTTPResponseBody(res *http.Response, chunkSize int) ro.Observable[[]byte] {
+	if chunkSize <= 0 {
+		chunkSize = HTTPResponseBodyChunkSize
+	}
+
+	return ro.NewUnsafeObservableWithContext(func(ctx context.Context, destination ro.Observer[[]byte]) ro.Teardown {
+		var once sync.Once
+		closeBody := func() {
+			once.Do(func() {
+				_ = res.Body.Close()
+			})
+		}
+
+		go func() {
+			defer closeBody()
+
+			for {
+				buf := make([]byte, chunkSize)
+
+				n, err := res.Body.Read(buf)
+				if n > 0 {
+					destination.NextWithContext(ctx, buf[:n])
+				}
+
+				if err == io.EOF {
+					destination.CompleteWithContext(ctx)
+					return
+				} else if err != nil {
+					if !destination.IsClosed() {
+						destination.ErrorWithContext(ctx, err)
+					}
+					return
+				} else if destination.IsClosed() {
+					return
+				}
+			}
+		}()
+
+		return closeBody
+	})
+}
+
+// HTTPRequestBody sends a http request and streams the body of the response,
+// without buffering it. See HTTPRequest and HTTPResponseBody.
+//
+// A http status code >= 400 is not considered an error.
+func HTTPRequestBody(req *http.Request, client *http.Client, chunkSize int) ro.Observable[[]byte] {
+	return ro.FlatMap(func(res *http.Response) ro.Observable[[]byte] {
+		return HTTPResponseBody(res, chunkSize)
+	})(HTTPRequest(req, client))
+}
@@ -19,7 +19,10 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/samber/ro"
 	"github.com/stretchr/testify/assert"
@@ -325,3 +328,103 @@ func TestHTTPRequestJSON_WithNilClient(t *testing.T) {
 
 	http.DefaultClient.CloseIdleConnections()
 }
+
+type trackingBody struct {
+	io.Reader
+	closed int32
+}
+
+func (b *trackingBody) Close() error {
+	atomic.StoreInt32(&b.closed, 1)
+	return nil
+}
+
+func (b *trackingBody) isClosed() bool {
+	return atomic.LoadInt32(&b.closed) == 1
+}
+
+type failingBody struct{}
+
+func (failingBody) Read([]byte) (int, error) { return 0, assert.AnError }
+func (failingBody) Close() error             { return nil }
+
+func TestHTTPResponseBody(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	body := &trackingBody{Reader: strings.NewReader("hello world")}
+
+	values, err := ro.Collect(
+		HTTPResponseBody(&http.Response{Body: body}, 4),
+	)
+	is.Equal([][]byte{[]byte("hell"), []byte("o wo"), []byte("rld")}, values)
+	is.NoError(err)
+	is.True(body.isClosed())
+
+	// default chunk size
+	body = &trackingBody{Reader: strings.NewReader("hello world")}
+
+	values, err = ro.Collect(
+		HTTPResponseBody(&http.Response{Body: body}, 0),
+	)
+	is.Equal([][]byte{[]byte("hello world")}, values)
+	is.NoError(err)
+	is.True(body.isClosed())
+
+	// read error
+	values, err = ro.Collect(
+		HTTPResponseBody(&http.Response{Body: failingBody{}}, 4),
+	)
+	is.Equal([][]byte{}, values)
+	is.ErrorIs(err, assert.AnError)
+}
+
+func TestHTTPResponseBody_unsubscribe(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	reader, writer := io.Pipe()
+	defer writer.Close()
+
+	body := &trackingBody{Reader: reader}
+
+	received := make(chan []byte, 1)
+	sub := HTTPResponseBody(&http.Response{Body: body}, 16).Subscribe(ro.OnNext(func(chunk []byte) {
+		received <- chunk
+	}))
+
+	_, _ = writer.Write([]byte("hello"))
+	is.Equal([]byte("hello"), <-received)
+
+	sub.Unsubscribe()
+	is.True(body.isClosed())
+}
+
+func TestHTTPRequestBody(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for i := 0; i < 3; i++ {
+			fmt.Fprintf(w, "chunk %d\n", i)
+			w.(http.Flusher).Flush()
+			time.Sleep(5 * time.Millisecond)
+		}
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+
+	values, err := ro.Collect(
+		HTTPRequestBody(req, http.DefaultClient, 0),
+	)
+	is.NoError(err)
+
+	var body strings.Builder
+	for _, chunk := range values {
+		body.Write(chunk)
+	}
+	is.Equal("chunk 0\nchunk 1\nchunk 2\n", body.String())
+
+	http.DefaultClient.CloseIdleConnections()
+}
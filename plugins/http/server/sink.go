@@ -0,0 +1,118 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rohttpserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/samber/ro"
+)
+
+// ErrResponseClosed is returned when a chunk is written after WriteResponse returned.
+var ErrResponseClosed = errors.New("rohttpserver.WriteResponse: response closed")
+
+// NewResponseWriter creates an operator writing each chunk to the response,
+// and flushing it when the writer implements http.Flusher, so that the client
+// receives the chunks as they are emitted. The operator emits the number of
+// bytes written when the source completes or fails.
+//
+// The operator must be subscribed from the handler, and the handler must not
+// return before the end of the stream. See WriteResponse.
+func NewResponseWriter(w http.ResponseWriter) func(ro.Observable[[]byte]) ro.Observable[int] {
+	return func(source ro.Observable[[]byte]) ro.Observable[int] {
+		return ro.NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[int]) ro.Teardown {
+			count := 0
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				ro.NewObserverWithContext(
+					func(ctx context.Context, value []byte) {
+						n, err := write(w, value)
+						count += n
+
+						if err != nil {
+							destination.NextWithContext(ctx, count)
+							destination.ErrorWithContext(ctx, err)
+						}
+					},
+					func(ctx context.Context, err error) {
+						destination.NextWithContext(ctx, count)
+						destination.ErrorWithContext(ctx, err)
+					},
+					func(ctx context.Context) {
+						destination.NextWithContext(ctx, count)
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// WriteResponse streams the source to the response, flushing after each chunk,
+// and blocks until the source completes, fails, or the request context is
+// canceled (for instance when the client disconnects). It returns nil on
+// completion, the error of the source or of the writer, or the context error.
+//
+// Headers and status code must be written before calling WriteResponse. Once it
+// returns, the response writer is no longer used, so the handler can return.
+func WriteResponse(w http.ResponseWriter, r *http.Request, source ro.Observable[[]byte]) error {
+	var mu sync.Mutex
+	closed := false
+
+	err := ro.Run(r.Context(), source, func(_ context.Context, chunk []byte) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if closed {
+			return ErrResponseClosed
+		}
+
+		_, err := write(w, chunk)
+		return err
+	})
+
+	// A notification may still be in flight after a cancellation.
+	mu.Lock()
+	closed = true
+	mu.Unlock()
+
+	return err
+}
+
+// WriteResponseWithEncoder is WriteResponse for an observable of values,
+// encoded by the encode function. For instance, json.Marshal with a newline
+// appended produces a stream of JSON lines.
+func WriteResponseWithEncoder[T any](w http.ResponseWriter, r *http.Request, source ro.Observable[T], encode func(T) ([]byte, error)) error {
+	return WriteResponse(w, r, ro.MapErr(encode)(source))
+}
+
+func write(w http.ResponseWriter, chunk []byte) (int, error) {
+	n, err := w.Write(chunk)
+	if err != nil {
+		return n, err
+	}
+
+	if flusher, ok := w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+
+	return n, nil
+}
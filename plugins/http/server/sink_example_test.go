@@ -0,0 +1,60 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rohttpserver
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/samber/ro"
+)
+
+func ExampleWriteResponse() {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+
+		err := WriteResponse(w, r, ro.Just([]byte("hello "), []byte("world")))
+		if err != nil {
+			// The response has already started: log the error.
+			return
+		}
+	})
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	fmt.Println(recorder.Body.String())
+	// Output: hello world
+}
+
+func ExampleWriteResponseWithEncoder() {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = WriteResponseWithEncoder(w, r, ro.Just(1, 2, 3), func(v int) ([]byte, error) {
+			return []byte(fmt.Sprintf("data: %d\n\n", v)), nil
+		})
+	})
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	fmt.Print(recorder.Body.String())
+	// Output:
+	// data: 1
+	//
+	// data: 2
+	//
+	// data: 3
+}
@@ -0,0 +1,148 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rohttpserver
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+type failingWriter struct {
+	*httptest.ResponseRecorder
+}
+
+func (w failingWriter) Write([]byte) (int, error) {
+	return 0, assert.AnError
+}
+
+func TestNewResponseWriter(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	recorder := httptest.NewRecorder()
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just([]byte("hello "), []byte("world")),
+			NewResponseWriter(recorder),
+		),
+	)
+	is.Equal([]int{11}, values)
+	is.NoError(err)
+	is.Equal("hello world", recorder.Body.String())
+	is.True(recorder.Flushed)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Just([]byte("hello")),
+			NewResponseWriter(failingWriter{httptest.NewRecorder()}),
+		),
+	)
+	is.Equal([]int{0}, values)
+	is.ErrorIs(err, assert.AnError)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Throw[[]byte](assert.AnError),
+			NewResponseWriter(httptest.NewRecorder()),
+		),
+	)
+	is.Equal([]int{0}, values)
+	is.ErrorIs(err, assert.AnError)
+}
+
+func TestWriteResponse(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	err := WriteResponse(recorder, req, ro.Just([]byte("hello "), []byte("world")))
+	is.NoError(err)
+	is.Equal("hello world", recorder.Body.String())
+	is.True(recorder.Flushed)
+
+	err = WriteResponse(httptest.NewRecorder(), req, ro.Throw[[]byte](assert.AnError))
+	is.ErrorIs(err, assert.AnError)
+
+	err = WriteResponse(failingWriter{httptest.NewRecorder()}, req, ro.Just([]byte("hello")))
+	is.ErrorIs(err, assert.AnError)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err = WriteResponse(httptest.NewRecorder(), req.WithContext(ctx), ro.Pipe1(
+		ro.Never(),
+		ro.Map(func(struct{}) []byte { return nil }),
+	))
+	is.ErrorIs(err, context.DeadlineExceeded)
+}
+
+func TestWriteResponseWithEncoder(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type event struct {
+		ID int `json:"id"`
+	}
+
+	encode := func(e event) ([]byte, error) {
+		b, err := json.Marshal(e)
+		return append(b, '\n'), err
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+
+		source := ro.Pipe1(
+			ro.Interval(5*time.Millisecond),
+			ro.Map(func(i int64) event { return event{ID: int(i)} }),
+		)
+
+		_ = WriteResponseWithEncoder(w, r, ro.Pipe1(source, ro.Take[event](3)), encode)
+	}))
+	defer server.Close()
+
+	res, err := http.Get(server.URL)
+	is.NoError(err)
+	defer res.Body.Close()
+
+	is.Equal("application/x-ndjson", res.Header.Get("Content-Type"))
+
+	lines := []string{}
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	is.NoError(scanner.Err())
+	is.Equal([]string{`{"id":0}`, `{"id":1}`, `{"id":2}`}, lines)
+
+	err = WriteResponseWithEncoder(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil), ro.Just(1), func(int) ([]byte, error) {
+		return nil, errors.New("encoding failure")
+	})
+	is.EqualError(err, "encoding failure")
+
+	http.DefaultClient.CloseIdleConnections()
+}
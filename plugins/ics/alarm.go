@@ -0,0 +1,265 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roics
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/samber/ro"
+)
+
+var icsDurationRegexp = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// Alarm is a VALARM of a VEVENT or a VTODO, with its trigger time resolved.
+type Alarm struct {
+	// Parent is the *ics.VEvent or *ics.VTodo holding the alarm.
+	Parent ics.Component
+	Alarm  *ics.VAlarm
+	// Trigger is the time the alarm is due.
+	Trigger time.Time
+}
+
+// Alarms emits the VALARM of the VEVENT and VTODO components of the calendar,
+// with their trigger time. Relative triggers are resolved against DTSTART, or
+// against DTEND (VEVENT) and DUE (VTODO) when RELATED=END. Alarms repeated with
+// REPEAT and DURATION are emitted once per occurrence. Other components are
+// ignored.
+func Alarms() func(ro.Observable[ics.Component]) ro.Observable[Alarm] {
+	return func(source ro.Observable[ics.Component]) ro.Observable[Alarm] {
+		return ro.Pipe2(
+			source,
+			ro.MapErr(componentAlarms),
+			ro.Flatten[Alarm](),
+		)
+	}
+}
+
+// DueAlarms emits each alarm when it is due, according to Alarm.Trigger. Alarms
+// already due on reception are dropped. The Observable completes once the source
+// completed and every pending alarm was emitted.
+func DueAlarms() func(ro.Observable[Alarm]) ro.Observable[Alarm] {
+	return func(source ro.Observable[Alarm]) ro.Observable[Alarm] {
+		return ro.NewObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[Alarm]) ro.Teardown {
+			var mu sync.Mutex
+			timers := map[*time.Timer]struct{}{}
+			completed := false
+			stopped := false
+
+			stop := func() {
+				mu.Lock()
+				defer mu.Unlock()
+
+				stopped = true
+				for timer := range timers {
+					timer.Stop()
+				}
+			}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				ro.NewObserverWithContext(
+					func(ctx context.Context, alarm Alarm) {
+						delay := time.Until(alarm.Trigger)
+						if delay < 0 {
+							return
+						}
+
+						mu.Lock()
+						defer mu.Unlock()
+
+						if stopped {
+							return
+						}
+
+						var timer *time.Timer
+						timer = time.AfterFunc(delay, func() {
+							mu.Lock()
+							if stopped {
+								mu.Unlock()
+								return
+							}
+							mu.Unlock()
+
+							destination.NextWithContext(ctx, alarm)
+
+							mu.Lock()
+							delete(timers, timer)
+							last := completed && len(timers) == 0
+							mu.Unlock()
+
+							if last {
+								destination.CompleteWithContext(ctx)
+							}
+						})
+						timers[timer] = struct{}{}
+					},
+					func(ctx context.Context, err error) {
+						stop()
+						destination.ErrorWithContext(ctx, err)
+					},
+					func(ctx context.Context) {
+						mu.Lock()
+						completed = true
+						last := len(timers) == 0
+						mu.Unlock()
+
+						if last {
+							destination.CompleteWithContext(ctx)
+						}
+					},
+				),
+			)
+
+			return func() {
+				sub.Unsubscribe()
+				stop()
+			}
+		})
+	}
+}
+
+func componentAlarms(component ics.Component) ([]Alarm, error) {
+	var base *ics.ComponentBase
+	var valarms []*ics.VAlarm
+	endProperty := ics.ComponentPropertyDtEnd
+
+	switch c := component.(type) {
+	case *ics.VEvent:
+		base = &c.ComponentBase
+		valarms = c.Alarms()
+	case *ics.VTodo:
+		base = &c.ComponentBase
+		valarms = c.Alarms()
+		endProperty = ics.ComponentPropertyDue
+	default:
+		return nil, nil
+	}
+
+	alarms := []Alarm{}
+
+	for _, valarm := range valarms {
+		trigger, err := alarmTrigger(base, valarm, endProperty)
+		if err != nil {
+			return nil, fmt.Errorf("roics: invalid VALARM of %q: %w", base.Id(), err)
+		}
+
+		alarms = append(alarms, Alarm{Parent: component, Alarm: valarm, Trigger: trigger})
+
+		repeat, interval, err := alarmRepeat(valarm)
+		if err != nil {
+			return nil, fmt.Errorf("roics: invalid VALARM of %q: %w", base.Id(), err)
+		}
+
+		for i := 1; i <= repeat; i++ {
+			alarms = append(alarms, Alarm{Parent: component, Alarm: valarm, Trigger: trigger.Add(time.Duration(i) * interval)})
+		}
+	}
+
+	return alarms, nil
+}
+
+func alarmTrigger(parent *ics.ComponentBase, valarm *ics.VAlarm, endProperty ics.ComponentProperty) (time.Time, error) {
+	prop := valarm.GetProperty(ics.ComponentPropertyTrigger)
+	if prop == nil {
+		return time.Time{}, fmt.Errorf("missing TRIGGER")
+	}
+
+	if firstParameter(prop.ICalParameters, ics.ParameterValue) == string(ics.ValueDataTypeDateTime) {
+		trigger, _, err := parseICSTime(prop.Value, prop.ICalParameters, nil)
+		return trigger, err
+	}
+
+	offset, err := parseICSDuration(prop.Value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	anchorProperty := ics.ComponentPropertyDtStart
+	if firstParameter(prop.ICalParameters, ics.ParameterRelated) == "END" {
+		anchorProperty = endProperty
+	}
+
+	anchor := parent.GetProperty(anchorProperty)
+	if anchor == nil {
+		return time.Time{}, fmt.Errorf("missing %s", anchorProperty)
+	}
+
+	at, _, err := parseICSTime(anchor.Value, anchor.ICalParameters, nil)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return at.Add(offset), nil
+}
+
+func alarmRepeat(valarm *ics.VAlarm) (int, time.Duration, error) {
+	prop := valarm.GetProperty(ics.ComponentProperty(ics.PropertyRepeat))
+	if prop == nil {
+		return 0, 0, nil
+	}
+
+	repeat, err := strconv.Atoi(prop.Value)
+	if err != nil || repeat < 0 {
+		return 0, 0, fmt.Errorf("invalid REPEAT %q", prop.Value)
+	}
+
+	duration := valarm.GetProperty(ics.ComponentPropertyDuration)
+	if duration == nil {
+		return 0, 0, fmt.Errorf("missing DURATION")
+	}
+
+	interval, err := parseICSDuration(duration.Value)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return repeat, interval, nil
+}
+
+// parseICSDuration parses a DURATION value, such as "-PT15M" or "P1DT12H".
+func parseICSDuration(value string) (time.Duration, error) {
+	matches := icsDurationRegexp.FindStringSubmatch(value)
+	if matches == nil || value == "P" || value == "-P" || value == "+P" || value[len(value)-1] == 'T' {
+		return 0, fmt.Errorf("unexpected duration value %q", value)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+
+	var duration time.Duration
+	for i, unit := range units {
+		if matches[i+2] == "" {
+			continue
+		}
+
+		n, err := strconv.Atoi(matches[i+2])
+		if err != nil {
+			return 0, fmt.Errorf("unexpected duration value %q: %w", value, err)
+		}
+
+		duration += time.Duration(n) * unit
+	}
+
+	if matches[1] == "-" {
+		duration = -duration
+	}
+
+	return duration, nil
+}
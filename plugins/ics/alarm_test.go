@@ -0,0 +1,201 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+const testAlarmCalendar = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:meeting-1\r\n" +
+	"DTSTART:20250106T090000Z\r\n" +
+	"DTEND:20250106T100000Z\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"ACTION:DISPLAY\r\n" +
+	"TRIGGER:-PT15M\r\n" +
+	"END:VALARM\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"ACTION:DISPLAY\r\n" +
+	"TRIGGER;RELATED=END:PT0S\r\n" +
+	"REPEAT:2\r\n" +
+	"DURATION:PT5M\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VTODO\r\n" +
+	"UID:todo-1\r\n" +
+	"DUE:20250107T120000Z\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"ACTION:AUDIO\r\n" +
+	"TRIGGER;RELATED=END:-P1D\r\n" +
+	"END:VALARM\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"ACTION:AUDIO\r\n" +
+	"TRIGGER;VALUE=DATE-TIME:20250105T080000Z\r\n" +
+	"END:VALARM\r\n" +
+	"END:VTODO\r\n" +
+	"BEGIN:VTODO\r\n" +
+	"UID:todo-2\r\n" +
+	"END:VTODO\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParseICSDuration(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := map[string]time.Duration{
+		"PT0S":         0,
+		"-PT15M":       -15 * time.Minute,
+		"+PT1H30M":     90 * time.Minute,
+		"P1D":          24 * time.Hour,
+		"P2W":          14 * 24 * time.Hour,
+		"-P1DT2H3M4S":  -(26*time.Hour + 3*time.Minute + 4*time.Second),
+		"P1W2DT1H":     9*24*time.Hour + time.Hour,
+		"PT36H":        36 * time.Hour,
+		"-P0DT0H0M10S": -10 * time.Second,
+	}
+
+	for value, expected := range tests {
+		duration, err := parseICSDuration(value)
+		is.NoError(err, value)
+		is.Equal(expected, duration, value)
+	}
+
+	for _, value := range []string{"", "P", "PT", "-P", "15M", "PT15", "P1H", "PT1D", "1D"} {
+		_, err := parseICSDuration(value)
+		is.Error(err, value)
+	}
+}
+
+func TestAlarms(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	alarms, err := ro.Collect(
+		ro.Pipe1(
+			ParseCalendar(strings.NewReader(testAlarmCalendar)),
+			Alarms(),
+		),
+	)
+	is.NoError(err)
+	is.Len(alarms, 6)
+
+	triggers := make([]time.Time, len(alarms))
+	for i := range alarms {
+		triggers[i] = alarms[i].Trigger
+	}
+
+	is.Equal([]time.Time{
+		time.Date(2025, 1, 6, 8, 45, 0, 0, time.UTC),
+		time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 6, 10, 5, 0, 0, time.UTC),
+		time.Date(2025, 1, 6, 10, 10, 0, 0, time.UTC),
+		time.Date(2025, 1, 6, 12, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 5, 8, 0, 0, 0, time.UTC),
+	}, triggers)
+
+	is.IsType(&ics.VEvent{}, alarms[0].Parent)
+	is.IsType(&ics.VTodo{}, alarms[4].Parent)
+	is.Equal("AUDIO", alarms[4].Alarm.GetProperty(ics.ComponentPropertyAction).Value)
+
+	// invalid alarms
+	for _, valarm := range []string{
+		"ACTION:DISPLAY\r\n",
+		"TRIGGER:soon\r\n",
+		"TRIGGER;RELATED=END:-PT5M\r\n",
+		"TRIGGER:PT0S\r\nREPEAT:many\r\nDURATION:PT5M\r\n",
+		"TRIGGER:PT0S\r\nREPEAT:2\r\n",
+	} {
+		calendar := "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nBEGIN:VEVENT\r\nUID:broken\r\nDTSTART:20250106T090000Z\r\n" +
+			"BEGIN:VALARM\r\n" + valarm + "END:VALARM\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+
+		alarms, err = ro.Collect(
+			ro.Pipe1(
+				ParseCalendar(strings.NewReader(calendar)),
+				Alarms(),
+			),
+		)
+		is.ErrorContains(err, `roics: invalid VALARM of "broken"`, valarm)
+		is.Len(alarms, 0)
+	}
+}
+
+func TestDueAlarms(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	now := time.Now()
+	alarm := func(name string, delay time.Duration) Alarm {
+		return Alarm{Parent: ics.NewEvent(name), Trigger: now.Add(delay)}
+	}
+
+	start := time.Now()
+	alarms, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just(
+				alarm("late", -time.Minute),
+				alarm("second", 60*time.Millisecond),
+				alarm("first", 20*time.Millisecond),
+			),
+			DueAlarms(),
+		),
+	)
+	is.NoError(err)
+	is.Len(alarms, 2)
+	is.Equal("first", alarms[0].Parent.(*ics.VEvent).Id())
+	is.Equal("second", alarms[1].Parent.(*ics.VEvent).Id())
+	is.GreaterOrEqual(time.Since(start), 55*time.Millisecond)
+
+	// no pending alarm
+	alarms, err = ro.Collect(
+		ro.Pipe1(
+			ro.Just(alarm("late", -time.Minute)),
+			DueAlarms(),
+		),
+	)
+	is.NoError(err)
+	is.Len(alarms, 0)
+
+	// error
+	alarms, err = ro.Collect(
+		ro.Pipe1(
+			ro.Throw[Alarm](assert.AnError),
+			DueAlarms(),
+		),
+	)
+	is.EqualError(err, assert.AnError.Error())
+	is.Len(alarms, 0)
+
+	// pending alarms are canceled on unsubscription
+	values := make(chan Alarm, 1)
+	sub := ro.Pipe1(
+		ro.Just(alarm("canceled", 50*time.Millisecond)),
+		DueAlarms(),
+	).Subscribe(ro.OnNext(func(a Alarm) { values <- a }))
+	sub.Unsubscribe()
+
+	select {
+	case <-values:
+		is.Fail("alarm emitted after unsubscription")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
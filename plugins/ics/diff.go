@@ -0,0 +1,141 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roics
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/samber/ro"
+)
+
+// DeltaKind is the kind of a CalendarDelta.
+type DeltaKind int8
+
+const (
+	// DeltaAdded is an event missing from the previous snapshot.
+	DeltaAdded DeltaKind = iota
+	// DeltaRemoved is an event missing from the current snapshot.
+	DeltaRemoved
+	// DeltaChanged is an event whose SEQUENCE changed.
+	DeltaChanged
+)
+
+// String implements fmt.Stringer.
+func (k DeltaKind) String() string {
+	switch k {
+	case DeltaAdded:
+		return "added"
+	case DeltaRemoved:
+		return "removed"
+	case DeltaChanged:
+		return "changed"
+	default:
+		return fmt.Sprintf("DeltaKind(%d)", k)
+	}
+}
+
+// CalendarDelta is a change between two snapshots of a calendar.
+type CalendarDelta struct {
+	Kind DeltaKind
+	UID  string
+	// Previous is nil for DeltaAdded.
+	Previous *ics.VEvent
+	// Current is nil for DeltaRemoved.
+	Current *ics.VEvent
+}
+
+// DiffCalendars compares each snapshot of a calendar with the previous one, and
+// emits the added, removed and changed events. Events are identified by their
+// UID (and RECURRENCE-ID, for overridden occurrences of recurring events), and
+// an event is changed when its SEQUENCE changed. Every event of the first
+// snapshot is added.
+//
+// Added and changed events are emitted in the order of the current snapshot,
+// followed by the removed events in the order of the previous snapshot.
+func DiffCalendars() func(ro.Observable[[]*ics.VEvent]) ro.Observable[CalendarDelta] {
+	return func(source ro.Observable[[]*ics.VEvent]) ro.Observable[CalendarDelta] {
+		return ro.NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[CalendarDelta]) ro.Teardown {
+			previous := []*ics.VEvent{}
+			previousByKey := map[string]*ics.VEvent{}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				ro.NewObserverWithContext(
+					func(ctx context.Context, snapshot []*ics.VEvent) {
+						currentByKey := make(map[string]*ics.VEvent, len(snapshot))
+						for _, event := range snapshot {
+							currentByKey[eventKey(event)] = event
+						}
+
+						for _, event := range snapshot {
+							key := eventKey(event)
+							if currentByKey[key] != event {
+								// duplicated key: the last event wins
+								continue
+							}
+
+							old, ok := previousByKey[key]
+							if !ok {
+								destination.NextWithContext(ctx, CalendarDelta{Kind: DeltaAdded, UID: event.Id(), Current: event})
+							} else if eventSequence(old) != eventSequence(event) {
+								destination.NextWithContext(ctx, CalendarDelta{Kind: DeltaChanged, UID: event.Id(), Previous: old, Current: event})
+							}
+						}
+
+						for _, event := range previous {
+							key := eventKey(event)
+							if previousByKey[key] != event {
+								continue
+							}
+
+							if _, ok := currentByKey[key]; !ok {
+								destination.NextWithContext(ctx, CalendarDelta{Kind: DeltaRemoved, UID: event.Id(), Previous: event})
+							}
+						}
+
+						previous = snapshot
+						previousByKey = currentByKey
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+func eventKey(event *ics.VEvent) string {
+	key := event.Id()
+	if recurrenceID := event.GetProperty(ics.ComponentPropertyRecurrenceId); recurrenceID != nil {
+		key += "\x00" + recurrenceID.Value
+	}
+
+	return key
+}
+
+func eventSequence(event *ics.VEvent) int {
+	if prop := event.GetProperty(ics.ComponentPropertySequence); prop != nil {
+		if sequence, err := strconv.Atoi(prop.Value); err == nil {
+			return sequence
+		}
+	}
+
+	return 0
+}
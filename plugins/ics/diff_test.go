@@ -0,0 +1,112 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roics
+
+import (
+	"testing"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestEvent(uid string, sequence int) *ics.VEvent {
+	event := ics.NewEvent(uid)
+	if sequence > 0 {
+		event.SetSequence(sequence)
+	}
+
+	return event
+}
+
+func TestDeltaKind_String(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal("added", DeltaAdded.String())
+	is.Equal("removed", DeltaRemoved.String())
+	is.Equal("changed", DeltaChanged.String())
+	is.Equal("DeltaKind(42)", DeltaKind(42).String())
+}
+
+func TestDiffCalendars(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	a1 := newTestEvent("a", 0)
+	b1 := newTestEvent("b", 1)
+	c1 := newTestEvent("c", 0)
+	b2 := newTestEvent("b", 2)
+	a1bis := newTestEvent("a", 0)
+	d1 := newTestEvent("d", 0)
+
+	// overridden occurrence of a recurring event
+	c1occurrence := newTestEvent("c", 0)
+	c1occurrence.SetProperty(ics.ComponentPropertyRecurrenceId, "20250106T090000Z")
+
+	deltas, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just(
+				[]*ics.VEvent{a1, b1, c1},
+				[]*ics.VEvent{a1bis, b2, d1, c1occurrence},
+				[]*ics.VEvent{},
+			),
+			DiffCalendars(),
+		),
+	)
+	is.NoError(err)
+	is.Equal([]CalendarDelta{
+		// first snapshot
+		{Kind: DeltaAdded, UID: "a", Current: a1},
+		{Kind: DeltaAdded, UID: "b", Current: b1},
+		{Kind: DeltaAdded, UID: "c", Current: c1},
+		// second snapshot: "a" has the same sequence
+		{Kind: DeltaChanged, UID: "b", Previous: b1, Current: b2},
+		{Kind: DeltaAdded, UID: "d", Current: d1},
+		{Kind: DeltaAdded, UID: "c", Current: c1occurrence},
+		{Kind: DeltaRemoved, UID: "c", Previous: c1},
+		// third snapshot
+		{Kind: DeltaRemoved, UID: "a", Previous: a1bis},
+		{Kind: DeltaRemoved, UID: "b", Previous: b2},
+		{Kind: DeltaRemoved, UID: "d", Previous: d1},
+		{Kind: DeltaRemoved, UID: "c", Previous: c1occurrence},
+	}, deltas)
+
+	// duplicated UID: the last event wins
+	deltas, err = ro.Collect(
+		ro.Pipe1(
+			ro.Just(
+				[]*ics.VEvent{b1, b2},
+				[]*ics.VEvent{b1},
+			),
+			DiffCalendars(),
+		),
+	)
+	is.NoError(err)
+	is.Equal([]CalendarDelta{
+		{Kind: DeltaAdded, UID: "b", Current: b2},
+		{Kind: DeltaChanged, UID: "b", Previous: b2, Current: b1},
+	}, deltas)
+
+	// error
+	deltas, err = ro.Collect(
+		ro.Pipe1(
+			ro.Throw[[]*ics.VEvent](assert.AnError),
+			DiffCalendars(),
+		),
+	)
+	is.EqualError(err, assert.AnError.Error())
+	is.Len(deltas, 0)
+}
@@ -0,0 +1,191 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roics
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/samber/ro"
+)
+
+// Event is a typed representation of a VEVENT. It can be marshaled to JSON.
+type Event struct {
+	UID         string          `json:"uid"`
+	Sequence    int             `json:"sequence,omitempty"`
+	Summary     string          `json:"summary,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Location    string          `json:"location,omitempty"`
+	Status      string          `json:"status,omitempty"`
+	Start       time.Time       `json:"start"`
+	End         time.Time       `json:"end"`
+	AllDay      bool            `json:"allDay,omitempty"`
+	Organizer   string          `json:"organizer,omitempty"`
+	Attendees   []EventAttendee `json:"attendees,omitempty"`
+	// Recurrence holds the RRULE values, such as "FREQ=WEEKLY;BYDAY=MO".
+	Recurrence []string `json:"recurrence,omitempty"`
+}
+
+// EventAttendee is an ATTENDEE of an Event.
+type EventAttendee struct {
+	Email  string `json:"email"`
+	Name   string `json:"name,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+// UnserializeVEvent converts a VEVENT into an Event. Date-time values are
+// parsed in their TZID time zone, loaded from the IANA database, or in UTC.
+// Dates (VALUE=DATE) are parsed in UTC and set Event.AllDay. See EventTimes for
+// the time zones defined by the VTIMEZONE components of a calendar.
+func UnserializeVEvent(event *ics.VEvent) (Event, error) {
+	output := Event{
+		UID:         event.Id(),
+		Summary:     propertyValue(event, ics.ComponentPropertySummary),
+		Description: propertyValue(event, ics.ComponentPropertyDescription),
+		Location:    propertyValue(event, ics.ComponentPropertyLocation),
+		Status:      propertyValue(event, ics.ComponentPropertyStatus),
+		Organizer:   strings.TrimPrefix(propertyValue(event, ics.ComponentPropertyOrganizer), "mailto:"),
+	}
+
+	if sequence := propertyValue(event, ics.ComponentPropertySequence); sequence != "" {
+		n, err := strconv.Atoi(sequence)
+		if err != nil {
+			return Event{}, fmt.Errorf("roics: invalid SEQUENCE %q: %w", sequence, err)
+		}
+
+		output.Sequence = n
+	}
+
+	if prop := event.GetProperty(ics.ComponentPropertyDtStart); prop != nil {
+		start, allDay, err := parseICSTime(prop.Value, prop.ICalParameters, nil)
+		if err != nil {
+			return Event{}, fmt.Errorf("roics: invalid DTSTART: %w", err)
+		}
+
+		output.Start = start
+		output.AllDay = allDay
+	}
+
+	if prop := event.GetProperty(ics.ComponentPropertyDtEnd); prop != nil {
+		end, _, err := parseICSTime(prop.Value, prop.ICalParameters, nil)
+		if err != nil {
+			return Event{}, fmt.Errorf("roics: invalid DTEND: %w", err)
+		}
+
+		output.End = end
+	}
+
+	for _, attendee := range event.Attendees() {
+		output.Attendees = append(output.Attendees, EventAttendee{
+			Email:  attendee.Email(),
+			Name:   firstParameter(attendee.ICalParameters, ics.ParameterCn),
+			Status: string(attendee.ParticipationStatus()),
+		})
+	}
+
+	for _, rrule := range event.GetProperties(ics.ComponentPropertyRrule) {
+		output.Recurrence = append(output.Recurrence, rrule.Value)
+	}
+
+	return output, nil
+}
+
+// SerializeVEvent converts an Event into a VEVENT. Date-times are written in
+// UTC, and dates when Event.AllDay is set. Zero times are omitted.
+func SerializeVEvent(event Event) *ics.VEvent {
+	output := ics.NewEvent(event.UID)
+
+	if event.Sequence != 0 {
+		output.SetSequence(event.Sequence)
+	}
+	if event.Summary != "" {
+		output.SetSummary(event.Summary)
+	}
+	if event.Description != "" {
+		output.SetDescription(event.Description)
+	}
+	if event.Location != "" {
+		output.SetLocation(event.Location)
+	}
+	if event.Status != "" {
+		output.SetStatus(ics.ObjectStatus(event.Status))
+	}
+
+	if !event.Start.IsZero() {
+		if event.AllDay {
+			output.SetAllDayStartAt(event.Start)
+		} else {
+			output.SetStartAt(event.Start)
+		}
+	}
+	if !event.End.IsZero() {
+		if event.AllDay {
+			output.SetAllDayEndAt(event.End)
+		} else {
+			output.SetEndAt(event.End)
+		}
+	}
+
+	if event.Organizer != "" {
+		output.SetOrganizer(event.Organizer)
+	}
+
+	for _, attendee := range event.Attendees {
+		params := []ics.PropertyParameter{}
+		if attendee.Name != "" {
+			params = append(params, ics.WithCN(attendee.Name))
+		}
+		if attendee.Status != "" {
+			params = append(params, ics.ParticipationStatus(attendee.Status))
+		}
+
+		output.AddAttendee(attendee.Email, params...)
+	}
+
+	for _, rrule := range event.Recurrence {
+		output.AddRrule(rrule)
+	}
+
+	return output
+}
+
+// ToEvent converts each VEVENT into an Event. See UnserializeVEvent.
+func ToEvent() func(ro.Observable[*ics.VEvent]) ro.Observable[Event] {
+	return ro.MapErr(UnserializeVEvent)
+}
+
+// FromEvent converts each Event into a VEVENT. See SerializeVEvent.
+func FromEvent() func(ro.Observable[Event]) ro.Observable[*ics.VEvent] {
+	return ro.Map(SerializeVEvent)
+}
+
+func propertyValue(event *ics.VEvent, property ics.ComponentProperty) string {
+	if prop := event.GetProperty(property); prop != nil {
+		return prop.Value
+	}
+
+	return ""
+}
+
+func firstParameter(params map[string][]string, parameter ics.Parameter) string {
+	if values := params[string(parameter)]; len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
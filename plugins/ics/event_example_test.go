@@ -0,0 +1,52 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roics
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/samber/ro"
+)
+
+func ExampleToEvent() {
+	obs := ro.Pipe3(
+		FromICSFile("testdata/fr-public-holidays-a.ics"),
+		Events(),
+		ToEvent(),
+		ro.Take[Event](2),
+	)
+
+	subscription := obs.Subscribe(
+		ro.NewObserver(
+			func(event Event) {
+				data, _ := json.Marshal(event)
+				fmt.Println(string(data))
+			},
+			func(err error) {
+				fmt.Printf("Error: %v\n", err)
+			},
+			func() {
+				fmt.Println("Completed")
+			},
+		),
+	)
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// {"uid":"20250114T124530Z-Zone-A@data.education.gouv.fr","summary":"Vacances de la Toussaint","description":"Vacances de la Toussaint - Zone A","location":"Zone A","start":"2017-10-21T00:00:00Z","end":"2017-11-06T00:00:00Z","allDay":true}
+	// {"uid":"20250114T124531Z-Zone-A@data.education.gouv.fr","summary":"Vacances de Noël","description":"Vacances de Noël - Zone A","location":"Zone A","start":"2017-12-23T00:00:00Z","end":"2018-01-08T00:00:00Z","allDay":true}
+	// Completed
+}
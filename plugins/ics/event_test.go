@@ -0,0 +1,241 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roics
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+const testEventCalendar = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:meeting-1\r\n" +
+	"SEQUENCE:2\r\n" +
+	"SUMMARY:Weekly sync\\, team A\r\n" +
+	"DESCRIPTION:Agenda\r\n" +
+	"LOCATION:Room 1\r\n" +
+	"STATUS:CONFIRMED\r\n" +
+	"DTSTART;TZID=Europe/Paris:20250106T100000\r\n" +
+	"DTEND:20250106T100000Z\r\n" +
+	"ORGANIZER:mailto:alice@example.com\r\n" +
+	"ATTENDEE;CN=Bob;PARTSTAT=ACCEPTED:mailto:bob@example.com\r\n" +
+	"ATTENDEE:mailto:carol@example.com\r\n" +
+	"RRULE:FREQ=WEEKLY;BYDAY=MO\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:holiday-1\r\n" +
+	"SUMMARY:Holiday\r\n" +
+	"DTSTART;VALUE=DATE:20250101\r\n" +
+	"DTEND;VALUE=DATE:20250102\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestUnserializeVEvent(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	events, err := ro.Collect(
+		ro.Pipe1(
+			ParseCalendar(strings.NewReader(testEventCalendar)),
+			Events(),
+		),
+	)
+	is.NoError(err)
+	is.Len(events, 2)
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	is.NoError(err)
+
+	event, err := UnserializeVEvent(events[0])
+	is.NoError(err)
+	is.Equal("meeting-1", event.UID)
+	is.Equal(2, event.Sequence)
+	is.Equal("Weekly sync, team A", event.Summary)
+	is.Equal("Agenda", event.Description)
+	is.Equal("Room 1", event.Location)
+	is.Equal("CONFIRMED", event.Status)
+	is.True(event.Start.Equal(time.Date(2025, 1, 6, 10, 0, 0, 0, paris)))
+	is.Equal(paris, event.Start.Location())
+	is.True(event.End.Equal(time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)))
+	is.False(event.AllDay)
+	is.Equal("alice@example.com", event.Organizer)
+	is.Equal([]EventAttendee{
+		{Email: "bob@example.com", Name: "Bob", Status: "ACCEPTED"},
+		{Email: "carol@example.com"},
+	}, event.Attendees)
+	is.Equal([]string{"FREQ=WEEKLY;BYDAY=MO"}, event.Recurrence)
+
+	event, err = UnserializeVEvent(events[1])
+	is.NoError(err)
+	is.Equal(Event{
+		UID:     "holiday-1",
+		Summary: "Holiday",
+		Start:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:     time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+		AllDay:  true,
+	}, event)
+
+	// invalid values
+	invalid := ics.NewEvent("invalid")
+	invalid.SetProperty(ics.ComponentPropertyDtStart, "tomorrow")
+	_, err = UnserializeVEvent(invalid)
+	is.ErrorContains(err, "roics: invalid DTSTART")
+
+	invalid = ics.NewEvent("invalid")
+	invalid.SetProperty(ics.ComponentPropertyDtStart, "20250101T100000", ics.WithTZID("Mars/Olympus"))
+	_, err = UnserializeVEvent(invalid)
+	is.ErrorContains(err, "roics: invalid DTSTART")
+
+	invalid = ics.NewEvent("invalid")
+	invalid.SetProperty(ics.ComponentPropertySequence, "one")
+	_, err = UnserializeVEvent(invalid)
+	is.ErrorContains(err, "roics: invalid SEQUENCE")
+}
+
+func TestSerializeVEvent(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	event := Event{
+		UID:         "meeting-1",
+		Sequence:    2,
+		Summary:     "Weekly sync, team A",
+		Description: "Agenda",
+		Location:    "Room 1",
+		Status:      "CONFIRMED",
+		Start:       time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC),
+		End:         time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC),
+		Organizer:   "alice@example.com",
+		Attendees: []EventAttendee{
+			{Email: "bob@example.com", Name: "Bob", Status: "ACCEPTED"},
+			{Email: "carol@example.com"},
+		},
+		Recurrence: []string{"FREQ=WEEKLY;BYDAY=MO"},
+	}
+
+	vevent := SerializeVEvent(event)
+	is.Equal("meeting-1", vevent.Id())
+	is.Equal("20250106T090000Z", vevent.GetProperty(ics.ComponentPropertyDtStart).Value)
+
+	// round trip
+	roundTrip, err := UnserializeVEvent(vevent)
+	is.NoError(err)
+	is.Equal(event, roundTrip)
+
+	// round trip through a serialized calendar
+	calendar := ics.NewCalendar()
+	calendar.AddVEvent(SerializeVEvent(event))
+	events, err := ro.Collect(
+		ro.Pipe2(
+			ParseCalendar(strings.NewReader(calendar.Serialize())),
+			Events(),
+			ToEvent(),
+		),
+	)
+	is.NoError(err)
+	is.Equal([]Event{event}, events)
+
+	allDay := Event{
+		UID:    "holiday-1",
+		Start:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:    time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+		AllDay: true,
+	}
+	vevent = SerializeVEvent(allDay)
+	is.Equal("20250101", vevent.GetProperty(ics.ComponentPropertyDtStart).Value)
+
+	roundTrip, err = UnserializeVEvent(vevent)
+	is.NoError(err)
+	is.Equal(allDay, roundTrip)
+}
+
+func TestEventJSON(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	event := Event{
+		UID:       "meeting-1",
+		Summary:   "Weekly sync",
+		Start:     time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC),
+		End:       time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC),
+		Attendees: []EventAttendee{{Email: "bob@example.com"}},
+	}
+
+	data, err := json.Marshal(event)
+	is.NoError(err)
+	is.JSONEq(`{
+		"uid": "meeting-1",
+		"summary": "Weekly sync",
+		"start": "2025-01-06T09:00:00Z",
+		"end": "2025-01-06T10:00:00Z",
+		"attendees": [{"email": "bob@example.com"}]
+	}`, string(data))
+
+	var decoded Event
+	is.NoError(json.Unmarshal(data, &decoded))
+	is.Equal(event, decoded)
+}
+
+func TestToEvent(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	events, err := ro.Collect(
+		ro.Pipe2(
+			FromICSFile("testdata/fr-public-holidays-a.ics"),
+			Events(),
+			ToEvent(),
+		),
+	)
+	is.NoError(err)
+	is.Len(events, 61)
+	is.Equal("Vacances de la Toussaint", events[0].Summary)
+	is.Equal(time.Date(2017, 10, 21, 0, 0, 0, 0, time.UTC), events[0].Start)
+	is.True(events[0].AllDay)
+
+	invalid := ics.NewEvent("invalid")
+	invalid.SetProperty(ics.ComponentPropertyDtStart, "tomorrow")
+	events, err = ro.Collect(
+		ro.Pipe1(
+			ro.Just(invalid),
+			ToEvent(),
+		),
+	)
+	is.ErrorContains(err, "roics: invalid DTSTART")
+	is.Len(events, 0)
+}
+
+func TestFromEvent(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	vevents, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just(Event{UID: "a"}, Event{UID: "b"}),
+			FromEvent(),
+		),
+	)
+	is.NoError(err)
+	is.Len(vevents, 2)
+	is.Equal("a", vevents[0].Id())
+	is.Equal("b", vevents[1].Id())
+}
@@ -0,0 +1,47 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roics
+
+import (
+	ics "github.com/arran4/golang-ical"
+	"github.com/samber/ro"
+)
+
+// ComponentsOfType emits the calendar components of type C, such as *ics.VEvent
+// or *ics.VTodo, and drops the others.
+func ComponentsOfType[C ics.Component]() func(ro.Observable[ics.Component]) ro.Observable[C] {
+	return func(source ro.Observable[ics.Component]) ro.Observable[C] {
+		return ro.Pipe2(
+			source,
+			ro.Filter(func(component ics.Component) bool {
+				_, ok := component.(C)
+				return ok
+			}),
+			ro.Map(func(component ics.Component) C {
+				return component.(C)
+			}),
+		)
+	}
+}
+
+// Events emits the VEVENT components of the calendar.
+func Events() func(ro.Observable[ics.Component]) ro.Observable[*ics.VEvent] {
+	return ComponentsOfType[*ics.VEvent]()
+}
+
+// Todos emits the VTODO components of the calendar.
+func Todos() func(ro.Observable[ics.Component]) ro.Observable[*ics.VTodo] {
+	return ComponentsOfType[*ics.VTodo]()
+}
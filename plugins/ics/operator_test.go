@@ -0,0 +1,90 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roics
+
+import (
+	"testing"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComponentsOfType(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	todo := ics.NewTodo("todo-1")
+	event := ics.NewEvent("event-1")
+
+	timezones, err := ro.Collect(
+		ro.Pipe1(
+			FromICSFile("testdata/fr-public-holidays-a.ics"),
+			ComponentsOfType[*ics.VTimezone](),
+		),
+	)
+	is.NoError(err)
+	is.Len(timezones, 1)
+
+	todos, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just[ics.Component](event, todo, event),
+			ComponentsOfType[*ics.VTodo](),
+		),
+	)
+	is.NoError(err)
+	is.Equal([]*ics.VTodo{todo}, todos)
+
+	todos, err = ro.Collect(
+		ro.Pipe1(
+			ro.Throw[ics.Component](assert.AnError),
+			ComponentsOfType[*ics.VTodo](),
+		),
+	)
+	is.EqualError(err, assert.AnError.Error())
+	is.Len(todos, 0)
+}
+
+func TestEvents(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	events, err := ro.Collect(
+		ro.Pipe1(
+			FromICSFile("testdata/fr-public-holidays-a.ics"),
+			Events(),
+		),
+	)
+	is.NoError(err)
+	is.Len(events, 61)
+	is.Equal("Vacances de la Toussaint", events[0].GetProperty(ics.ComponentPropertySummary).Value)
+}
+
+func TestTodos(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	todo := ics.NewTodo("todo-1")
+	event := ics.NewEvent("event-1")
+
+	todos, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just[ics.Component](event, todo),
+			Todos(),
+		),
+	)
+	is.NoError(err)
+	is.Equal([]*ics.VTodo{todo}, todos)
+}
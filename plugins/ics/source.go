@@ -16,6 +16,7 @@ package roics
 
 import (
 	"context"
+	"io"
 	"os"
 
 	ics "github.com/arran4/golang-ical"
@@ -72,3 +73,48 @@ func NewICSURLReader(urls ...string) ro.Observable[*ics.VEvent] {
 		return nil
 	})
 }
+
+// ParseCalendar parses the VCALENDAR read from r, and emits each of its
+// components (*ics.VEvent, *ics.VTodo, *ics.VTimezone...), in order. The
+// reader is consumed by the first subscription.
+// See Events and ComponentsOfType.
+func ParseCalendar(r io.Reader) ro.Observable[ics.Component] {
+	return ro.NewUnsafeObservableWithContext(func(ctx context.Context, destination ro.Observer[ics.Component]) ro.Teardown {
+		emitComponents(ctx, destination, r)
+		return nil
+	})
+}
+
+// FromICSFile parses the VCALENDAR of an ICS file, and emits each of its
+// components (*ics.VEvent, *ics.VTodo, *ics.VTimezone...), in order. The file
+// is opened on subscription.
+// See Events and ComponentsOfType.
+func FromICSFile(path string) ro.Observable[ics.Component] {
+	return ro.NewUnsafeObservableWithContext(func(ctx context.Context, destination ro.Observer[ics.Component]) ro.Teardown {
+		reader, err := os.Open(path)
+		if err != nil {
+			destination.ErrorWithContext(ctx, err)
+			return nil
+		}
+
+		defer reader.Close()
+
+		emitComponents(ctx, destination, reader)
+
+		return nil
+	})
+}
+
+func emitComponents(ctx context.Context, destination ro.Observer[ics.Component], r io.Reader) {
+	calendar, err := ics.ParseCalendar(r)
+	if err != nil {
+		destination.ErrorWithContext(ctx, err)
+		return
+	}
+
+	for _, component := range calendar.Components {
+		destination.NextWithContext(ctx, component)
+	}
+
+	destination.CompleteWithContext(ctx)
+}
@@ -15,6 +15,9 @@
 package roics
 
 import (
+	"strings"
+	"time"
+
 	ics "github.com/arran4/golang-ical"
 	"github.com/samber/ro"
 )
@@ -53,3 +56,148 @@ func ExampleNewICSURLReader() {
 	// Next: 183
 	// Completed
 }
+
+func ExampleFromICSFile() {
+	obs := ro.Pipe2(
+		FromICSFile("testdata/fr-public-holidays-a.ics"),
+		Events(),
+		ro.Count[*ics.VEvent](),
+	)
+
+	subscription := obs.Subscribe(ro.PrintObserver[int64]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 61
+	// Completed
+}
+
+func ExampleParseCalendar() {
+	calendar := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VEVENT",
+		"UID:event-1",
+		"SUMMARY:Team meeting",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	obs := ro.Pipe2(
+		ParseCalendar(strings.NewReader(calendar)),
+		Events(),
+		ro.Map(func(event *ics.VEvent) string {
+			return event.GetProperty(ics.ComponentPropertySummary).Value
+		}),
+	)
+
+	subscription := obs.Subscribe(ro.PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: Team meeting
+	// Completed
+}
+
+func ExampleAlarms() {
+	calendar := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VTODO",
+		"UID:todo-1",
+		"DUE:20250107T120000Z",
+		"BEGIN:VALARM",
+		"ACTION:DISPLAY",
+		"TRIGGER;RELATED=END:-PT1H",
+		"END:VALARM",
+		"END:VTODO",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	obs := ro.Pipe2(
+		ParseCalendar(strings.NewReader(calendar)),
+		Alarms(),
+		ro.Map(func(alarm Alarm) time.Time {
+			return alarm.Trigger
+		}),
+	)
+
+	subscription := obs.Subscribe(ro.PrintObserver[time.Time]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 2025-01-07 11:00:00 +0000 UTC
+	// Completed
+}
+
+func ExampleDiffCalendars() {
+	// successive snapshots of a calendar, e.g. polled from a file
+	snapshot := func(path string) ro.Observable[[]*ics.VEvent] {
+		return ro.Pipe2(
+			FromICSFile(path),
+			Events(),
+			ro.ToSlice[*ics.VEvent](),
+		)
+	}
+
+	obs := ro.Pipe2(
+		ro.Concat(
+			snapshot("testdata/fr-public-holidays-a.ics"),
+			snapshot("testdata/fr-public-holidays-a.ics"),
+		),
+		DiffCalendars(),
+		ro.Count[CalendarDelta](),
+	)
+
+	subscription := obs.Subscribe(ro.PrintObserver[int64]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 61
+	// Completed
+}
+
+func ExampleEventTimes() {
+	calendar := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VTIMEZONE",
+		"TZID:Eastern Standard Time",
+		"BEGIN:STANDARD",
+		"DTSTART:20071104T020000",
+		"RRULE:FREQ=YEARLY;BYMONTH=11;BYDAY=1SU",
+		"TZNAME:EST",
+		"TZOFFSETFROM:-0400",
+		"TZOFFSETTO:-0500",
+		"END:STANDARD",
+		"BEGIN:DAYLIGHT",
+		"DTSTART:20070311T020000",
+		"RRULE:FREQ=YEARLY;BYMONTH=3;BYDAY=2SU",
+		"TZNAME:EDT",
+		"TZOFFSETFROM:-0500",
+		"TZOFFSETTO:-0400",
+		"END:DAYLIGHT",
+		"END:VTIMEZONE",
+		"BEGIN:VEVENT",
+		"UID:event-1",
+		"DTSTART;TZID=Eastern Standard Time:20250701T100000",
+		"DTEND;TZID=Eastern Standard Time:20250701T110000",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	obs := ro.Pipe2(
+		ParseCalendar(strings.NewReader(calendar)),
+		EventTimes(),
+		ro.Map(func(times EventTime) string {
+			return times.Start.String() + " -> " + times.End.UTC().String()
+		}),
+	)
+
+	subscription := obs.Subscribe(ro.PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 2025-07-01 10:00:00 -0400 EDT -> 2025-07-01 15:00:00 +0000 UTC
+	// Completed
+}
@@ -15,8 +15,11 @@
 package roics
 
 import (
+	"os"
+	"strings"
 	"testing"
 
+	ics "github.com/arran4/golang-ical"
 	"github.com/samber/ro"
 	"github.com/stretchr/testify/assert"
 )
@@ -74,3 +77,59 @@ func TestNewICSURLReader(t *testing.T) {
 	is.ErrorContains(err, "malformed calendar; expected begin")
 	is.Len(items, 0)
 }
+
+func TestParseCalendar(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	reader, err := os.Open("testdata/fr-public-holidays-a.ics")
+	is.NoError(err)
+	defer reader.Close()
+
+	items, err := ro.Collect(ParseCalendar(reader))
+	is.NoError(err)
+	is.Len(items, 62)
+	is.IsType(&ics.VTimezone{}, items[0])
+	is.IsType(&ics.VEvent{}, items[1])
+
+	// malformed calendar
+	items, err = ro.Collect(ParseCalendar(strings.NewReader("not a calendar")))
+	is.ErrorContains(err, "parsing calendar")
+	is.Len(items, 0)
+
+	// mixed components
+	items, err = ro.Collect(ParseCalendar(strings.NewReader(strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VTODO",
+		"UID:todo-1",
+		"END:VTODO",
+		"BEGIN:VEVENT",
+		"UID:event-1",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n"))))
+	is.NoError(err)
+	is.Len(items, 2)
+	is.IsType(&ics.VTodo{}, items[0])
+	is.IsType(&ics.VEvent{}, items[1])
+}
+
+func TestFromICSFile(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	obs := FromICSFile("testdata/fr-public-holidays-a.ics")
+
+	// the file is read on each subscription
+	for i := 0; i < 2; i++ {
+		items, err := ro.Collect(obs)
+		is.NoError(err)
+		is.Len(items, 62)
+	}
+
+	// file not found
+	items, err := ro.Collect(FromICSFile("testdata/not-found.ics"))
+	is.ErrorContains(err, "open testdata/not-found.ics: no such file or directory")
+	is.Len(items, 0)
+}
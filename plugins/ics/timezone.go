@@ -0,0 +1,419 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roics
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/samber/ro"
+)
+
+const (
+	icsDateLayout     = "20060102"
+	icsDateTimeLayout = "20060102T150405"
+)
+
+var (
+	icsTimeRegexp   = regexp.MustCompile(`^(\d{8})(?:T(\d{6})(Z)?)?$`)
+	icsOffsetRegexp = regexp.MustCompile(`^([+-])(\d{2})(\d{2})(\d{2})?$`)
+)
+
+var icsWeekdays = map[ics.Weekday]time.Weekday{
+	ics.WeekdaySunday:    time.Sunday,
+	ics.WeekdayMonday:    time.Monday,
+	ics.WeekdayTuesday:   time.Tuesday,
+	ics.WeekdayWednesday: time.Wednesday,
+	ics.WeekdayThursday:  time.Thursday,
+	ics.WeekdayFriday:    time.Friday,
+	ics.WeekdaySaturday:  time.Saturday,
+}
+
+// EventTime holds the start and end of a VEVENT, in their time zone.
+type EventTime struct {
+	Event  *ics.VEvent
+	Start  time.Time
+	End    time.Time
+	AllDay bool
+}
+
+// EventTimes emits the start and end of the VEVENT components of the calendar.
+//
+// Date-times with a TZID parameter are localized with the IANA time zone
+// database, or with the VTIMEZONE components of the calendar for the other time
+// zone identifiers (such as "Eastern Standard Time"). The VTIMEZONE components
+// must be emitted before the events using them, as in an ICS file.
+//
+// When DTEND is missing, the end is computed from DURATION, or is one day after
+// the start of all-day events, or is the start of the other events.
+func EventTimes() func(ro.Observable[ics.Component]) ro.Observable[EventTime] {
+	return func(source ro.Observable[ics.Component]) ro.Observable[EventTime] {
+		return ro.NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[EventTime]) ro.Teardown {
+			zones := timezones{}
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				ro.NewObserverWithContext(
+					func(ctx context.Context, component ics.Component) {
+						switch c := component.(type) {
+						case *ics.VTimezone:
+							if err := zones.add(c); err != nil {
+								destination.ErrorWithContext(ctx, err)
+							}
+						case *ics.VEvent:
+							times, err := eventTimes(c, zones)
+							if err != nil {
+								destination.ErrorWithContext(ctx, err)
+								return
+							}
+
+							destination.NextWithContext(ctx, times)
+						}
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+func eventTimes(event *ics.VEvent, zones timezones) (EventTime, error) {
+	output := EventTime{Event: event}
+
+	prop := event.GetProperty(ics.ComponentPropertyDtStart)
+	if prop == nil {
+		return EventTime{}, fmt.Errorf("roics: missing DTSTART of %q", event.Id())
+	}
+
+	start, allDay, err := parseICSTime(prop.Value, prop.ICalParameters, zones)
+	if err != nil {
+		return EventTime{}, fmt.Errorf("roics: invalid DTSTART of %q: %w", event.Id(), err)
+	}
+
+	output.Start = start
+	output.AllDay = allDay
+
+	if prop := event.GetProperty(ics.ComponentPropertyDtEnd); prop != nil {
+		output.End, _, err = parseICSTime(prop.Value, prop.ICalParameters, zones)
+		if err != nil {
+			return EventTime{}, fmt.Errorf("roics: invalid DTEND of %q: %w", event.Id(), err)
+		}
+	} else if prop := event.GetProperty(ics.ComponentPropertyDuration); prop != nil {
+		duration, err := parseICSDuration(prop.Value)
+		if err != nil {
+			return EventTime{}, fmt.Errorf("roics: invalid DURATION of %q: %w", event.Id(), err)
+		}
+
+		output.End = start.Add(duration)
+	} else if allDay {
+		output.End = start.AddDate(0, 0, 1)
+	} else {
+		output.End = start
+	}
+
+	return output, nil
+}
+
+// parseICSTime parses a DATE or DATE-TIME value. It reports whether the value
+// is a date. Date-times with a TZID parameter are localized with the IANA time
+// zone database, then with zones.
+func parseICSTime(value string, params map[string][]string, zones timezones) (time.Time, bool, error) {
+	matches := icsTimeRegexp.FindStringSubmatch(value)
+	if matches == nil {
+		return time.Time{}, false, fmt.Errorf("unexpected time value %q", value)
+	}
+
+	if matches[2] == "" {
+		date, err := time.ParseInLocation(icsDateLayout, matches[1], time.UTC)
+		return date, true, err
+	}
+
+	wall, err := time.ParseInLocation(icsDateTimeLayout, matches[1]+"T"+matches[2], time.UTC)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	tzid := firstParameter(params, ics.ParameterTzid)
+	if tzid == "" || matches[3] == "Z" {
+		return wall, false, nil
+	}
+
+	if location, err := time.LoadLocation(tzid); err == nil {
+		return inLocation(wall, location), false, nil
+	}
+
+	if zone, ok := zones[tzid]; ok {
+		return inLocation(wall, zone.location(wall)), false, nil
+	}
+
+	return time.Time{}, false, fmt.Errorf("unknown time zone %q", tzid)
+}
+
+// inLocation returns the time with the wall clock of wall, in location.
+func inLocation(wall time.Time, location *time.Location) time.Time {
+	return time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), 0, location)
+}
+
+// timezones indexes VTIMEZONE definitions by TZID.
+type timezones map[string]*vtimezone
+
+func (z timezones) add(component *ics.VTimezone) error {
+	tzid := ""
+	for _, prop := range component.Properties {
+		if prop.IANAToken == string(ics.PropertyTzid) {
+			tzid = prop.Value
+		}
+	}
+
+	if tzid == "" {
+		return fmt.Errorf("roics: missing TZID of VTIMEZONE")
+	}
+
+	zone := &vtimezone{}
+
+	for _, sub := range component.Components {
+		var base *ics.ComponentBase
+		switch c := sub.(type) {
+		case *ics.Standard:
+			base = &c.ComponentBase
+		case *ics.Daylight:
+			base = &c.ComponentBase
+		default:
+			continue
+		}
+
+		obs, err := parseObservance(base)
+		if err != nil {
+			return fmt.Errorf("roics: invalid VTIMEZONE %q: %w", tzid, err)
+		}
+
+		zone.observances = append(zone.observances, obs)
+	}
+
+	if len(zone.observances) == 0 {
+		return fmt.Errorf("roics: invalid VTIMEZONE %q: missing STANDARD or DAYLIGHT", tzid)
+	}
+
+	z[tzid] = zone
+
+	return nil
+}
+
+// vtimezone is a time zone defined by a VTIMEZONE component.
+type vtimezone struct {
+	observances []observance
+}
+
+// observance is a STANDARD or DAYLIGHT sub-component of a VTIMEZONE. Onsets
+// are expressed in local time, with UTC fields.
+type observance struct {
+	name       string
+	start      time.Time
+	offsetFrom int
+	offsetTo   int
+	rrule      *ics.RecurrenceRule
+	rdates     []time.Time
+}
+
+func parseObservance(base *ics.ComponentBase) (observance, error) {
+	output := observance{}
+
+	for _, prop := range base.Properties {
+		var err error
+
+		switch ics.Property(prop.IANAToken) {
+		case ics.PropertyTzname:
+			if output.name == "" {
+				output.name = prop.Value
+			}
+		case ics.PropertyDtstart:
+			output.start, err = time.ParseInLocation(icsDateTimeLayout, prop.Value, time.UTC)
+		case ics.PropertyTzoffsetfrom:
+			output.offsetFrom, err = parseICSOffset(prop.Value)
+		case ics.PropertyTzoffsetto:
+			output.offsetTo, err = parseICSOffset(prop.Value)
+		case ics.PropertyRrule:
+			output.rrule, err = ics.ParseRecurrenceRule(prop.Value)
+		case ics.PropertyRdate:
+			var rdate time.Time
+			rdate, err = time.ParseInLocation(icsDateTimeLayout, prop.Value, time.UTC)
+			output.rdates = append(output.rdates, rdate)
+		}
+
+		if err != nil {
+			return observance{}, fmt.Errorf("invalid %s: %w", prop.IANAToken, err)
+		}
+	}
+
+	if output.start.IsZero() {
+		return observance{}, fmt.Errorf("missing DTSTART")
+	}
+
+	return output, nil
+}
+
+// location returns the fixed zone in effect at the wall clock time.
+func (z *vtimezone) location(wall time.Time) *time.Location {
+	var current *observance
+	var currentOnset time.Time
+
+	for i := range z.observances {
+		obs := &z.observances[i]
+
+		onset, ok := obs.lastOnset(wall)
+		if ok && (current == nil || onset.After(currentOnset)) {
+			current = obs
+			currentOnset = onset
+		}
+	}
+
+	if current == nil {
+		// before the first onset: the offset preceding the earliest observance
+		earliest := &z.observances[0]
+		for i := range z.observances {
+			if z.observances[i].start.Before(earliest.start) {
+				earliest = &z.observances[i]
+			}
+		}
+
+		return time.FixedZone(earliest.name, earliest.offsetFrom)
+	}
+
+	return time.FixedZone(current.name, current.offsetTo)
+}
+
+// lastOnset returns the last onset of the observance at or before the wall
+// clock time. Only yearly RRULEs are expanded.
+func (o *observance) lastOnset(wall time.Time) (time.Time, bool) {
+	var last time.Time
+	found := false
+
+	candidates := append([]time.Time{o.start}, o.rdates...)
+	if o.rrule != nil && o.rrule.Freq == ics.FrequencyYearly {
+		for year := wall.Year() - 1; year <= wall.Year(); year++ {
+			candidates = append(candidates, o.yearlyOnsets(year)...)
+		}
+	}
+
+	for _, onset := range candidates {
+		if onset.After(wall) || onset.Before(o.start) {
+			continue
+		}
+
+		if o.rrule != nil && !o.rrule.Until.IsZero() && !onset.Equal(o.start) {
+			// UNTIL is in UTC
+			if onset.Add(-time.Duration(o.offsetFrom) * time.Second).After(o.rrule.Until) {
+				continue
+			}
+		}
+
+		if !found || onset.After(last) {
+			last = onset
+			found = true
+		}
+	}
+
+	return last, found
+}
+
+// yearlyOnsets returns the onsets of the yearly RRULE during the year.
+func (o *observance) yearlyOnsets(year int) []time.Time {
+	months := o.rrule.ByMonth
+	if len(months) == 0 {
+		months = []int{int(o.start.Month())}
+	}
+
+	onsets := []time.Time{}
+
+	for _, month := range months {
+		first := time.Date(year, time.Month(month), 1, o.start.Hour(), o.start.Minute(), o.start.Second(), 0, time.UTC)
+		days := first.AddDate(0, 1, -1).Day()
+
+		for day := 1; day <= days; day++ {
+			date := first.AddDate(0, 0, day-1)
+			if o.matchesDay(date, days) {
+				onsets = append(onsets, date)
+			}
+		}
+	}
+
+	return onsets
+}
+
+func (o *observance) matchesDay(date time.Time, days int) bool {
+	if len(o.rrule.ByMonthDay) == 0 && len(o.rrule.ByDay) == 0 {
+		return date.Day() == o.start.Day()
+	}
+
+	if len(o.rrule.ByMonthDay) > 0 {
+		matched := false
+		for _, monthDay := range o.rrule.ByMonthDay {
+			if monthDay == date.Day() || (monthDay < 0 && days+monthDay+1 == date.Day()) {
+				matched = true
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	if len(o.rrule.ByDay) > 0 {
+		for _, weekday := range o.rrule.ByDay {
+			if icsWeekdays[weekday.Day] != date.Weekday() {
+				continue
+			}
+
+			switch {
+			case weekday.OrdWeek == 0:
+				return true
+			case weekday.OrdWeek > 0 && (date.Day()-1)/7+1 == weekday.OrdWeek:
+				return true
+			case weekday.OrdWeek < 0 && (days-date.Day())/7+1 == -weekday.OrdWeek:
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return true
+}
+
+// parseICSOffset parses a UTC offset, such as "+0200" or "-0530", in seconds.
+func parseICSOffset(value string) (int, error) {
+	matches := icsOffsetRegexp.FindStringSubmatch(value)
+	if matches == nil {
+		return 0, fmt.Errorf("unexpected offset value %q", value)
+	}
+
+	hours, _ := strconv.Atoi(matches[2])
+	minutes, _ := strconv.Atoi(matches[3])
+	seconds, _ := strconv.Atoi(matches[4])
+
+	offset := hours*3600 + minutes*60 + seconds
+	if matches[1] == "-" {
+		offset = -offset
+	}
+
+	return offset, nil
+}
@@ -0,0 +1,253 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+const testTimezoneCalendar = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	// Outlook-style identifier, with the US rules changed in 2007
+	"BEGIN:VTIMEZONE\r\n" +
+	"TZID:Eastern Standard Time\r\n" +
+	"BEGIN:STANDARD\r\n" +
+	"DTSTART:19671029T020000\r\n" +
+	"RRULE:FREQ=YEARLY;BYMONTH=10;BYDAY=-1SU;UNTIL=20061029T060000Z\r\n" +
+	"TZNAME:EST\r\n" +
+	"TZOFFSETFROM:-0400\r\n" +
+	"TZOFFSETTO:-0500\r\n" +
+	"END:STANDARD\r\n" +
+	"BEGIN:DAYLIGHT\r\n" +
+	"DTSTART:19870405T020000\r\n" +
+	"RRULE:FREQ=YEARLY;BYMONTH=4;BYDAY=1SU;UNTIL=20060402T070000Z\r\n" +
+	"TZNAME:EDT\r\n" +
+	"TZOFFSETFROM:-0500\r\n" +
+	"TZOFFSETTO:-0400\r\n" +
+	"END:DAYLIGHT\r\n" +
+	"BEGIN:STANDARD\r\n" +
+	"DTSTART:20071104T020000\r\n" +
+	"RRULE:FREQ=YEARLY;BYMONTH=11;BYDAY=1SU\r\n" +
+	"TZNAME:EST\r\n" +
+	"TZOFFSETFROM:-0400\r\n" +
+	"TZOFFSETTO:-0500\r\n" +
+	"END:STANDARD\r\n" +
+	"BEGIN:DAYLIGHT\r\n" +
+	"DTSTART:20070311T020000\r\n" +
+	"RRULE:FREQ=YEARLY;BYMONTH=3;BYDAY=2SU\r\n" +
+	"TZNAME:EDT\r\n" +
+	"TZOFFSETFROM:-0500\r\n" +
+	"TZOFFSETTO:-0400\r\n" +
+	"END:DAYLIGHT\r\n" +
+	"END:VTIMEZONE\r\n" +
+	"BEGIN:VTIMEZONE\r\n" +
+	"TZID:Custom/Fixed\r\n" +
+	"BEGIN:STANDARD\r\n" +
+	"DTSTART:19700101T000000\r\n" +
+	"TZNAME:IST\r\n" +
+	"TZOFFSETFROM:+0530\r\n" +
+	"TZOFFSETTO:+0530\r\n" +
+	"END:STANDARD\r\n" +
+	"END:VTIMEZONE\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:summer\r\n" +
+	"DTSTART;TZID=Eastern Standard Time:20250701T100000\r\n" +
+	"DTEND;TZID=Eastern Standard Time:20250701T110000\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:winter\r\n" +
+	"DTSTART;TZID=Eastern Standard Time:20250115T100000\r\n" +
+	"DURATION:PT30M\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:old-rules\r\n" +
+	"DTSTART;TZID=Eastern Standard Time:20050320T100000\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:fixed\r\n" +
+	"DTSTART;TZID=Custom/Fixed:20250701T100000\r\n" +
+	"DTEND:20250701T050000Z\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:iana\r\n" +
+	"DTSTART;TZID=Europe/Paris:20250701T100000\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:all-day\r\n" +
+	"DTSTART;VALUE=DATE:20250101\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParseICSOffset(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := map[string]int{
+		"+0000":   0,
+		"+0200":   7200,
+		"-0500":   -18000,
+		"+0530":   19800,
+		"-003015": -1815,
+	}
+
+	for value, expected := range tests {
+		offset, err := parseICSOffset(value)
+		is.NoError(err, value)
+		is.Equal(expected, offset, value)
+	}
+
+	for _, value := range []string{"", "0200", "+2", "+02:00"} {
+		_, err := parseICSOffset(value)
+		is.Error(err, value)
+	}
+}
+
+func TestEventTimes(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	times, err := ro.Collect(
+		ro.Pipe1(
+			ParseCalendar(strings.NewReader(testTimezoneCalendar)),
+			EventTimes(),
+		),
+	)
+	is.NoError(err)
+	is.Len(times, 6)
+
+	utc := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	is.Equal("summer", times[0].Event.Id())
+	is.Equal(utc(2025, 7, 1, 14, 0), times[0].Start.UTC())
+	is.Equal(utc(2025, 7, 1, 15, 0), times[0].End.UTC())
+	is.Equal("EDT", times[0].Start.Location().String())
+	is.Equal(10, times[0].Start.Hour())
+
+	is.Equal(utc(2025, 1, 15, 15, 0), times[1].Start.UTC())
+	is.Equal(utc(2025, 1, 15, 15, 30), times[1].End.UTC())
+	is.Equal("EST", times[1].Start.Location().String())
+
+	// before 2007, daylight saving time started in April
+	is.Equal(utc(2005, 3, 20, 15, 0), times[2].Start.UTC())
+	is.Equal(times[2].Start, times[2].End)
+
+	is.Equal(utc(2025, 7, 1, 4, 30), times[3].Start.UTC())
+	is.Equal(utc(2025, 7, 1, 5, 0), times[3].End)
+
+	is.Equal(utc(2025, 7, 1, 8, 0), times[4].Start.UTC())
+	is.Equal("Europe/Paris", times[4].Start.Location().String())
+
+	is.Equal(EventTime{
+		Event:  times[5].Event,
+		Start:  utc(2025, 1, 1, 0, 0),
+		End:    utc(2025, 1, 2, 0, 0),
+		AllDay: true,
+	}, times[5])
+
+	// unknown time zone
+	times, err = ro.Collect(
+		ro.Pipe1(
+			ParseCalendar(strings.NewReader(strings.Join([]string{
+				"BEGIN:VCALENDAR",
+				"VERSION:2.0",
+				"BEGIN:VEVENT",
+				"UID:unknown",
+				"DTSTART;TZID=Mars/Olympus:20250701T100000",
+				"END:VEVENT",
+				"END:VCALENDAR",
+			}, "\r\n"))),
+			EventTimes(),
+		),
+	)
+	is.ErrorContains(err, `roics: invalid DTSTART of "unknown": unknown time zone "Mars/Olympus"`)
+	is.Len(times, 0)
+
+	// invalid VTIMEZONE
+	times, err = ro.Collect(
+		ro.Pipe1(
+			ParseCalendar(strings.NewReader(strings.Join([]string{
+				"BEGIN:VCALENDAR",
+				"VERSION:2.0",
+				"BEGIN:VTIMEZONE",
+				"TZID:Broken",
+				"BEGIN:STANDARD",
+				"DTSTART:19700101T000000",
+				"TZOFFSETTO:0100",
+				"END:STANDARD",
+				"END:VTIMEZONE",
+				"END:VCALENDAR",
+			}, "\r\n"))),
+			EventTimes(),
+		),
+	)
+	is.ErrorContains(err, `roics: invalid VTIMEZONE "Broken"`)
+	is.Len(times, 0)
+
+	// missing DTSTART
+	times, err = ro.Collect(
+		ro.Pipe1(
+			ro.Just[ics.Component](ics.NewEvent("no-start")),
+			EventTimes(),
+		),
+	)
+	is.ErrorContains(err, `roics: missing DTSTART of "no-start"`)
+	is.Len(times, 0)
+}
+
+func TestVTimezone_location(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// Europe/Paris, from the testdata files
+	components, err := ro.Collect(
+		ro.Pipe1(
+			FromICSFile("testdata/fr-public-holidays-a.ics"),
+			ComponentsOfType[*ics.VTimezone](),
+		),
+	)
+	is.NoError(err)
+	is.Len(components, 1)
+
+	zones := timezones{}
+	is.NoError(zones.add(components[0]))
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	is.NoError(err)
+
+	zone := zones["Europe/Paris"]
+	is.NotNil(zone)
+
+	for _, wall := range []time.Time{
+		time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 30, 1, 59, 0, 0, time.UTC),
+		time.Date(2025, 3, 30, 3, 0, 0, 0, time.UTC),
+		time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(2025, 10, 26, 3, 0, 0, 0, time.UTC),
+		time.Date(2025, 12, 31, 23, 59, 0, 0, time.UTC),
+		time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC),
+	} {
+		expected := inLocation(wall, paris)
+		actual := inLocation(wall, zone.location(wall))
+		is.True(expected.Equal(actual), wall.String())
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"sort"
 
 	"github.com/samber/ro"
 )
@@ -37,16 +38,46 @@ func Log[T any](logger slog.Logger, level slog.Level) func(ro.Observable[T]) ro.
 	)
 }
 
+// LogWithNotification logs the notifications with structured attributes. The
+// pipeline name and the stage tags attached to the context of the notifications
+// (see ro.WithPipelineName and ro.WithStageTag) are logged as "pipeline" and
+// "tags" attributes.
 func LogWithNotification[T any](logger slog.Logger, level slog.Level) func(ro.Observable[T]) ro.Observable[T] {
 	return ro.TapWithContext(
 		func(ctx context.Context, value T) {
-			logger.LogAttrs(ctx, level, "ro.Next", slog.Any("value", value))
+			logger.LogAttrs(ctx, level, "ro.Next", append(metadataAttrs(ctx), slog.Any("value", value))...)
 		},
 		func(ctx context.Context, err error) {
-			logger.LogAttrs(ctx, level, "ro.Error", slog.Any("error", err))
+			logger.LogAttrs(ctx, level, "ro.Error", append(metadataAttrs(ctx), slog.Any("error", err))...)
 		},
 		func(ctx context.Context) {
-			logger.LogAttrs(ctx, level, "ro.Complete")
+			logger.LogAttrs(ctx, level, "ro.Complete", metadataAttrs(ctx)...)
 		},
 	)
 }
+
+func metadataAttrs(ctx context.Context) []slog.Attr {
+	var attrs []slog.Attr
+
+	if name := ro.PipelineName(ctx); name != "" {
+		attrs = append(attrs, slog.String("pipeline", name))
+	}
+
+	if tags := ro.StageTags(ctx); len(tags) > 0 {
+		keys := make([]string, 0, len(tags))
+		for key := range tags {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		group := make([]any, 0, len(tags))
+		for _, key := range keys {
+			group = append(group, slog.String(key, tags[key]))
+		}
+
+		attrs = append(attrs, slog.Group("tags", group...))
+	}
+
+	return attrs
+}
@@ -105,6 +105,30 @@ func ExampleLogWithNotification() {
 	// level=DEBUG msg=ro.Complete
 }
 
+func ExampleLogWithNotification_withPipelineMetadata() {
+	// Initialize slog logger with mock handler that removes time
+	buff := bufio.NewWriter(os.Stdout)
+	logger := slog.New(slog.NewTextHandler(&timeFilterWriter{w: buff}, &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+	}))
+	defer buff.Flush()
+
+	// The pipeline name and tags travel with the notifications
+	ctx := ro.WithStageTag(ro.WithPipelineName(context.Background(), "greetings"), "tenant", "acme")
+
+	observable := ro.Pipe1(
+		ro.Just("hello"),
+		LogWithNotification[string](*logger, slog.LevelInfo),
+	)
+
+	subscription := observable.SubscribeWithContext(ctx, ro.NoopObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// level=INFO msg=ro.Next pipeline=greetings tags.tenant=acme value=hello
+	// level=INFO msg=ro.Complete pipeline=greetings tags.tenant=acme
+}
+
 func ExampleLog_withError() {
 	// Initialize slog logger with mock handler that removes time
 	buff := bufio.NewWriter(os.Stdout)
@@ -154,3 +154,132 @@ func ValidateStructOrSkipWithContext[T any]() func(ro.Observable[T]) ro.Observab
 		return ctx, err == nil
 	})
 }
+
+func ValidateOrDrop[T any](onInvalid func(value T, err error), rules ...ozzo.Rule) func(ro.Observable[T]) ro.Observable[T] {
+	return validateOrDrop(
+		func(_ context.Context, v T) error {
+			return ozzo.Validate(v, rules...)
+		},
+		func(_ context.Context, v T, err error) {
+			onInvalid(v, err)
+		},
+	)
+}
+
+func ValidateStructOrDrop[T any](onInvalid func(value T, err error)) func(ro.Observable[T]) ro.Observable[T] {
+	var t T
+	if _, ok := any(t).(ozzo.Validatable); !ok {
+		panic(ErrValidatable)
+	}
+
+	return validateOrDrop(
+		func(_ context.Context, v T) error {
+			return any(v).(ozzo.Validatable).Validate()
+		},
+		func(_ context.Context, v T, err error) {
+			onInvalid(v, err)
+		},
+	)
+}
+
+func ValidateOrDropWithContext[T any](onInvalid func(ctx context.Context, value T, err error), rules ...ozzo.Rule) func(ro.Observable[T]) ro.Observable[T] {
+	return validateOrDrop(
+		func(ctx context.Context, v T) error {
+			return ozzo.ValidateWithContext(ctx, v, rules...)
+		},
+		onInvalid,
+	)
+}
+
+func ValidateStructOrDropWithContext[T any](onInvalid func(ctx context.Context, value T, err error)) func(ro.Observable[T]) ro.Observable[T] {
+	var t T
+	if _, ok := any(t).(ozzo.ValidatableWithContext); !ok {
+		panic(ErrValidatableWithContext)
+	}
+
+	return validateOrDrop(
+		func(ctx context.Context, v T) error {
+			return any(v).(ozzo.ValidatableWithContext).ValidateWithContext(ctx)
+		},
+		onInvalid,
+	)
+}
+
+func validateOrDrop[T any](validate func(ctx context.Context, v T) error, onInvalid func(ctx context.Context, value T, err error)) func(ro.Observable[T]) ro.Observable[T] {
+	return ro.FilterWithContext(func(ctx context.Context, v T) (context.Context, bool) {
+		err := validate(ctx, v)
+		if err != nil {
+			onInvalid(ctx, v, err)
+		}
+		return ctx, err == nil
+	})
+}
+
+// Invalid is an item rejected by the validation, routed to a side channel by
+// the ValidateOrRoute operators.
+type Invalid[T any] struct {
+	Value T
+	Err   error
+}
+
+func ValidateOrRoute[T any](invalid ro.Observer[Invalid[T]], rules ...ozzo.Rule) func(ro.Observable[T]) ro.Observable[T] {
+	return validateOrRoute(
+		func(_ context.Context, v T) error {
+			return ozzo.Validate(v, rules...)
+		},
+		invalid,
+	)
+}
+
+func ValidateStructOrRoute[T any](invalid ro.Observer[Invalid[T]]) func(ro.Observable[T]) ro.Observable[T] {
+	var t T
+	if _, ok := any(t).(ozzo.Validatable); !ok {
+		panic(ErrValidatable)
+	}
+
+	return validateOrRoute(
+		func(_ context.Context, v T) error {
+			return any(v).(ozzo.Validatable).Validate()
+		},
+		invalid,
+	)
+}
+
+func ValidateOrRouteWithContext[T any](invalid ro.Observer[Invalid[T]], rules ...ozzo.Rule) func(ro.Observable[T]) ro.Observable[T] {
+	return validateOrRoute(
+		func(ctx context.Context, v T) error {
+			return ozzo.ValidateWithContext(ctx, v, rules...)
+		},
+		invalid,
+	)
+}
+
+func ValidateStructOrRouteWithContext[T any](invalid ro.Observer[Invalid[T]]) func(ro.Observable[T]) ro.Observable[T] {
+	var t T
+	if _, ok := any(t).(ozzo.ValidatableWithContext); !ok {
+		panic(ErrValidatableWithContext)
+	}
+
+	return validateOrRoute(
+		func(ctx context.Context, v T) error {
+			return any(v).(ozzo.ValidatableWithContext).ValidateWithContext(ctx)
+		},
+		invalid,
+	)
+}
+
+func validateOrRoute[T any](validate func(ctx context.Context, v T) error, invalid ro.Observer[Invalid[T]]) func(ro.Observable[T]) ro.Observable[T] {
+	return func(source ro.Observable[T]) ro.Observable[T] {
+		return ro.Pipe2(
+			source,
+			validateOrDrop(validate, func(ctx context.Context, v T, err error) {
+				invalid.NextWithContext(ctx, Invalid[T]{Value: v, Err: err})
+			}),
+			ro.TapWithContext(
+				func(ctx context.Context, v T) {},
+				invalid.ErrorWithContext,
+				invalid.CompleteWithContext,
+			),
+		)
+	}
+}
@@ -16,6 +16,8 @@
 package roozzovalidation
 
 import (
+	"fmt"
+
 	ozzo "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
 	"github.com/samber/ro"
@@ -189,3 +191,31 @@ func ExampleValidateOrSkipWithContext() {
 	// Next: valid@email.com
 	// Completed
 }
+
+func ExampleValidateOrRoute() {
+	// Route invalid values and their validation error to a side channel
+	invalid := ro.NewPublishSubject[Invalid[string]]()
+	invalid.Subscribe(ro.OnNext(func(item Invalid[string]) {
+		fmt.Printf("Invalid: %s (%v)\n", item.Value, item.Err)
+	}))
+
+	observable := ro.Pipe1(
+		ro.Just(
+			"valid@email.com",
+			"invalid-email",
+		),
+		ValidateOrRoute[string](
+			invalid,
+			ozzo.Required,
+			is.EmailFormat,
+		),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: valid@email.com
+	// Invalid: invalid-email (must be a valid email address)
+	// Completed
+}
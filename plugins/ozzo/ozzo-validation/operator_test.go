@@ -736,3 +736,116 @@ func TestValidateOrSkipWithContext_ErrorObservable(t *testing.T) {
 	is.EqualError(err, assert.AnError.Error())
 	is.Empty(values)
 }
+
+func TestValidateOrDrop(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	dropped := []string{}
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just("test", "", "valid", "too-long-string-that-exceeds-limit"),
+			ValidateOrDrop(func(value string, err error) {
+				is.Error(err)
+				dropped = append(dropped, value)
+			}, ozzo.Required, ozzo.Length(1, 10)),
+		),
+	)
+	is.Nil(err)
+	is.Equal([]string{"test", "valid"}, values)
+	is.Equal([]string{"", "too-long-string-that-exceeds-limit"}, dropped)
+
+	dropped = []string{}
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Just("test", ""),
+			ValidateOrDropWithContext(func(ctx context.Context, value string, err error) {
+				is.NotNil(ctx)
+				dropped = append(dropped, value)
+			}, ozzo.Required),
+		),
+	)
+	is.Nil(err)
+	is.Equal([]string{"test"}, values)
+	is.Equal([]string{""}, dropped)
+
+	users, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just(
+				ValidatableUser{Name: "John", Email: "john@example.com", Age: 25},
+				ValidatableUser{Name: "", Email: "invalid-email", Age: 10},
+			),
+			ValidateStructOrDrop(func(value ValidatableUser, err error) {
+				is.Equal("", value.Name)
+			}),
+		),
+	)
+	is.Nil(err)
+	is.Len(users, 1)
+	is.Equal("John", users[0].Name)
+
+	is.PanicsWithValue(ErrValidatable, func() {
+		ValidateStructOrDrop(func(value User, err error) {})
+	})
+}
+
+func TestValidateOrRoute(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	invalid := ro.NewReplaySubject[Invalid[string]](10)
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just("test", "", "valid", "too-long-string-that-exceeds-limit"),
+			ValidateOrRoute[string](invalid, ozzo.Required, ozzo.Length(1, 10)),
+		),
+	)
+	is.Nil(err)
+	is.Equal([]string{"test", "valid"}, values)
+
+	rejected, err := ro.Collect(invalid.AsObservable())
+	is.Nil(err)
+	is.Len(rejected, 2)
+	is.Equal("", rejected[0].Value)
+	is.EqualError(rejected[0].Err, "cannot be blank")
+	is.Equal("too-long-string-that-exceeds-limit", rejected[1].Value)
+	is.Error(rejected[1].Err)
+
+	// the side channel mirrors the termination of the source
+	invalid = ro.NewReplaySubject[Invalid[string]](10)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Throw[string](assert.AnError),
+			ValidateOrRouteWithContext[string](invalid, ozzo.Required),
+		),
+	)
+	is.EqualError(err, assert.AnError.Error())
+	is.Empty(values)
+
+	rejected, err = ro.Collect(invalid.AsObservable())
+	is.EqualError(err, assert.AnError.Error())
+	is.Empty(rejected)
+
+	routed := ro.NewReplaySubject[Invalid[ValidatableUser]](10)
+
+	users, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just(
+				ValidatableUser{Name: "John", Email: "john@example.com", Age: 25},
+				ValidatableUser{Name: "", Email: "invalid-email", Age: 10},
+			),
+			ValidateStructOrRoute[ValidatableUser](routed),
+		),
+	)
+	is.Nil(err)
+	is.Len(users, 1)
+
+	rejectedUsers, err := ro.Collect(routed.AsObservable())
+	is.Nil(err)
+	is.Len(rejectedUsers, 1)
+	is.Equal("invalid-email", rejectedUsers[0].Value.Email)
+}
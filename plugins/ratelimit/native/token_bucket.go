@@ -0,0 +1,233 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/samber/ro"
+)
+
+var (
+	ErrRateLimitWrongRate  = errors.New("roratelimit.RateLimit: rate must be greater than 0")
+	ErrRateLimitWrongBurst = errors.New("roratelimit.RateLimit: burst must be greater than 0")
+)
+
+// onDropped reports the items dropped by RateLimit to ro.OnDroppedNotification.
+// Replaced by the tests.
+var onDropped = func(ctx context.Context, notification fmt.Stringer) {
+	ro.OnDroppedNotification(ctx, notification)
+}
+
+// errStopped is returned by tokenBucket.wait when the subscription is canceled.
+var errStopped = errors.New("roratelimit.RateLimit: stopped")
+
+// RateLimitOption configures RateLimit.
+type RateLimitOption func(config *rateLimitConfig)
+
+type rateLimitConfig struct {
+	drop bool
+	fail bool
+}
+
+// WithDrop makes RateLimit drop the items exceeding the budget, instead of
+// delaying them. Dropped items are reported to ro.OnDroppedNotification, as a
+// *ro.DroppedError wrapping a *ro.RateLimitError.
+func WithDrop() RateLimitOption {
+	return func(config *rateLimitConfig) {
+		config.drop = true
+	}
+}
+
+// WithError makes RateLimit fail with a *ro.RateLimitError, matching
+// ro.ErrRateLimited, on the first item exceeding the budget, instead of
+// delaying it. The error carries the delay before a token is available.
+func WithError() RateLimitOption {
+	return func(config *rateLimitConfig) {
+		config.fail = true
+	}
+}
+
+// RateLimit limits the emissions to rate items per second, with bursts of up to
+// burst items, using a token bucket shared by all items. By default, an item
+// exceeding the budget is delayed until a token is available: the source is
+// blocked meanwhile, which applies backpressure. If the context of the item is
+// canceled while waiting, the context error is emitted. If the subscription is
+// canceled while waiting, the item is discarded. See WithDrop to drop the
+// excess, or WithError to fail on it, instead.
+func RateLimit[T any](rate float64, burst int, opts ...RateLimitOption) func(ro.Observable[T]) ro.Observable[T] {
+	if !(rate > 0) {
+		panic(ErrRateLimitWrongRate)
+	}
+
+	if burst < 1 {
+		panic(ErrRateLimitWrongBurst)
+	}
+
+	config := rateLimitConfig{}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return func(source ro.Observable[T]) ro.Observable[T] {
+		return ro.NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[T]) ro.Teardown {
+			bucket := newTokenBucket(rate, burst)
+			stopped := make(chan struct{})
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				ro.NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if config.drop || config.fail {
+							retryAfter, ok := bucket.allow(time.Now())
+							switch {
+							case ok:
+								destination.NextWithContext(ctx, value)
+							case config.fail:
+								destination.ErrorWithContext(ctx, &ro.RateLimitError{
+									Operator:   "roratelimit.RateLimit",
+									RetryAfter: retryAfter,
+								})
+							default:
+								onDropped(ctx, &ro.DroppedError{
+									Operator: "roratelimit.RateLimit",
+									Value:    value,
+									Err: &ro.RateLimitError{
+										Operator:   "roratelimit.RateLimit",
+										RetryAfter: retryAfter,
+									},
+								})
+							}
+
+							return
+						}
+
+						if err := bucket.wait(ctx, stopped); err != nil {
+							if !errors.Is(err, errStopped) {
+								destination.ErrorWithContext(ctx, err)
+							}
+
+							return
+						}
+
+						destination.NextWithContext(ctx, value)
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return func() {
+				close(stopped)
+				sub.Unsubscribe()
+			}
+		})
+	}
+}
+
+// tokenBucket holds up to burst tokens, refilled at rate tokens per second. In
+// delay mode, tokens are reserved in advance and the balance may go negative:
+// the debt is the time callers have to wait.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+func (b *tokenBucket) advance(now time.Time) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed.Seconds()*b.rate)
+		b.last = now
+	}
+}
+
+// allow consumes a token if one is available. Otherwise, it returns the delay
+// before a token is available.
+func (b *tokenBucket) allow(now time.Time) (time.Duration, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.advance(now)
+
+	if b.tokens < 1 {
+		return time.Duration((1 - b.tokens) / b.rate * float64(time.Second)), false
+	}
+
+	b.tokens--
+
+	return 0, true
+}
+
+// reserve consumes a token and returns the delay before it is available.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.advance(now)
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel gives back a reserved token.
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = math.Min(b.burst, b.tokens+1)
+}
+
+// wait blocks until a token is available, or until ctx is canceled or stopped
+// is closed.
+func (b *tokenBucket) wait(ctx context.Context, stopped <-chan struct{}) error {
+	delay := b.reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	case <-stopped:
+		b.cancel()
+		return errStopped
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roratelimit
+
+import (
+	"github.com/samber/ro"
+)
+
+func ExampleRateLimit() {
+	// 100 items per second, with bursts of 3 items
+	observable := ro.Pipe1(
+		ro.Just(1, 2, 3, 4, 5),
+		RateLimit[int](100, 3),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 1
+	// Next: 2
+	// Next: 3
+	// Next: 4
+	// Next: 5
+	// Completed
+}
+
+func ExampleRateLimit_drop() {
+	// 1 item per second, with bursts of 3 items: the excess is dropped
+	observable := ro.Pipe1(
+		ro.Just(1, 2, 3, 4, 5),
+		RateLimit[int](1, 3, WithDrop()),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 1
+	// Next: 2
+	// Next: 3
+	// Completed
+}
@@ -0,0 +1,174 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roratelimit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	start := time.Now()
+	values, err := ro.Collect(
+		ro.Pipe2(
+			ro.Just(1, 2, 3, 4),
+			RateLimit[int](20, 2),
+			ro.Map(func(v int) time.Duration {
+				return time.Since(start)
+			}),
+		),
+	)
+	is.NoError(err)
+	is.Len(values, 4)
+	is.Less(values[1], 25*time.Millisecond) // burst
+	is.InDelta(50*time.Millisecond, values[2], float64(25*time.Millisecond))
+	is.InDelta(100*time.Millisecond, values[3], float64(25*time.Millisecond))
+
+	is.PanicsWithValue(ErrRateLimitWrongRate, func() {
+		_ = RateLimit[int](0, 1)
+	})
+	is.PanicsWithValue(ErrRateLimitWrongBurst, func() {
+		_ = RateLimit[int](1, 0)
+	})
+}
+
+func TestRateLimitUnsubscribeWhileWaiting(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var mu sync.Mutex
+	values := []int{}
+
+	source := ro.NewPublishSubject[int]()
+	sub := RateLimit[int](1, 1)(source).Subscribe(ro.OnNext(func(value int) {
+		mu.Lock()
+		values = append(values, value)
+		mu.Unlock()
+	}))
+
+	source.Next(1)
+
+	// The second item waits for a token, for 1 second.
+	done := make(chan struct{})
+	go func() {
+		source.Next(2)
+		close(done)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	sub.Unsubscribe()
+
+	select {
+	case <-done:
+	case <-time.After(500 * time.Millisecond):
+		is.Fail("the wait should stop upon unsubscription")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	is.Equal([]int{1}, values)
+}
+
+func TestRateLimitWithDrop(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ro.Collect(
+		RateLimit[int](1, 2, WithDrop())(ro.Just(1, 2, 3, 4, 5)),
+	)
+	is.NoError(err)
+	is.Equal([]int{1, 2}, values)
+
+	values, err = ro.Collect(
+		RateLimit[int](1, 2, WithDrop())(ro.Throw[int](assert.AnError)),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestRateLimitContextCanceled(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	start := time.Now()
+	values, err := ro.Collect(
+		ro.Pipe2(
+			ro.Just(1, 2, 3),
+			ro.ContextWithTimeout[int](20*time.Millisecond),
+			RateLimit[int](1, 1),
+		),
+	)
+	is.Equal([]int{1}, values)
+	is.ErrorIs(err, context.DeadlineExceeded)
+	is.Less(time.Since(start), 500*time.Millisecond)
+}
+
+func TestRateLimitWithError(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ro.Collect(
+		RateLimit[int](2, 2, WithError())(ro.Just(1, 2, 3, 4)),
+	)
+	is.Equal([]int{1, 2}, values)
+	is.ErrorIs(err, ro.ErrRateLimited)
+
+	var rateLimitErr *ro.RateLimitError
+	is.ErrorAs(err, &rateLimitErr)
+	is.Equal("roratelimit.RateLimit", rateLimitErr.Operator)
+	is.InDelta(500*time.Millisecond, rateLimitErr.RetryAfter, float64(25*time.Millisecond))
+}
+
+//nolint:paralleltest
+func TestRateLimitWithDrop_reportsDropped(t *testing.T) {
+	// t.Parallel()
+	is := assert.New(t)
+
+	previous := onDropped
+	t.Cleanup(func() { onDropped = previous })
+
+	var dropped []string
+	var errs []error
+	onDropped = func(ctx context.Context, notification fmt.Stringer) {
+		dropped = append(dropped, notification.String())
+		if err, ok := notification.(error); ok {
+			errs = append(errs, err)
+		}
+	}
+
+	values, err := ro.Collect(
+		RateLimit[int](1, 2, WithDrop())(ro.Just(1, 2, 3, 4)),
+	)
+	is.NoError(err)
+	is.Equal([]int{1, 2}, values)
+	is.Equal([]string{"Next(3)", "Next(4)"}, dropped)
+	is.Len(errs, 2)
+	is.ErrorIs(errs[0], ro.ErrDropped)
+	is.ErrorIs(errs[0], ro.ErrRateLimited)
+
+	var droppedErr *ro.DroppedError
+	is.ErrorAs(errs[1], &droppedErr)
+	is.Equal("roratelimit.RateLimit", droppedErr.Operator)
+	is.Equal(4, droppedErr.Value)
+}
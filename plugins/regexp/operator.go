@@ -50,6 +50,29 @@ func FindStringSubmatch[T ~string](pattern *regexp.Regexp) func(ro.Observable[T]
 	})
 }
 
+// FindStringSubmatchNamed finds the first submatch of the pattern in the string,
+// and returns the named capture groups, indexed by name. Unnamed groups are
+// ignored. It returns nil when the string does not match.
+func FindStringSubmatchNamed[T ~string](pattern *regexp.Regexp) func(ro.Observable[T]) ro.Observable[map[string]string] {
+	names := pattern.SubexpNames()
+
+	return ro.Map(func(v T) map[string]string {
+		match := pattern.FindStringSubmatch(string(v))
+		if match == nil {
+			return nil
+		}
+
+		groups := make(map[string]string, len(names))
+		for i, name := range names {
+			if i > 0 && name != "" {
+				groups[name] = match[i]
+			}
+		}
+
+		return groups
+	})
+}
+
 // FindAll finds all matches of the pattern in the byte slice.
 func FindAll[T ~[]byte](pattern *regexp.Regexp, n int) func(ro.Observable[T]) ro.Observable[[][]byte] {
 	return ro.Map(func(v T) [][]byte {
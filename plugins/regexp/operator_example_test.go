@@ -112,6 +112,28 @@ func ExampleFindStringSubmatch() {
 	// Completed
 }
 
+func ExampleFindStringSubmatchNamed() {
+	// Extract named capture groups from log lines
+	pattern := regexp.MustCompile(`^(?P<level>[A-Z]+) (?P<msg>.*)$`)
+	observable := ro.Pipe1(
+		ro.Just(
+			"INFO server started",
+			"ERROR disk full",
+			"no match",
+		),
+		FindStringSubmatchNamed[string](pattern),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[map[string]string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: map[level:INFO msg:server started]
+	// Next: map[level:ERROR msg:disk full]
+	// Next: map[]
+	// Completed
+}
+
 func ExampleFindAll() {
 	// Find all matches in byte slices
 	pattern := regexp.MustCompile(`\d+`)
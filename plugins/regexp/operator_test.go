@@ -119,6 +119,36 @@ func TestFindStringSubmatch(t *testing.T) {
 	assert.Equal(t, expected, result)
 }
 
+func TestFindStringSubmatchNamed(t *testing.T) {
+	pattern := regexp.MustCompile(`(?P<level>[A-Z]+) (\d+) (?P<msg>.*)`)
+	operator := FindStringSubmatchNamed[string](pattern)
+
+	observable := ro.FromSlice([]string{
+		"INFO 42 server started",
+		"no match",
+		"ERROR 7 disk full",
+	})
+
+	result, err := ro.Collect(operator(observable))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []map[string]string{
+		{"level": "INFO", "msg": "server started"},
+		nil, // no match
+		{"level": "ERROR", "msg": "disk full"},
+	}
+
+	assert.Equal(t, expected, result)
+
+	// optional named groups are present, with an empty value
+	pattern = regexp.MustCompile(`(?P<key>\w+)(=(?P<value>\w+))?`)
+	result, err = ro.Collect(FindStringSubmatchNamed[string](pattern)(ro.Just("flag")))
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]string{{"key": "flag", "value": ""}}, result)
+}
+
 func TestFindAll(t *testing.T) {
 	pattern := regexp.MustCompile(`\d+`)
 	operator := FindAll[[]byte](pattern, -1)
@@ -0,0 +1,24 @@
+module github.com/samber/ro/plugins/rxgo
+
+go 1.18
+
+require (
+	github.com/reactivex/rxgo/v2 v2.5.0
+	github.com/samber/ro v0.0.0
+	github.com/stretchr/testify v1.11.1
+)
+
+require (
+	github.com/cenkalti/backoff/v4 v4.0.0 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/emirpasic/gods v1.12.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/samber/lo v1.53.0 // indirect
+	github.com/stretchr/objx v0.5.2 // indirect
+	github.com/teivah/onecontext v0.0.0-20200513185103-40f981bfd775 // indirect
+	golang.org/x/exp v0.0.0-20240613232115-7f521ea00fb8 // indirect
+	golang.org/x/text v0.22.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/samber/ro => ../..
@@ -0,0 +1,59 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rorxgo
+
+import (
+	"context"
+
+	"github.com/reactivex/rxgo/v2"
+	"github.com/samber/ro"
+)
+
+// ToRxGo converts an observable to a cold RxGo observable. Each observation
+// subscribes to the source: values are sent as items, and the error of the
+// source, if any, is sent as an error item. Canceling the context of the
+// observation unsubscribes from the source.
+//
+// Sending an item blocks until the RxGo side consumes it, so that the source
+// follows the pace of the RxGo pipeline.
+func ToRxGo[T any](source ro.Observable[T], opts ...rxgo.Option) rxgo.Observable {
+	producer := func(ctx context.Context, next chan<- rxgo.Item) {
+		done := make(chan struct{})
+
+		sub := source.SubscribeWithContext(
+			ctx,
+			ro.NewObserverWithContext(
+				func(_ context.Context, value T) {
+					rxgo.Of(value).SendContext(ctx, next)
+				},
+				func(_ context.Context, err error) {
+					defer close(done)
+					rxgo.Error(err).SendContext(ctx, next)
+				},
+				func(_ context.Context) {
+					close(done)
+				},
+			),
+		)
+		defer sub.Unsubscribe()
+
+		select {
+		case <-done:
+		case <-ctx.Done():
+		}
+	}
+
+	return rxgo.Defer([]rxgo.Producer{producer}, opts...)
+}
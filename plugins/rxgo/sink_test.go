@@ -0,0 +1,99 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rorxgo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/reactivex/rxgo/v2"
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToRxGo(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	obs := ToRxGo(ro.Just(1, 2, 3))
+
+	values, err := obs.ToSlice(0)
+	is.Equal([]interface{}{1, 2, 3}, values)
+	is.NoError(err)
+
+	// cold: each observation subscribes to the source
+	values, err = obs.ToSlice(0)
+	is.Equal([]interface{}{1, 2, 3}, values)
+	is.NoError(err)
+
+	values, err = ToRxGo(ro.Empty[int]()).ToSlice(0)
+	is.Empty(values)
+	is.NoError(err)
+}
+
+func TestToRxGo_error(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ToRxGo(ro.Concat(ro.Just(1), ro.Throw[int](errors.New("boom")))).ToSlice(0)
+	is.Equal([]interface{}{1}, values)
+	is.EqualError(err, "boom")
+}
+
+func TestToRxGo_cancel(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	unsubscribed := make(chan struct{})
+	source := ro.Pipe1(
+		ro.Never(),
+		ro.DoOnFinalize[struct{}](func() {
+			close(unsubscribed)
+		}),
+	)
+
+	items := ToRxGo(source).Observe(rxgo.WithContext(ctx))
+	cancel()
+
+	select {
+	case <-unsubscribed:
+	case <-time.After(time.Second):
+		is.Fail("source not unsubscribed")
+	}
+
+	for item := range items {
+		is.Fail("unexpected item", item)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			FromRxGo[int](ToRxGo(ro.Just(1, 2, 3)).Map(func(_ context.Context, v interface{}) (interface{}, error) {
+				return v.(int) * 10, nil
+			})),
+			ro.Filter(func(v int) bool { return v > 10 }),
+		),
+	)
+	is.Equal([]int{20, 30}, values)
+	is.NoError(err)
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rorxgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/reactivex/rxgo/v2"
+	"github.com/samber/ro"
+)
+
+// ErrUnexpectedItemType is emitted by FromRxGo when an item cannot be converted
+// to the type of the resulting observable.
+var ErrUnexpectedItemType = errors.New("rorxgo.FromRxGo: unexpected item type")
+
+// FromRxGo creates an observable from an RxGo observable. Each subscription
+// observes the RxGo observable: items are type-asserted to T, and RxGo error
+// items are emitted as errors. Unsubscribing cancels the RxGo observation.
+//
+// The RxGo options are passed to Observe. The context of the subscription
+// always takes precedence over rxgo.WithContext.
+func FromRxGo[T any](observable rxgo.Observable, opts ...rxgo.Option) ro.Observable[T] {
+	return ro.NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[T]) ro.Teardown {
+		ctx, cancel := context.WithCancel(subscriberCtx)
+
+		opts = append(opts[:len(opts):len(opts)], rxgo.WithContext(ctx))
+		items := observable.Observe(opts...)
+
+		go func() {
+			for {
+				select {
+				case item, ok := <-items:
+					if !ok {
+						destination.CompleteWithContext(subscriberCtx)
+						return
+					}
+
+					if item.E != nil {
+						destination.ErrorWithContext(subscriberCtx, item.E)
+						return
+					}
+
+					value, ok := item.V.(T)
+					if !ok {
+						destination.ErrorWithContext(subscriberCtx, fmt.Errorf("%w: %T", ErrUnexpectedItemType, item.V))
+						return
+					}
+
+					destination.NextWithContext(subscriberCtx, value)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+
+		return ro.Teardown(cancel)
+	})
+}
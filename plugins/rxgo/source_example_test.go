@@ -0,0 +1,33 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rorxgo
+
+import (
+	"fmt"
+
+	"github.com/reactivex/rxgo/v2"
+	"github.com/samber/ro"
+)
+
+func ExampleFromRxGo() {
+	observable := ro.Pipe1(
+		FromRxGo[int](rxgo.Just(1, 2, 3)()),
+		ro.Map(func(x int) int { return x * 2 }),
+	)
+
+	values, err := ro.Collect(observable)
+	fmt.Println(values, err)
+	// Output: [2 4 6] <nil>
+}
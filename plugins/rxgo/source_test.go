@@ -0,0 +1,75 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rorxgo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/reactivex/rxgo/v2"
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromRxGo(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ro.Collect(FromRxGo[int](rxgo.Just(1, 2, 3)()))
+	is.Equal([]int{1, 2, 3}, values)
+	is.NoError(err)
+
+	values, err = ro.Collect(FromRxGo[int](rxgo.Empty()))
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	// cold: each subscription observes the RxGo observable
+	obs := FromRxGo[int](rxgo.Just(1, 2)())
+	values, err = ro.Collect(obs)
+	is.Equal([]int{1, 2}, values)
+	is.NoError(err)
+	values, err = ro.Collect(obs)
+	is.Equal([]int{1, 2}, values)
+	is.NoError(err)
+}
+
+func TestFromRxGo_error(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	expected := errors.New("boom")
+
+	values, err := ro.Collect(FromRxGo[int](rxgo.Thrown(expected)))
+	is.Equal([]int{}, values)
+	is.EqualError(err, "boom")
+
+	values, err = ro.Collect(FromRxGo[int](rxgo.Just(1, "two", 3)()))
+	is.Equal([]int{1}, values)
+	is.ErrorIs(err, ErrUnexpectedItemType)
+	is.EqualError(err, "rorxgo.FromRxGo: unexpected item type: string")
+}
+
+func TestFromRxGo_cancel(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := ro.Run(ctx, FromRxGo[int](rxgo.Never()), nil)
+	is.ErrorIs(err, context.DeadlineExceeded)
+}
@@ -18,6 +18,7 @@ package rohot
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/samber/hot"
 	"github.com/samber/lo"
@@ -26,6 +27,11 @@ import (
 
 var NotFound = errors.New("rohot.GetOrFetchOrError: not found")
 
+var (
+	ErrDedupWithTTLWrongTTL     = errors.New("rohot.DedupWithTTL: ttl must be greater than 0")
+	ErrDedupWithTTLWrongMaxKeys = errors.New("rohot.DedupWithTTL: max keys must be greater than 0")
+)
+
 // GetOrFetch creates an operator that retrieves values from cache or fetches them when missing.
 // Play: https://go.dev/play/p/7mKj3n8fH4b
 func GetOrFetch[K comparable, V any](cache *hot.HotCache[K, V]) func(ro.Observable[K]) ro.Observable[lo.Tuple2[V, bool]] {
@@ -139,3 +145,52 @@ func GetOrFetchMany[K comparable, V any](cache *hot.HotCache[K, V]) func(ro.Obse
 		})
 	}
 }
+
+// DedupWithTTL creates an operator that drops the items whose key was already
+// seen during the last ttl. Unlike ro.DistinctBy, the keys are held in a LRU
+// cache of maxKeys entries with expiration, so that long-running pipelines use
+// bounded memory. When the cache is full, the least recently seen keys are
+// forgotten early.
+func DedupWithTTL[T any, K comparable](key func(item T) K, ttl time.Duration, maxKeys int) func(ro.Observable[T]) ro.Observable[T] {
+	if ttl <= 0 {
+		panic(ErrDedupWithTTLWrongTTL)
+	}
+
+	if maxKeys <= 0 {
+		panic(ErrDedupWithTTLWrongMaxKeys)
+	}
+
+	return func(source ro.Observable[T]) ro.Observable[T] {
+		return ro.NewObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[T]) ro.Teardown {
+			seen := hot.NewHotCache[K, struct{}](hot.LRU, maxKeys).
+				WithTTL(ttl).
+				Build()
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				ro.NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						k := key(value)
+
+						_, found, err := seen.Get(k)
+						if err != nil {
+							destination.ErrorWithContext(ctx, err)
+							return
+						}
+
+						if found {
+							return
+						}
+
+						seen.Set(k, struct{}{})
+						destination.NextWithContext(ctx, value)
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
@@ -272,3 +272,43 @@ func ExampleGetOrFetch_withContext() {
 	// Not found: user2
 	// Completed
 }
+
+func ExampleDedupWithTTL() {
+	type Event struct {
+		ID      string
+		Payload string
+	}
+
+	// Drop the events delivered twice within 5 minutes, remembering at most
+	// 10000 event IDs.
+	observable := ro.Pipe1(
+		ro.Just(
+			Event{ID: "evt-1", Payload: "a"},
+			Event{ID: "evt-2", Payload: "b"},
+			Event{ID: "evt-1", Payload: "a"},
+			Event{ID: "evt-3", Payload: "c"},
+		),
+		DedupWithTTL(func(event Event) string { return event.ID }, 5*time.Minute, 10000),
+	)
+
+	subscription := observable.Subscribe(
+		ro.NewObserver(
+			func(event Event) {
+				fmt.Printf("Event: %s (%s)\n", event.ID, event.Payload)
+			},
+			func(err error) {
+				fmt.Printf("Error: %v\n", err)
+			},
+			func() {
+				fmt.Println("Completed")
+			},
+		),
+	)
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Event: evt-1 (a)
+	// Event: evt-2 (b)
+	// Event: evt-3 (c)
+	// Completed
+}
@@ -16,9 +16,78 @@
 package rohot
 
 import (
+	"errors"
+	"reflect"
 	"testing"
+	"time"
+
+	"github.com/samber/ro"
 )
 
 func Test(t *testing.T) {
 	// @TODO: Implement
 }
+
+func TestDedupWithTTL(t *testing.T) {
+	t.Parallel()
+
+	identity := func(item int) int { return item }
+
+	values, err := ro.Collect(
+		DedupWithTTL(identity, time.Minute, 10)(ro.Just(1, 2, 1, 3, 2, 1)),
+	)
+	if err != nil || !reflect.DeepEqual(values, []int{1, 2, 3}) {
+		t.Fatalf("unexpected result: %v, %v", values, err)
+	}
+
+	// Keys are forgotten after the ttl.
+	values, err = ro.Collect(
+		DedupWithTTL(identity, 20*time.Millisecond, 10)(
+			ro.NewObservable(func(destination ro.Observer[int]) ro.Teardown {
+				destination.Next(1)
+				destination.Next(1)
+				time.Sleep(40 * time.Millisecond)
+				destination.Next(1)
+				destination.Complete()
+
+				return nil
+			}),
+		),
+	)
+	if err != nil || !reflect.DeepEqual(values, []int{1, 1}) {
+		t.Fatalf("unexpected result: %v, %v", values, err)
+	}
+
+	// The least recently seen keys are evicted when the cache is full.
+	values, err = ro.Collect(
+		DedupWithTTL(identity, time.Minute, 2)(ro.Just(1, 2, 3, 1, 3)),
+	)
+	if err != nil || !reflect.DeepEqual(values, []int{1, 2, 3, 1}) {
+		t.Fatalf("unexpected result: %v, %v", values, err)
+	}
+
+	values, err = ro.Collect(
+		DedupWithTTL(identity, time.Minute, 2)(ro.Throw[int](errors.New("boom"))),
+	)
+	if err == nil || err.Error() != "boom" || len(values) != 0 {
+		t.Fatalf("unexpected result: %v, %v", values, err)
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r != ErrDedupWithTTLWrongTTL {
+				t.Fatalf("unexpected panic: %v", r)
+			}
+		}()
+		DedupWithTTL(identity, 0, 2)
+	}()
+
+	func() {
+		defer func() {
+			if r := recover(); r != ErrDedupWithTTLWrongMaxKeys {
+				t.Fatalf("unexpected panic: %v", r)
+			}
+		}()
+		DedupWithTTL(identity, time.Minute, 0)
+	}()
+}
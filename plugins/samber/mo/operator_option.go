@@ -0,0 +1,52 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package romo
+
+import (
+	"context"
+
+	"github.com/samber/mo"
+	"github.com/samber/ro"
+)
+
+// FilterSome emits the values of the present options, and drops the absent ones.
+func FilterSome[T any]() func(ro.Observable[mo.Option[T]]) ro.Observable[T] {
+	return func(source ro.Observable[mo.Option[T]]) ro.Observable[T] {
+		return ro.NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[T]) ro.Teardown {
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				ro.NewObserverWithContext(
+					func(ctx context.Context, value mo.Option[T]) {
+						if v, ok := value.Get(); ok {
+							destination.NextWithContext(ctx, v)
+						}
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// MapToOption applies the project function to each item and emits the result
+// as an option: present when project returns true, absent otherwise.
+func MapToOption[T, R any](project func(item T) (R, bool)) func(ro.Observable[T]) ro.Observable[mo.Option[R]] {
+	return ro.Map(func(item T) mo.Option[R] {
+		return mo.TupleToOption(project(item))
+	})
+}
@@ -0,0 +1,35 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package romo
+
+import (
+	"github.com/samber/mo"
+	"github.com/samber/ro"
+)
+
+func ExampleFilterSome() {
+	observable := ro.Pipe1(
+		ro.Just(mo.Some(1), mo.None[int](), mo.Some(3)),
+		FilterSome[int](),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 1
+	// Next: 3
+	// Completed
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package romo
+
+import (
+	"testing"
+
+	"github.com/samber/mo"
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterSome(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just(mo.Some(1), mo.None[int](), mo.Some(3)),
+			FilterSome[int](),
+		),
+	)
+	is.Equal([]int{1, 3}, values)
+	is.NoError(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Just(mo.None[int]()),
+			FilterSome[int](),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Throw[mo.Option[int]](assert.AnError),
+			FilterSome[int](),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.ErrorIs(err, assert.AnError)
+}
+
+func TestMapToOption(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	users := map[int]string{1: "Alice", 3: "Charlie"}
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just(1, 2, 3),
+			MapToOption(func(id int) (string, bool) {
+				name, ok := users[id]
+				return name, ok
+			}),
+		),
+	)
+	is.Equal([]mo.Option[string]{mo.Some("Alice"), mo.None[string](), mo.Some("Charlie")}, values)
+	is.NoError(err)
+}
@@ -0,0 +1,157 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package romo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/samber/mo"
+	"github.com/samber/ro"
+)
+
+// MapToResult applies the project function to each item and emits the result
+// as a mo.Result. Unlike ro.MapErr, an error does not terminate the stream: it
+// is emitted as a failed result.
+func MapToResult[T, R any](project func(item T) (R, error)) func(ro.Observable[T]) ro.Observable[mo.Result[R]] {
+	return ro.Map(func(item T) mo.Result[R] {
+		return mo.TupleToResult(project(item))
+	})
+}
+
+// UnwrapResult emits the values of the successful results. The first failed
+// result is emitted as an error notification, and terminates the stream.
+func UnwrapResult[T any]() func(ro.Observable[mo.Result[T]]) ro.Observable[T] {
+	return ro.MapErr(func(item mo.Result[T]) (T, error) {
+		return item.Get()
+	})
+}
+
+// FilterOk emits the values of the successful results, and drops the failed ones.
+func FilterOk[T any]() func(ro.Observable[mo.Result[T]]) ro.Observable[T] {
+	return filterResult(func(ctx context.Context, destination ro.Observer[T], value mo.Result[T]) {
+		if v, err := value.Get(); err == nil {
+			destination.NextWithContext(ctx, v)
+		}
+	})
+}
+
+// FilterErr emits the errors of the failed results, and drops the successful ones.
+func FilterErr[T any]() func(ro.Observable[mo.Result[T]]) ro.Observable[error] {
+	return filterResult(func(ctx context.Context, destination ro.Observer[error], value mo.Result[T]) {
+		if err := value.Error(); err != nil {
+			destination.NextWithContext(ctx, err)
+		}
+	})
+}
+
+// SplitResult branches a stream of results into the values of the successful
+// results and the errors of the failed ones. See FilterOk and FilterErr.
+//
+// The source is subscribed once, when both branches have been subscribed, and
+// unsubscribed when both have been unsubscribed. Subscribe both branches before
+// waiting for either of them.
+func SplitResult[T any](source ro.Observable[mo.Result[T]]) (ro.Observable[T], ro.Observable[error]) {
+	shared := newSplitter(source, 2).observable()
+	return FilterOk[T]()(shared), FilterErr[T]()(shared)
+}
+
+// splitter multicasts a source to a fixed number of branches. The source is
+// subscribed when the last branch subscribes, and unsubscribed when the last
+// branch unsubscribes.
+type splitter[T any] struct {
+	source   ro.Observable[T]
+	branches int
+
+	mu         sync.Mutex
+	subject    ro.Subject[T]
+	subscribed int
+	connection ro.Subscription
+}
+
+func newSplitter[T any](source ro.Observable[T], branches int) *splitter[T] {
+	return &splitter[T]{
+		source:   source,
+		branches: branches,
+	}
+}
+
+func (s *splitter[T]) observable() ro.Observable[T] {
+	return ro.NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[T]) ro.Teardown {
+		s.mu.Lock()
+		if s.subject == nil {
+			s.subject = ro.NewPublishSubject[T]()
+		}
+		subject := s.subject
+		sub := subject.SubscribeWithContext(subscriberCtx, destination)
+		s.subscribed++
+		connect := s.subscribed == s.branches
+		s.mu.Unlock()
+
+		if connect {
+			// The lock is released: a synchronous source completes the
+			// branches, and runs their teardown, before returning.
+			connection := s.source.SubscribeWithContext(subscriberCtx, subject)
+
+			s.mu.Lock()
+			if s.subject == subject {
+				s.connection = connection
+				connection = nil
+			}
+			s.mu.Unlock()
+
+			if connection != nil {
+				connection.Unsubscribe()
+			}
+		}
+
+		return func() {
+			sub.Unsubscribe()
+
+			s.mu.Lock()
+			s.subscribed--
+			var connection ro.Subscription
+			if s.subscribed == 0 {
+				connection = s.connection
+				s.connection = nil
+				s.subject = nil
+			}
+			s.mu.Unlock()
+
+			if connection != nil {
+				connection.Unsubscribe()
+			}
+		}
+	})
+}
+
+func filterResult[T, R any](onNext func(ctx context.Context, destination ro.Observer[R], value mo.Result[T])) func(ro.Observable[mo.Result[T]]) ro.Observable[R] {
+	return func(source ro.Observable[mo.Result[T]]) ro.Observable[R] {
+		return ro.NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[R]) ro.Teardown {
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				ro.NewObserverWithContext(
+					func(ctx context.Context, value mo.Result[T]) {
+						onNext(ctx, destination, value)
+					},
+					destination.ErrorWithContext,
+					destination.CompleteWithContext,
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package romo
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/samber/ro"
+)
+
+func ExampleMapToResult() {
+	observable := ro.Pipe2(
+		ro.Just("1", "two", "3"),
+		MapToResult(strconv.Atoi),
+		FilterOk[int](),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 1
+	// Next: 3
+	// Completed
+}
+
+func ExampleSplitResult() {
+	oks, errs := SplitResult(
+		ro.Pipe1(
+			ro.Just("1", "two", "3"),
+			MapToResult(strconv.Atoi),
+		),
+	)
+
+	sub := errs.Subscribe(ro.OnNext(func(err error) {
+		fmt.Println(err)
+	}))
+	defer sub.Unsubscribe()
+
+	values, _ := ro.Collect(oks)
+
+	fmt.Println(values)
+	// Output:
+	// strconv.Atoi: parsing "two": invalid syntax
+	// [1 3]
+}
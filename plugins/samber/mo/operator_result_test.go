@@ -0,0 +1,184 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package romo
+
+import (
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/samber/mo"
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapToResult(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just("1", "two", "3"),
+			MapToResult(strconv.Atoi),
+		),
+	)
+	is.NoError(err)
+	is.Len(values, 3)
+	is.Equal(mo.Ok(1), values[0])
+	is.True(values[1].IsError())
+	is.Equal(mo.Ok(3), values[2])
+}
+
+func TestUnwrapResult(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just(mo.Ok(1), mo.Ok(2)),
+			UnwrapResult[int](),
+		),
+	)
+	is.Equal([]int{1, 2}, values)
+	is.NoError(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Just(mo.Ok(1), mo.Err[int](assert.AnError), mo.Ok(3)),
+			UnwrapResult[int](),
+		),
+	)
+	is.Equal([]int{1}, values)
+	is.ErrorIs(err, assert.AnError)
+}
+
+func TestFilterOk(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just(mo.Ok(1), mo.Err[int](assert.AnError), mo.Ok(3)),
+			FilterOk[int](),
+		),
+	)
+	is.Equal([]int{1, 3}, values)
+	is.NoError(err)
+}
+
+func TestFilterErr(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just(mo.Ok(1), mo.Err[int](assert.AnError), mo.Ok(3)),
+			FilterErr[int](),
+		),
+	)
+	is.Equal([]error{assert.AnError}, values)
+	is.NoError(err)
+}
+
+func TestSplitResult(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	err1 := errors.New("error 1")
+	err2 := errors.New("error 2")
+
+	oks, errs := SplitResult(ro.Just(mo.Ok(1), mo.Err[int](err1), mo.Ok(3), mo.Err[int](err2)))
+
+	var failures []error
+	sub := errs.Subscribe(ro.OnNext(func(err error) {
+		failures = append(failures, err)
+	}))
+	defer sub.Unsubscribe()
+
+	values, err := ro.Collect(oks)
+	is.Equal([]int{1, 3}, values)
+	is.NoError(err)
+	is.Equal([]error{err1, err2}, failures)
+	is.True(sub.IsClosed())
+}
+
+func TestSplitResult_subscribesOnce(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var subscriptions int32
+	source := ro.Defer(func() ro.Observable[mo.Result[int]] {
+		atomic.AddInt32(&subscriptions, 1)
+		return ro.Just(mo.Ok(1), mo.Err[int](assert.AnError), mo.Ok(3))
+	})
+
+	oks, errs := SplitResult(source)
+
+	var values []int
+	var failures []error
+	sub1 := oks.Subscribe(ro.OnNext(func(value int) {
+		values = append(values, value)
+	}))
+	is.Equal(int32(0), atomic.LoadInt32(&subscriptions))
+
+	sub2 := errs.Subscribe(ro.OnNext(func(err error) {
+		failures = append(failures, err)
+	}))
+	is.Equal(int32(1), atomic.LoadInt32(&subscriptions))
+	is.Equal([]int{1, 3}, values)
+	is.Equal([]error{assert.AnError}, failures)
+	is.True(sub1.IsClosed())
+	is.True(sub2.IsClosed())
+
+	// Once both branches are done, a new pair of subscriptions runs the source again.
+	values, failures = nil, nil
+	sub1 = oks.Subscribe(ro.OnNext(func(value int) {
+		values = append(values, value)
+	}))
+	sub2 = errs.Subscribe(ro.OnNext(func(err error) {
+		failures = append(failures, err)
+	}))
+	is.Equal(int32(2), atomic.LoadInt32(&subscriptions))
+	is.Equal([]int{1, 3}, values)
+	is.Equal([]error{assert.AnError}, failures)
+}
+
+func TestSplitResult_unsubscribe(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	source := ro.NewPublishSubject[mo.Result[int]]()
+	oks, errs := SplitResult[int](source)
+
+	var values []int
+	sub1 := oks.Subscribe(ro.OnNext(func(value int) {
+		values = append(values, value)
+	}))
+	source.Next(mo.Ok(1))
+	is.Equal(0, source.CountObservers())
+
+	sub2 := errs.Subscribe(ro.NoopObserver[error]())
+	is.Equal(1, source.CountObservers())
+
+	source.Next(mo.Ok(2))
+	sub1.Unsubscribe()
+	is.Equal(1, source.CountObservers())
+
+	source.Next(mo.Ok(3))
+	sub2.Unsubscribe()
+	is.Equal(0, source.CountObservers())
+	is.Equal([]int{2}, values)
+}
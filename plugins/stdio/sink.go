@@ -18,6 +18,8 @@ import (
 	"context"
 	"io"
 	"os"
+	"path/filepath"
+	"sync"
 
 	"github.com/samber/ro"
 )
@@ -93,3 +95,276 @@ func NewStdWriter() func(ro.Observable[[]byte]) ro.Observable[int] {
 		})
 	}
 }
+
+// NewAtomicFileWriter creates a sink that writes byte slices to the file at
+// path and emits the total bytes written, without ever leaving a partially
+// written file behind.
+//
+// The data is written to a temporary file of the same directory. On
+// completion, the temporary file is synced, closed and renamed to path, so
+// that path holds either its previous content or the whole stream. On error or
+// unsubscription, the temporary file is removed and path is left untouched.
+//
+// The file gets permissions perm, as is: unlike os.WriteFile, the umask is not
+// applied.
+func NewAtomicFileWriter(path string, perm os.FileMode) func(ro.Observable[[]byte]) ro.Observable[int] {
+	return func(source ro.Observable[[]byte]) ro.Observable[int] {
+		return ro.NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[int]) ro.Teardown {
+			writer, err := newAtomicFile(path, perm)
+			if err != nil {
+				destination.ErrorWithContext(subscriberCtx, err)
+				return nil
+			}
+
+			count := 0
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				ro.NewObserverWithContext(
+					func(ctx context.Context, value []byte) {
+						n, err := writer.write(value)
+						count += n
+
+						if err != nil {
+							writer.abort()
+							destination.NextWithContext(ctx, count)
+							destination.ErrorWithContext(ctx, err)
+						}
+					},
+					func(ctx context.Context, err error) {
+						writer.abort()
+						destination.NextWithContext(ctx, count)
+						destination.ErrorWithContext(ctx, err)
+					},
+					func(ctx context.Context) {
+						if err := writer.commit(); err != nil {
+							destination.NextWithContext(ctx, count)
+							destination.ErrorWithContext(ctx, err)
+							return
+						}
+
+						destination.NextWithContext(ctx, count)
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return func() {
+				sub.Unsubscribe()
+				writer.abort()
+			}
+		})
+	}
+}
+
+// atomicFile is a temporary file renamed to its final path on commit, and
+// removed on abort. Once committed or aborted, other calls are no-op.
+type atomicFile struct {
+	path string
+
+	mu   sync.Mutex
+	file *os.File // nil once committed or aborted
+}
+
+func newAtomicFile(path string, perm os.FileMode) (*atomicFile, error) {
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return nil, err
+	}
+
+	// os.CreateTemp creates the file with 0600 permissions.
+	if err := file.Chmod(perm); err != nil {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+
+		return nil, err
+	}
+
+	return &atomicFile{
+		path: path,
+		file: file,
+	}, nil
+}
+
+func (f *atomicFile) write(value []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return 0, os.ErrClosed
+	}
+
+	return f.file.Write(value)
+}
+
+func (f *atomicFile) commit() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return os.ErrClosed
+	}
+
+	file := f.file
+	f.file = nil
+
+	err := file.Sync()
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(file.Name(), f.path)
+	}
+
+	if err != nil {
+		_ = os.Remove(file.Name())
+		return err
+	}
+
+	// Persist the rename. Syncing a directory is not supported on every
+	// platform, hence the error is ignored.
+	if dir, err := os.Open(filepath.Dir(f.path)); err == nil {
+		_ = dir.Sync()
+		_ = dir.Close()
+	}
+
+	return nil
+}
+
+func (f *atomicFile) abort() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return
+	}
+
+	_ = f.file.Close()
+	_ = os.Remove(f.file.Name())
+	f.file = nil
+}
+
+var _ io.ReadCloser = (*ObservableReader)(nil)
+
+// ObservableReader is an io.ReadCloser consuming an Observable of byte slices.
+// See NewReader.
+type ObservableReader struct {
+	source ro.Observable[[]byte]
+
+	subscribeOnce sync.Once
+	closeOnce     sync.Once
+	ctx           context.Context
+	cancel        context.CancelFunc
+
+	chunks chan []byte
+	done   chan struct{} // closed after err is set
+	err    error
+	buf    []byte
+
+	mu     sync.Mutex
+	sub    ro.Subscription
+	closed bool
+}
+
+// NewReader creates an io.Reader reading the byte slices emitted by an
+// Observable, so that a pipeline can feed any API expecting an io.Reader
+// (gzip, multipart upload, json.Decoder...).
+//
+// The Observable is subscribed on the first call to Read. Read blocks until
+// data arrives, returns io.EOF when the Observable completes and the error
+// emitted by the Observable otherwise. Close unsubscribes from the Observable.
+//
+// Each chunk is held until fully read, so the Observable is back-pressured by
+// the consumer. Like most readers, an ObservableReader must not be read
+// concurrently.
+func NewReader(source ro.Observable[[]byte]) *ObservableReader {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &ObservableReader{
+		source: source,
+		ctx:    ctx,
+		cancel: cancel,
+		chunks: make(chan []byte),
+		done:   make(chan struct{}),
+	}
+}
+
+// Read implements io.Reader.
+func (r *ObservableReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	r.subscribeOnce.Do(r.subscribe)
+
+	for len(r.buf) == 0 {
+		select {
+		case chunk := <-r.chunks:
+			r.buf = chunk
+		case <-r.done:
+			return 0, r.err
+		case <-r.ctx.Done():
+			return 0, io.ErrClosedPipe
+		}
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+
+	return n, nil
+}
+
+// Close implements io.Closer. It unsubscribes from the Observable. Read
+// returns io.ErrClosedPipe once closed.
+func (r *ObservableReader) Close() error {
+	r.closeOnce.Do(func() {
+		r.cancel()
+
+		r.mu.Lock()
+		r.closed = true
+		sub := r.sub
+		r.mu.Unlock()
+
+		if sub != nil {
+			sub.Unsubscribe()
+		}
+	})
+
+	return nil
+}
+
+func (r *ObservableReader) subscribe() {
+	// The Observable may emit synchronously: subscribe in a goroutine so
+	// that Read can receive the chunks.
+	go func() {
+		sub := r.source.SubscribeWithContext(
+			r.ctx,
+			ro.NewObserverWithContext(
+				func(ctx context.Context, value []byte) {
+					select {
+					case r.chunks <- value:
+					case <-r.ctx.Done():
+					}
+				},
+				func(ctx context.Context, err error) {
+					r.err = err
+					close(r.done)
+				},
+				func(ctx context.Context) {
+					r.err = io.EOF
+					close(r.done)
+				},
+			),
+		)
+
+		r.mu.Lock()
+		closed := r.closed
+		r.sub = sub
+		r.mu.Unlock()
+
+		if closed {
+			sub.Unsubscribe()
+		}
+	}()
+}
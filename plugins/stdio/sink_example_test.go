@@ -16,6 +16,8 @@ package rostdio
 
 import (
 	"bytes"
+	"fmt"
+	"io"
 
 	"github.com/samber/ro"
 )
@@ -88,3 +90,22 @@ func ExampleNewIOWriter_withError() {
 	// Next: 13
 	// Completed
 }
+
+func ExampleNewReader() {
+	reader := NewReader(
+		ro.Just(
+			[]byte("Hello, "),
+			[]byte("World!"),
+		),
+	)
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+
+	fmt.Printf("Data: %s\n", data)
+	fmt.Printf("Error: %v\n", err)
+
+	// Output:
+	// Data: Hello, World!
+	// Error: <nil>
+}
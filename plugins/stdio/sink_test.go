@@ -18,7 +18,11 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"io"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -299,3 +303,205 @@ type errorWriter struct {
 func (w *errorWriter) Write(p []byte) (n int, err error) {
 	return 0, w.err
 }
+
+func TestNewAtomicFileWriter(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "out.txt")
+
+	values, err := ro.Collect(
+		NewAtomicFileWriter(path, 0o644)(ro.Just([]byte("Hello, "), []byte("World!"))),
+	)
+	is.Equal([]int{13}, values)
+	is.Nil(err)
+
+	content, err := os.ReadFile(path)
+	is.Nil(err)
+	is.Equal("Hello, World!", string(content))
+
+	info, err := os.Stat(path)
+	is.Nil(err)
+	is.Equal(os.FileMode(0o644), info.Mode().Perm())
+
+	entries, err := os.ReadDir(dir)
+	is.Nil(err)
+	is.Len(entries, 1)
+}
+
+func TestNewAtomicFileWriter_Error(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "out.txt")
+	is.Nil(os.WriteFile(path, []byte("previous"), 0o600))
+
+	values, err := ro.Collect(
+		NewAtomicFileWriter(path, 0o600)(ro.Concat(
+			ro.Just([]byte("partial")),
+			ro.Throw[[]byte](assert.AnError),
+		)),
+	)
+	is.Equal([]int{7}, values)
+	is.Equal(assert.AnError, err)
+
+	// The previous content is kept and the temporary file is removed.
+	content, err := os.ReadFile(path)
+	is.Nil(err)
+	is.Equal("previous", string(content))
+
+	entries, err := os.ReadDir(dir)
+	is.Nil(err)
+	is.Len(entries, 1)
+}
+
+func TestNewAtomicFileWriter_Unsubscribe(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "out.txt")
+
+	subject := ro.NewPublishSubject[[]byte]()
+	sub := NewAtomicFileWriter(path, 0o600)(subject).Subscribe(ro.NoopObserver[int]())
+
+	subject.Next([]byte("partial"))
+
+	entries, err := os.ReadDir(dir)
+	is.Nil(err)
+	is.Len(entries, 1)
+	is.NotEqual("out.txt", entries[0].Name())
+
+	sub.Unsubscribe()
+
+	entries, err = os.ReadDir(dir)
+	is.Nil(err)
+	is.Empty(entries)
+}
+
+func TestNewAtomicFileWriter_MissingDirectory(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	path := filepath.Join(t.TempDir(), "missing", "out.txt")
+
+	values, err := ro.Collect(
+		NewAtomicFileWriter(path, 0o600)(ro.Just([]byte("Hello"))),
+	)
+	is.Empty(values)
+	is.ErrorIs(err, os.ErrNotExist)
+}
+
+func TestNewReader(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	reader := NewReader(ro.Just([]byte("Hello "), []byte{}, []byte("World!")))
+
+	data, err := io.ReadAll(reader)
+	is.Equal("Hello World!", string(data))
+	is.Nil(err)
+
+	n, err := reader.Read(make([]byte, 8))
+	is.Equal(0, n)
+	is.Equal(io.EOF, err)
+}
+
+func TestNewReader_SmallBuffer(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	reader := NewReader(ro.Just([]byte("Hello")))
+	buf := make([]byte, 2)
+
+	n, err := reader.Read(buf)
+	is.Equal(2, n)
+	is.Equal("He", string(buf[:n]))
+	is.Nil(err)
+
+	n, err = reader.Read(buf)
+	is.Equal(2, n)
+	is.Equal("ll", string(buf[:n]))
+	is.Nil(err)
+
+	n, err = reader.Read(buf)
+	is.Equal(1, n)
+	is.Equal("o", string(buf[:n]))
+	is.Nil(err)
+
+	n, err = reader.Read(buf)
+	is.Equal(0, n)
+	is.Equal(io.EOF, err)
+}
+
+func TestNewReader_Error(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	reader := NewReader(
+		ro.Concat(
+			ro.Just([]byte("Hello")),
+			ro.Throw[[]byte](assert.AnError),
+		),
+	)
+
+	data, err := io.ReadAll(reader)
+	is.Equal("Hello", string(data))
+	is.Equal(assert.AnError, err)
+}
+
+func TestNewReader_Async(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	reader := NewReader(
+		ro.Pipe2(
+			ro.Interval(5*time.Millisecond),
+			ro.Take[int64](3),
+			ro.Map(func(i int64) []byte {
+				return []byte{byte('a' + i)}
+			}),
+		),
+	)
+
+	data, err := io.ReadAll(reader)
+	is.Equal("abc", string(data))
+	is.Nil(err)
+}
+
+func TestNewReader_Close(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var unsubscribed int32
+
+	subject := ro.NewPublishSubject[[]byte]()
+	reader := NewReader(
+		ro.Pipe1(
+			subject.AsObservable(),
+			ro.DoOnFinalize[[]byte](func() {
+				atomic.StoreInt32(&unsubscribed, 1)
+			}),
+		),
+	)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		subject.Next([]byte("Hello"))
+	}()
+
+	buf := make([]byte, 8)
+
+	n, err := reader.Read(buf)
+	is.Equal("Hello", string(buf[:n]))
+	is.Nil(err)
+
+	is.Nil(reader.Close())
+	is.Equal(int32(1), atomic.LoadInt32(&unsubscribed))
+
+	n, err = reader.Read(buf)
+	is.Equal(0, n)
+	is.Equal(io.ErrClosedPipe, err)
+}
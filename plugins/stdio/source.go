@@ -16,6 +16,7 @@ package rostdio
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"io"
 	"os"
@@ -82,6 +83,72 @@ func NewIOReaderLine(reader io.Reader) ro.Observable[[]byte] {
 	})
 }
 
+// Line is a line read by NewIOReaderLineWithCheckpoint.
+type Line struct {
+	// Bytes is the content of the line, without the trailing "\n" or "\r\n".
+	Bytes []byte
+	// Offset is the position following the line, in bytes.
+	Offset int64
+}
+
+// NewIOReaderLineWithCheckpoint creates an observable that reads lines from an
+// io.ReadSeeker, such as an *os.File, resuming after the position saved to
+// checkpointer under key. Use CheckpointLine at the end of the pipeline to
+// save the position of the processed lines: the lines are then delivered at
+// least once across restarts.
+func NewIOReaderLineWithCheckpoint(reader io.ReadSeeker, checkpointer ro.Checkpointer, key string) ro.Observable[Line] {
+	return ro.NewUnsafeObservableWithContext(func(ctx context.Context, destination ro.Observer[Line]) ro.Teardown {
+		teardown := func() {
+			if closer, ok := reader.(io.Closer); ok {
+				closer.Close()
+			}
+		}
+
+		offset, _, err := checkpointer.Load(ctx, key)
+		if err == nil {
+			_, err = reader.Seek(offset, io.SeekStart)
+		}
+
+		if err != nil {
+			destination.ErrorWithContext(ctx, err)
+			return teardown
+		}
+
+		r := bufio.NewReader(reader)
+
+		for {
+			line, err := r.ReadBytes('\n')
+			if len(line) > 0 {
+				offset += int64(len(line))
+
+				destination.NextWithContext(ctx, Line{
+					Bytes:  bytes.TrimSuffix(bytes.TrimSuffix(line, []byte("\n")), []byte("\r")),
+					Offset: offset,
+				})
+			}
+
+			if err != nil {
+				if err == io.EOF {
+					destination.CompleteWithContext(ctx)
+				} else {
+					destination.ErrorWithContext(ctx, err)
+				}
+
+				return teardown
+			}
+		}
+	})
+}
+
+// CheckpointLine saves the position of the lines emitted by
+// NewIOReaderLineWithCheckpoint, once processed. Place it last, right before
+// the sink. See ro.Checkpoint.
+func CheckpointLine(checkpointer ro.Checkpointer, key string) func(ro.Observable[Line]) ro.Observable[Line] {
+	return ro.Checkpoint(checkpointer, key, func(line Line) int64 {
+		return line.Offset
+	})
+}
+
 // NewStdReader creates an observable that reads bytes from standard input.
 func NewStdReader() ro.Observable[[]byte] {
 	return NewIOReader(os.Stdin)
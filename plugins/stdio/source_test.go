@@ -15,6 +15,7 @@
 package rostdio
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"testing"
@@ -129,3 +130,49 @@ func TestNewIOReader_Closer(t *testing.T) {
 
 	is.True(reader.closed)
 }
+
+func TestNewIOReaderLineWithCheckpoint(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	checkpointer := ro.NewMemoryCheckpointer()
+	content := "line1\nline2\r\nline3\nline4"
+
+	read := func(count int64) []string {
+		values, err := ro.Collect(
+			ro.Pipe3(
+				NewIOReaderLineWithCheckpoint(strings.NewReader(content), checkpointer, "file"),
+				ro.Take[Line](count),
+				CheckpointLine(checkpointer, "file"),
+				ro.Map(func(line Line) string { return string(line.Bytes) }),
+			),
+		)
+		is.NoError(err)
+
+		return values
+	}
+
+	is.Equal([]string{"line1", "line2"}, read(2))
+
+	offset, ok, err := checkpointer.Load(context.Background(), "file")
+	is.NoError(err)
+	is.True(ok)
+	is.EqualValues(13, offset)
+
+	// restart
+	is.Equal([]string{"line3", "line4"}, read(10))
+	is.Empty(read(10))
+
+	// load error
+	values, err := ro.Collect(NewIOReaderLineWithCheckpoint(strings.NewReader(content), failingCheckpointer{}, "file"))
+	is.EqualError(err, "mock error")
+	is.Empty(values)
+}
+
+type failingCheckpointer struct {
+	ro.Checkpointer
+}
+
+func (failingCheckpointer) Load(context.Context, string) (int64, bool, error) {
+	return 0, false, errors.New("mock error")
+}
@@ -15,10 +15,12 @@
 package rostrings
 
 type caseTests struct {
-	PascalCase string
-	CamelCase  string
-	KebabCase  string
-	SnakeCase  string
+	PascalCase         string
+	CamelCase          string
+	KebabCase          string
+	SnakeCase          string
+	TitleCase          string
+	ScreamingSnakeCase string
 }
 
 var allCaseTests = []struct {
@@ -35,402 +37,502 @@ var allCaseTests = []struct {
 	{
 		input: "Hello world!",
 		output: caseTests{
-			PascalCase: "HelloWorld",
-			CamelCase:  "helloWorld",
-			KebabCase:  "hello-world",
-			SnakeCase:  "hello_world",
+			PascalCase:         "HelloWorld",
+			CamelCase:          "helloWorld",
+			KebabCase:          "hello-world",
+			SnakeCase:          "hello_world",
+			TitleCase:          "Hello World",
+			ScreamingSnakeCase: "HELLO_WORLD",
 		}},
 	{
 		input: "A",
 		output: caseTests{
-			PascalCase: "A",
-			CamelCase:  "a",
-			KebabCase:  "a",
-			SnakeCase:  "a",
+			PascalCase:         "A",
+			CamelCase:          "a",
+			KebabCase:          "a",
+			SnakeCase:          "a",
+			TitleCase:          "A",
+			ScreamingSnakeCase: "A",
 		}},
 	{
 		input: "a",
 		output: caseTests{
-			PascalCase: "A",
-			CamelCase:  "a",
-			KebabCase:  "a",
-			SnakeCase:  "a",
+			PascalCase:         "A",
+			CamelCase:          "a",
+			KebabCase:          "a",
+			SnakeCase:          "a",
+			TitleCase:          "A",
+			ScreamingSnakeCase: "A",
 		}},
 	{
 		input: "foo",
 		output: caseTests{
-			PascalCase: "Foo",
-			CamelCase:  "foo",
-			KebabCase:  "foo",
-			SnakeCase:  "foo",
+			PascalCase:         "Foo",
+			CamelCase:          "foo",
+			KebabCase:          "foo",
+			SnakeCase:          "foo",
+			TitleCase:          "Foo",
+			ScreamingSnakeCase: "FOO",
 		}},
 	{
 		input: "snake_case",
 		output: caseTests{
-			PascalCase: "SnakeCase",
-			CamelCase:  "snakeCase",
-			KebabCase:  "snake-case",
-			SnakeCase:  "snake_case",
+			PascalCase:         "SnakeCase",
+			CamelCase:          "snakeCase",
+			KebabCase:          "snake-case",
+			SnakeCase:          "snake_case",
+			TitleCase:          "Snake Case",
+			ScreamingSnakeCase: "SNAKE_CASE",
 		}},
 	{
 		input: "SNAKE_CASE",
 		output: caseTests{
-			PascalCase: "SnakeCase",
-			CamelCase:  "snakeCase",
-			KebabCase:  "snake-case",
-			SnakeCase:  "snake_case",
+			PascalCase:         "SnakeCase",
+			CamelCase:          "snakeCase",
+			KebabCase:          "snake-case",
+			SnakeCase:          "snake_case",
+			TitleCase:          "Snake Case",
+			ScreamingSnakeCase: "SNAKE_CASE",
 		}},
 	{
 		input: "kebab-case",
 		output: caseTests{
-			PascalCase: "KebabCase",
-			CamelCase:  "kebabCase",
-			KebabCase:  "kebab-case",
-			SnakeCase:  "kebab_case",
+			PascalCase:         "KebabCase",
+			CamelCase:          "kebabCase",
+			KebabCase:          "kebab-case",
+			SnakeCase:          "kebab_case",
+			TitleCase:          "Kebab Case",
+			ScreamingSnakeCase: "KEBAB_CASE",
 		}},
 	{
 		input: "PascalCase",
 		output: caseTests{
-			PascalCase: "PascalCase",
-			CamelCase:  "pascalCase",
-			KebabCase:  "pascal-case",
-			SnakeCase:  "pascal_case",
+			PascalCase:         "PascalCase",
+			CamelCase:          "pascalCase",
+			KebabCase:          "pascal-case",
+			SnakeCase:          "pascal_case",
+			TitleCase:          "Pascal Case",
+			ScreamingSnakeCase: "PASCAL_CASE",
 		}},
 	{
 		input: "camelCase",
 		output: caseTests{
-			PascalCase: "CamelCase",
-			CamelCase:  "camelCase",
-			KebabCase:  `camel-case`,
-			SnakeCase:  "camel_case",
+			PascalCase:         "CamelCase",
+			CamelCase:          "camelCase",
+			KebabCase:          `camel-case`,
+			SnakeCase:          "camel_case",
+			TitleCase:          "Camel Case",
+			ScreamingSnakeCase: "CAMEL_CASE",
 		}},
 	{
 		input: "Title Case",
 		output: caseTests{
-			PascalCase: "TitleCase",
-			CamelCase:  "titleCase",
-			KebabCase:  "title-case",
-			SnakeCase:  "title_case",
+			PascalCase:         "TitleCase",
+			CamelCase:          "titleCase",
+			KebabCase:          "title-case",
+			SnakeCase:          "title_case",
+			TitleCase:          "Title Case",
+			ScreamingSnakeCase: "TITLE_CASE",
 		}},
 	{
 		input: "point.case",
 		output: caseTests{
-			PascalCase: "PointCase",
-			CamelCase:  "pointCase",
-			KebabCase:  "point-case",
-			SnakeCase:  "point_case",
+			PascalCase:         "PointCase",
+			CamelCase:          "pointCase",
+			KebabCase:          "point-case",
+			SnakeCase:          "point_case",
+			TitleCase:          "Point Case",
+			ScreamingSnakeCase: "POINT_CASE",
 		}},
 	{
 		input: "snake_case_with_more_words",
 		output: caseTests{
-			PascalCase: "SnakeCaseWithMoreWords",
-			CamelCase:  "snakeCaseWithMoreWords",
-			KebabCase:  "snake-case-with-more-words",
-			SnakeCase:  "snake_case_with_more_words",
+			PascalCase:         "SnakeCaseWithMoreWords",
+			CamelCase:          "snakeCaseWithMoreWords",
+			KebabCase:          "snake-case-with-more-words",
+			SnakeCase:          "snake_case_with_more_words",
+			TitleCase:          "Snake Case With More Words",
+			ScreamingSnakeCase: "SNAKE_CASE_WITH_MORE_WORDS",
 		}},
 	{
 		input: "SNAKE_CASE_WITH_MORE_WORDS",
 		output: caseTests{
-			PascalCase: "SnakeCaseWithMoreWords",
-			CamelCase:  "snakeCaseWithMoreWords",
-			KebabCase:  "snake-case-with-more-words",
-			SnakeCase:  "snake_case_with_more_words",
+			PascalCase:         "SnakeCaseWithMoreWords",
+			CamelCase:          "snakeCaseWithMoreWords",
+			KebabCase:          "snake-case-with-more-words",
+			SnakeCase:          "snake_case_with_more_words",
+			TitleCase:          "Snake Case With More Words",
+			ScreamingSnakeCase: "SNAKE_CASE_WITH_MORE_WORDS",
 		}},
 	{
 		input: "kebab-case-with-more-words",
 		output: caseTests{
-			PascalCase: "KebabCaseWithMoreWords",
-			CamelCase:  "kebabCaseWithMoreWords",
-			KebabCase:  "kebab-case-with-more-words",
-			SnakeCase:  "kebab_case_with_more_words",
+			PascalCase:         "KebabCaseWithMoreWords",
+			CamelCase:          "kebabCaseWithMoreWords",
+			KebabCase:          "kebab-case-with-more-words",
+			SnakeCase:          "kebab_case_with_more_words",
+			TitleCase:          "Kebab Case With More Words",
+			ScreamingSnakeCase: "KEBAB_CASE_WITH_MORE_WORDS",
 		}},
 	{
 		input: "PascalCaseWithMoreWords",
 		output: caseTests{
-			PascalCase: "PascalCaseWithMoreWords",
-			CamelCase:  "pascalCaseWithMoreWords",
-			KebabCase:  "pascal-case-with-more-words",
-			SnakeCase:  "pascal_case_with_more_words",
+			PascalCase:         "PascalCaseWithMoreWords",
+			CamelCase:          "pascalCaseWithMoreWords",
+			KebabCase:          "pascal-case-with-more-words",
+			SnakeCase:          "pascal_case_with_more_words",
+			TitleCase:          "Pascal Case With More Words",
+			ScreamingSnakeCase: "PASCAL_CASE_WITH_MORE_WORDS",
 		}},
 	{
 		input: "camelCaseWithMoreWords",
 		output: caseTests{
-			PascalCase: "CamelCaseWithMoreWords",
-			CamelCase:  "camelCaseWithMoreWords",
-			KebabCase:  "camel-case-with-more-words",
-			SnakeCase:  "camel_case_with_more_words",
+			PascalCase:         "CamelCaseWithMoreWords",
+			CamelCase:          "camelCaseWithMoreWords",
+			KebabCase:          "camel-case-with-more-words",
+			SnakeCase:          "camel_case_with_more_words",
+			TitleCase:          "Camel Case With More Words",
+			ScreamingSnakeCase: "CAMEL_CASE_WITH_MORE_WORDS",
 		}},
 	{
 		input: "Title Case With More Words",
 		output: caseTests{
-			PascalCase: "TitleCaseWithMoreWords",
-			CamelCase:  "titleCaseWithMoreWords",
-			KebabCase:  "title-case-with-more-words",
-			SnakeCase:  "title_case_with_more_words",
+			PascalCase:         "TitleCaseWithMoreWords",
+			CamelCase:          "titleCaseWithMoreWords",
+			KebabCase:          "title-case-with-more-words",
+			SnakeCase:          "title_case_with_more_words",
+			TitleCase:          "Title Case With More Words",
+			ScreamingSnakeCase: "TITLE_CASE_WITH_MORE_WORDS",
 		}},
 	{
 		input: "point.case.with.more.words",
 		output: caseTests{
-			PascalCase: "PointCaseWithMoreWords",
-			CamelCase:  "pointCaseWithMoreWords",
-			KebabCase:  "point-case-with-more-words",
-			SnakeCase:  "point_case_with_more_words",
+			PascalCase:         "PointCaseWithMoreWords",
+			CamelCase:          "pointCaseWithMoreWords",
+			KebabCase:          "point-case-with-more-words",
+			SnakeCase:          "point_case_with_more_words",
+			TitleCase:          "Point Case With More Words",
+			ScreamingSnakeCase: "POINT_CASE_WITH_MORE_WORDS",
 		}},
 	{
 		input: "snake_case__with___multiple____delimiters",
 		output: caseTests{
-			PascalCase: "SnakeCaseWithMultipleDelimiters",
-			CamelCase:  "snakeCaseWithMultipleDelimiters",
-			KebabCase:  "snake-case-with-multiple-delimiters",
-			SnakeCase:  "snake_case_with_multiple_delimiters",
+			PascalCase:         "SnakeCaseWithMultipleDelimiters",
+			CamelCase:          "snakeCaseWithMultipleDelimiters",
+			KebabCase:          "snake-case-with-multiple-delimiters",
+			SnakeCase:          "snake_case_with_multiple_delimiters",
+			TitleCase:          "Snake Case With Multiple Delimiters",
+			ScreamingSnakeCase: "SNAKE_CASE_WITH_MULTIPLE_DELIMITERS",
 		}},
 	{
 		input: "SNAKE_CASE__WITH___multiple____DELIMITERS",
 		output: caseTests{
-			PascalCase: "SnakeCaseWithMultipleDelimiters",
-			CamelCase:  "snakeCaseWithMultipleDelimiters",
-			KebabCase:  "snake-case-with-multiple-delimiters",
-			SnakeCase:  "snake_case_with_multiple_delimiters",
+			PascalCase:         "SnakeCaseWithMultipleDelimiters",
+			CamelCase:          "snakeCaseWithMultipleDelimiters",
+			KebabCase:          "snake-case-with-multiple-delimiters",
+			SnakeCase:          "snake_case_with_multiple_delimiters",
+			TitleCase:          "Snake Case With Multiple Delimiters",
+			ScreamingSnakeCase: "SNAKE_CASE_WITH_MULTIPLE_DELIMITERS",
 		}},
 	{
 		input: "kebab-case--with---multiple----delimiters",
 		output: caseTests{
-			PascalCase: "KebabCaseWithMultipleDelimiters",
-			CamelCase:  "kebabCaseWithMultipleDelimiters",
-			KebabCase:  "kebab-case-with-multiple-delimiters",
-			SnakeCase:  "kebab_case_with_multiple_delimiters",
+			PascalCase:         "KebabCaseWithMultipleDelimiters",
+			CamelCase:          "kebabCaseWithMultipleDelimiters",
+			KebabCase:          "kebab-case-with-multiple-delimiters",
+			SnakeCase:          "kebab_case_with_multiple_delimiters",
+			TitleCase:          "Kebab Case With Multiple Delimiters",
+			ScreamingSnakeCase: "KEBAB_CASE_WITH_MULTIPLE_DELIMITERS",
 		}},
 	{
 		input: "Title Case  With   Multiple    Delimiters",
 		output: caseTests{
-			PascalCase: "TitleCaseWithMultipleDelimiters",
-			CamelCase:  "titleCaseWithMultipleDelimiters",
-			KebabCase:  "title-case-with-multiple-delimiters",
-			SnakeCase:  "title_case_with_multiple_delimiters",
+			PascalCase:         "TitleCaseWithMultipleDelimiters",
+			CamelCase:          "titleCaseWithMultipleDelimiters",
+			KebabCase:          "title-case-with-multiple-delimiters",
+			SnakeCase:          "title_case_with_multiple_delimiters",
+			TitleCase:          "Title Case With Multiple Delimiters",
+			ScreamingSnakeCase: "TITLE_CASE_WITH_MULTIPLE_DELIMITERS",
 		}},
 	{
 		input: "point.case..with...multiple....delimiters",
 		output: caseTests{
-			PascalCase: "PointCaseWithMultipleDelimiters",
-			CamelCase:  "pointCaseWithMultipleDelimiters",
-			KebabCase:  "point-case-with-multiple-delimiters",
-			SnakeCase:  "point_case_with_multiple_delimiters",
+			PascalCase:         "PointCaseWithMultipleDelimiters",
+			CamelCase:          "pointCaseWithMultipleDelimiters",
+			KebabCase:          "point-case-with-multiple-delimiters",
+			SnakeCase:          "point_case_with_multiple_delimiters",
+			TitleCase:          "Point Case With Multiple Delimiters",
+			ScreamingSnakeCase: "POINT_CASE_WITH_MULTIPLE_DELIMITERS",
 		}},
 	{
 		input: " leading space",
 		output: caseTests{
-			PascalCase: "LeadingSpace",
-			CamelCase:  "leadingSpace",
-			KebabCase:  "leading-space",
-			SnakeCase:  "leading_space",
+			PascalCase:         "LeadingSpace",
+			CamelCase:          "leadingSpace",
+			KebabCase:          "leading-space",
+			SnakeCase:          "leading_space",
+			TitleCase:          "Leading Space",
+			ScreamingSnakeCase: "LEADING_SPACE",
 		}},
 	{
 		input: "   leading spaces",
 		output: caseTests{
-			PascalCase: "LeadingSpaces",
-			CamelCase:  "leadingSpaces",
-			KebabCase:  "leading-spaces",
-			SnakeCase:  "leading_spaces",
+			PascalCase:         "LeadingSpaces",
+			CamelCase:          "leadingSpaces",
+			KebabCase:          "leading-spaces",
+			SnakeCase:          "leading_spaces",
+			TitleCase:          "Leading Spaces",
+			ScreamingSnakeCase: "LEADING_SPACES",
 		}},
 	{
 		input: "\t\t\r\n leading whitespaces",
 		output: caseTests{
-			PascalCase: "LeadingWhitespaces",
-			CamelCase:  "leadingWhitespaces",
-			KebabCase:  "leading-whitespaces",
-			SnakeCase:  "leading_whitespaces",
+			PascalCase:         "LeadingWhitespaces",
+			CamelCase:          "leadingWhitespaces",
+			KebabCase:          "leading-whitespaces",
+			SnakeCase:          "leading_whitespaces",
+			TitleCase:          "Leading Whitespaces",
+			ScreamingSnakeCase: "LEADING_WHITESPACES",
 		}},
 	{
 		input: "trailing space ",
 		output: caseTests{
-			PascalCase: "TrailingSpace",
-			CamelCase:  "trailingSpace",
-			KebabCase:  "trailing-space",
-			SnakeCase:  "trailing_space",
+			PascalCase:         "TrailingSpace",
+			CamelCase:          "trailingSpace",
+			KebabCase:          "trailing-space",
+			SnakeCase:          "trailing_space",
+			TitleCase:          "Trailing Space",
+			ScreamingSnakeCase: "TRAILING_SPACE",
 		}},
 	{
 		input: "trailing spaces   ",
 		output: caseTests{
-			PascalCase: "TrailingSpaces",
-			CamelCase:  "trailingSpaces",
-			KebabCase:  "trailing-spaces",
-			SnakeCase:  "trailing_spaces",
+			PascalCase:         "TrailingSpaces",
+			CamelCase:          "trailingSpaces",
+			KebabCase:          "trailing-spaces",
+			SnakeCase:          "trailing_spaces",
+			TitleCase:          "Trailing Spaces",
+			ScreamingSnakeCase: "TRAILING_SPACES",
 		}},
 	{
 		input: "trailing whitespaces\t\t\r\n",
 		output: caseTests{
-			PascalCase: "TrailingWhitespaces",
-			CamelCase:  "trailingWhitespaces",
-			KebabCase:  "trailing-whitespaces",
-			SnakeCase:  "trailing_whitespaces",
+			PascalCase:         "TrailingWhitespaces",
+			CamelCase:          "trailingWhitespaces",
+			KebabCase:          "trailing-whitespaces",
+			SnakeCase:          "trailing_whitespaces",
+			TitleCase:          "Trailing Whitespaces",
+			ScreamingSnakeCase: "TRAILING_WHITESPACES",
 		}},
 	{
 		input: " on both sides ",
 		output: caseTests{
-			PascalCase: "OnBothSides",
-			CamelCase:  "onBothSides",
-			KebabCase:  "on-both-sides",
-			SnakeCase:  "on_both_sides",
+			PascalCase:         "OnBothSides",
+			CamelCase:          "onBothSides",
+			KebabCase:          "on-both-sides",
+			SnakeCase:          "on_both_sides",
+			TitleCase:          "On Both Sides",
+			ScreamingSnakeCase: "ON_BOTH_SIDES",
 		}},
 	{
 		input: "    many on both sides  ",
 		output: caseTests{
-			PascalCase: "ManyOnBothSides",
-			CamelCase:  "manyOnBothSides",
-			KebabCase:  "many-on-both-sides",
-			SnakeCase:  "many_on_both_sides",
+			PascalCase:         "ManyOnBothSides",
+			CamelCase:          "manyOnBothSides",
+			KebabCase:          "many-on-both-sides",
+			SnakeCase:          "many_on_both_sides",
+			TitleCase:          "Many On Both Sides",
+			ScreamingSnakeCase: "MANY_ON_BOTH_SIDES",
 		}},
 	{
 		input: "\r whitespaces on both sides\t\t\r\n",
 		output: caseTests{
-			PascalCase: "WhitespacesOnBothSides",
-			CamelCase:  "whitespacesOnBothSides",
-			KebabCase:  "whitespaces-on-both-sides",
-			SnakeCase:  "whitespaces_on_both_sides",
+			PascalCase:         "WhitespacesOnBothSides",
+			CamelCase:          "whitespacesOnBothSides",
+			KebabCase:          "whitespaces-on-both-sides",
+			SnakeCase:          "whitespaces_on_both_sides",
+			TitleCase:          "Whitespaces On Both Sides",
+			ScreamingSnakeCase: "WHITESPACES_ON_BOTH_SIDES",
 		}},
 	{
 		input: "  extraSpaces in_This TestCase Of MIXED_CASES\t",
 		output: caseTests{
-			PascalCase: "ExtraSpacesInThisTestCaseOfMixedCases",
-			CamelCase:  "extraSpacesInThisTestCaseOfMixedCases",
-			KebabCase:  "extra-spaces-in-this-test-case-of-mixed-cases",
-			SnakeCase:  "extra_spaces_in_this_test_case_of_mixed_cases",
+			PascalCase:         "ExtraSpacesInThisTestCaseOfMixedCases",
+			CamelCase:          "extraSpacesInThisTestCaseOfMixedCases",
+			KebabCase:          "extra-spaces-in-this-test-case-of-mixed-cases",
+			SnakeCase:          "extra_spaces_in_this_test_case_of_mixed_cases",
+			TitleCase:          "Extra Spaces In This Test Case Of Mixed Cases",
+			ScreamingSnakeCase: "EXTRA_SPACES_IN_THIS_TEST_CASE_OF_MIXED_CASES",
 		}},
 	{
 		input: "CASEBreak",
 		output: caseTests{
-			PascalCase: "CaseBreak",
-			CamelCase:  "caseBreak",
-			KebabCase:  "case-break",
-			SnakeCase:  "case_break",
+			PascalCase:         "CaseBreak",
+			CamelCase:          "caseBreak",
+			KebabCase:          "case-break",
+			SnakeCase:          "case_break",
+			TitleCase:          "Case Break",
+			ScreamingSnakeCase: "CASE_BREAK",
 		}},
 	{
 		input: "ID",
 		output: caseTests{
-			PascalCase: "Id",
-			CamelCase:  "id",
-			KebabCase:  "id",
-			SnakeCase:  "id",
+			PascalCase:         "Id",
+			CamelCase:          "id",
+			KebabCase:          "id",
+			SnakeCase:          "id",
+			TitleCase:          "Id",
+			ScreamingSnakeCase: "ID",
 		}},
 	{
 		input: "userID",
 		output: caseTests{
-			PascalCase: "UserId",
-			CamelCase:  "userId",
-			KebabCase:  "user-id",
-			SnakeCase:  "user_id",
+			PascalCase:         "UserId",
+			CamelCase:          "userId",
+			KebabCase:          "user-id",
+			SnakeCase:          "user_id",
+			TitleCase:          "User Id",
+			ScreamingSnakeCase: "USER_ID",
 		}},
 	{
 		input: "JSON_blob",
 		output: caseTests{
-			PascalCase: "JsonBlob",
-			CamelCase:  "jsonBlob",
-			KebabCase:  "json-blob",
-			SnakeCase:  "json_blob",
+			PascalCase:         "JsonBlob",
+			CamelCase:          "jsonBlob",
+			KebabCase:          "json-blob",
+			SnakeCase:          "json_blob",
+			TitleCase:          "Json Blob",
+			ScreamingSnakeCase: "JSON_BLOB",
 		}},
 	{
 		input: "HTTPStatusCode",
 		output: caseTests{
-			PascalCase: "HttpStatusCode",
-			CamelCase:  "httpStatusCode",
-			KebabCase:  "http-status-code",
-			SnakeCase:  "http_status_code",
+			PascalCase:         "HttpStatusCode",
+			CamelCase:          "httpStatusCode",
+			KebabCase:          "http-status-code",
+			SnakeCase:          "http_status_code",
+			TitleCase:          "Http Status Code",
+			ScreamingSnakeCase: "HTTP_STATUS_CODE",
 		}},
 	{
 		input: "FreeBSD and SSLError are not golang initialisms",
 		output: caseTests{
-			PascalCase: "FreeBsdAndSslErrorAreNotGolangInitialisms",
-			CamelCase:  "freeBsdAndSslErrorAreNotGolangInitialisms",
-			KebabCase:  "free-bsd-and-ssl-error-are-not-golang-initialisms",
-			SnakeCase:  "free_bsd_and_ssl_error_are_not_golang_initialisms",
+			PascalCase:         "FreeBsdAndSslErrorAreNotGolangInitialisms",
+			CamelCase:          "freeBsdAndSslErrorAreNotGolangInitialisms",
+			KebabCase:          "free-bsd-and-ssl-error-are-not-golang-initialisms",
+			SnakeCase:          "free_bsd_and_ssl_error_are_not_golang_initialisms",
+			TitleCase:          "Free Bsd And Ssl Error Are Not Golang Initialisms",
+			ScreamingSnakeCase: "FREE_BSD_AND_SSL_ERROR_ARE_NOT_GOLANG_INITIALISMS",
 		}},
 	{
 		input: "David's Computer",
 		output: caseTests{
-			PascalCase: "DavidSComputer",
-			CamelCase:  "davidSComputer",
-			KebabCase:  "david-s-computer",
-			SnakeCase:  "david_s_computer",
+			PascalCase:         "DavidSComputer",
+			CamelCase:          "davidSComputer",
+			KebabCase:          "david-s-computer",
+			SnakeCase:          "david_s_computer",
+			TitleCase:          "David S Computer",
+			ScreamingSnakeCase: "DAVID_S_COMPUTER",
 		}},
 	{
 		input: "http200",
 		output: caseTests{
-			PascalCase: "Http200",
-			CamelCase:  "http200",
-			KebabCase:  "http-200",
-			SnakeCase:  "http_200",
+			PascalCase:         "Http200",
+			CamelCase:          "http200",
+			KebabCase:          "http-200",
+			SnakeCase:          "http_200",
+			TitleCase:          "Http 200",
+			ScreamingSnakeCase: "HTTP_200",
 		}},
 	{
 		input: "NumberSplittingVersion1.0r3",
 		output: caseTests{
-			PascalCase: "NumberSplittingVersion10R3",
-			CamelCase:  "numberSplittingVersion10R3",
-			KebabCase:  "number-splitting-version-1-0-r3",
-			SnakeCase:  "number_splitting_version_1_0_r3",
+			PascalCase:         "NumberSplittingVersion10R3",
+			CamelCase:          "numberSplittingVersion10R3",
+			KebabCase:          "number-splitting-version-1-0-r3",
+			SnakeCase:          "number_splitting_version_1_0_r3",
+			TitleCase:          "Number Splitting Version 1 0 R3",
+			ScreamingSnakeCase: "NUMBER_SPLITTING_VERSION_1_0_R3",
 		}},
 	{
 		input: "When you have a comma, odd results",
 		output: caseTests{
-			PascalCase: "WhenYouHaveACommaOddResults",
-			CamelCase:  "whenYouHaveACommaOddResults",
-			KebabCase:  "when-you-have-a-comma-odd-results",
-			SnakeCase:  "when_you_have_a_comma_odd_results",
+			PascalCase:         "WhenYouHaveACommaOddResults",
+			CamelCase:          "whenYouHaveACommaOddResults",
+			KebabCase:          "when-you-have-a-comma-odd-results",
+			SnakeCase:          "when_you_have_a_comma_odd_results",
+			TitleCase:          "When You Have A Comma Odd Results",
+			ScreamingSnakeCase: "WHEN_YOU_HAVE_A_COMMA_ODD_RESULTS",
 		}},
 	{
 		input: "Ordinal numbers work: 1st 2nd and 3rd place",
 		output: caseTests{
-			PascalCase: "OrdinalNumbersWork1St2NdAnd3RdPlace",
-			CamelCase:  "ordinalNumbersWork1St2NdAnd3RdPlace",
-			KebabCase:  "ordinal-numbers-work-1-st-2-nd-and-3-rd-place",
-			SnakeCase:  "ordinal_numbers_work_1_st_2_nd_and_3_rd_place",
+			PascalCase:         "OrdinalNumbersWork1St2NdAnd3RdPlace",
+			CamelCase:          "ordinalNumbersWork1St2NdAnd3RdPlace",
+			KebabCase:          "ordinal-numbers-work-1-st-2-nd-and-3-rd-place",
+			SnakeCase:          "ordinal_numbers_work_1_st_2_nd_and_3_rd_place",
+			TitleCase:          "Ordinal Numbers Work 1 St 2 Nd And 3 Rd Place",
+			ScreamingSnakeCase: "ORDINAL_NUMBERS_WORK_1_ST_2_ND_AND_3_RD_PLACE",
 		}},
 	{
 		input: "BadUTF8\xe2\xe2\xa1",
 		output: caseTests{
-			PascalCase: "BadUtf8",
-			CamelCase:  "badUtf8",
-			KebabCase:  "bad-utf-8",
-			SnakeCase:  "bad_utf_8",
+			PascalCase:         "BadUtf8",
+			CamelCase:          "badUtf8",
+			KebabCase:          "bad-utf-8",
+			SnakeCase:          "bad_utf_8",
+			TitleCase:          "Bad Utf 8",
+			ScreamingSnakeCase: "BAD_UTF_8",
 		}},
 	{
 		input: "IDENT3",
 		output: caseTests{
-			PascalCase: "Ident3",
-			CamelCase:  "ident3",
-			KebabCase:  "ident-3",
-			SnakeCase:  "ident_3",
+			PascalCase:         "Ident3",
+			CamelCase:          "ident3",
+			KebabCase:          "ident-3",
+			SnakeCase:          "ident_3",
+			TitleCase:          "Ident 3",
+			ScreamingSnakeCase: "IDENT_3",
 		}},
 	{
 		input: "LogRouterS3BucketName",
 		output: caseTests{
-			PascalCase: "LogRouterS3BucketName",
-			CamelCase:  "logRouterS3BucketName",
-			KebabCase:  "log-router-s3-bucket-name",
-			SnakeCase:  "log_router_s3_bucket_name",
+			PascalCase:         "LogRouterS3BucketName",
+			CamelCase:          "logRouterS3BucketName",
+			KebabCase:          "log-router-s3-bucket-name",
+			SnakeCase:          "log_router_s3_bucket_name",
+			TitleCase:          "Log Router S3 Bucket Name",
+			ScreamingSnakeCase: "LOG_ROUTER_S3_BUCKET_NAME",
 		}},
 	{
 		input: "PINEAPPLE",
 		output: caseTests{
-			PascalCase: "Pineapple",
-			CamelCase:  "pineapple",
-			KebabCase:  "pineapple",
-			SnakeCase:  "pineapple",
+			PascalCase:         "Pineapple",
+			CamelCase:          "pineapple",
+			KebabCase:          "pineapple",
+			SnakeCase:          "pineapple",
+			TitleCase:          "Pineapple",
+			ScreamingSnakeCase: "PINEAPPLE",
 		}},
 	{
 		input: "Int8Value",
 		output: caseTests{
-			PascalCase: "Int8Value",
-			CamelCase:  "int8Value",
-			KebabCase:  "int-8-value",
-			SnakeCase:  "int_8_value",
+			PascalCase:         "Int8Value",
+			CamelCase:          "int8Value",
+			KebabCase:          "int-8-value",
+			SnakeCase:          "int_8_value",
+			TitleCase:          "Int 8 Value",
+			ScreamingSnakeCase: "INT_8_VALUE",
 		}},
 	{
 		input: "first.last",
 		output: caseTests{
-			PascalCase: "FirstLast",
-			CamelCase:  "firstLast",
-			KebabCase:  "first-last",
-			SnakeCase:  "first_last",
+			PascalCase:         "FirstLast",
+			CamelCase:          "firstLast",
+			KebabCase:          "first-last",
+			SnakeCase:          "first_last",
+			TitleCase:          "First Last",
+			ScreamingSnakeCase: "FIRST_LAST",
 		},
 	},
 }
@@ -131,6 +131,48 @@ func ExampleSnakeCase() {
 	// Completed
 }
 
+func ExampleTitleCase() {
+	// Convert strings to Title Case format
+	observable := ro.Pipe1(
+		ro.Just(
+			"hello world",
+			"userName",
+			"API_KEY",
+		),
+		TitleCase[string](),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: Hello World
+	// Next: User Name
+	// Next: Api Key
+	// Completed
+}
+
+func ExampleScreamingSnakeCase() {
+	// Convert strings to SCREAMING_SNAKE_CASE format
+	observable := ro.Pipe1(
+		ro.Just(
+			"hello world",
+			"userName",
+			"api-key",
+		),
+		ScreamingSnakeCase[string](),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: HELLO_WORLD
+	// Next: USER_NAME
+	// Next: API_KEY
+	// Completed
+}
+
 func ExampleEllipsis() {
 	// Truncate strings with ellipsis
 	observable := ro.Pipe1(
@@ -152,6 +194,185 @@ func ExampleEllipsis() {
 	// Completed
 }
 
+func ExampleNormalizeNFC() {
+	// Compose decomposed characters
+	observable := ro.Pipe2(
+		ro.Just("cafe\u0301", "caf\u00e9"),
+		NormalizeNFC[string](),
+		ro.Map(func(s string) int { return len(s) }),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 5
+	// Next: 5
+	// Completed
+}
+
+func ExampleNormalizeNFD() {
+	// Decompose composed characters
+	observable := ro.Pipe2(
+		ro.Just("cafe\u0301", "caf\u00e9"),
+		NormalizeNFD[string](),
+		ro.Map(func(s string) int { return len(s) }),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 6
+	// Next: 6
+	// Completed
+}
+
+func ExampleStripAccents() {
+	// Remove diacritical marks
+	observable := ro.Pipe1(
+		ro.Just("Crème brûlée", "Ångström"),
+		StripAccents[string](),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: Creme brulee
+	// Next: Angstrom
+	// Completed
+}
+
+func ExampleToASCII() {
+	// Transliterate strings to ASCII
+	observable := ro.Pipe1(
+		ro.Just("Crème brûlée", "Straße", "Łódź"),
+		ToASCII[string](),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: Creme brulee
+	// Next: Strasse
+	// Next: Lodz
+	// Completed
+}
+
+func ExampleMapLevenshtein() {
+	// Compute edit distances to a target
+	observable := ro.Pipe1(
+		ro.Just("kitten", "sitting", "mitten"),
+		MapLevenshtein[string]("kitten"),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 0
+	// Next: 3
+	// Next: 1
+	// Completed
+}
+
+func ExampleFilterSimilarTo() {
+	// Keep strings close to a target
+	observable := ro.Pipe1(
+		ro.Just("apple", "appel", "banana", "aple"),
+		FilterSimilarTo[string]("apple", 2),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: apple
+	// Next: appel
+	// Next: aple
+	// Completed
+}
+
+func ExampleTruncateRunes() {
+	// Truncate strings to a number of runes
+	observable := ro.Pipe1(
+		ro.Just("héllo wörld", "short"),
+		TruncateRunes[string](8, "…"),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: héllo w…
+	// Next: short
+	// Completed
+}
+
+func ExamplePadLeft() {
+	// Pad strings on the left
+	observable := ro.Pipe1(
+		ro.Just("7", "42", "123"),
+		PadLeft[string](3, '0'),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 007
+	// Next: 042
+	// Next: 123
+	// Completed
+}
+
+func ExamplePadRight() {
+	// Pad strings on the right
+	observable := ro.Pipe1(
+		ro.Just("a", "bé"),
+		PadRight[string](4, '.'),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: a...
+	// Next: bé..
+	// Completed
+}
+
+func ExampleJoin() {
+	// Join strings on completion
+	observable := ro.Pipe1(
+		ro.Just("a", "b", "c"),
+		Join[string](", "),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: a, b, c
+	// Completed
+}
+
+func ExampleJoinWithLimit() {
+	// Join strings, up to 5 bytes
+	observable := ro.Pipe1(
+		ro.Just("a", "b", "c", "d"),
+		JoinWithLimit[string](", ", 5),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Error: rostrings.JoinWithLimit: limit exceeded
+}
+
 func ExampleWords() {
 	// Split strings into words
 	observable := ro.Pipe1(
@@ -175,6 +396,79 @@ func ExampleWords() {
 	// Completed
 }
 
+func ExampleSplitWords() {
+	// Emit every word of the strings
+	observable := ro.Pipe1(
+		ro.Just(
+			"Hello world!",
+			"userName",
+		),
+		SplitWords[string](),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: Hello
+	// Next: world
+	// Next: user
+	// Next: Name
+	// Completed
+}
+
+func ExampleSplitLines() {
+	// Emit every line of the strings
+	observable := ro.Pipe1(
+		ro.Just("first line\nsecond line\n"),
+		SplitLines[string](),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: first line
+	// Next: second line
+	// Completed
+}
+
+func ExampleSplitRunes() {
+	// Emit every rune of the strings
+	observable := ro.Pipe1(
+		ro.Just("héllo"),
+		SplitRunes[string](),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: h
+	// Next: é
+	// Next: l
+	// Next: l
+	// Next: o
+	// Completed
+}
+
+func ExampleSplitBy() {
+	// Emit every comma-separated value of the strings
+	observable := ro.Pipe1(
+		ro.Just("a,b", "c"),
+		SplitBy[string](","),
+	)
+
+	subscription := observable.Subscribe(ro.PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: a
+	// Next: b
+	// Next: c
+	// Completed
+}
+
 func ExampleRandom() {
 	// Generate random strings
 	observable := ro.Pipe1(
@@ -0,0 +1,90 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rostrings
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/samber/ro"
+)
+
+// ErrJoinLimitExceeded is emitted by JoinWithLimit when the joined string
+// grows beyond the limit.
+var ErrJoinLimitExceeded = errors.New("rostrings.JoinWithLimit: limit exceeded")
+
+func join[T ~string](sep string, limit int) func(destination ro.Observable[T]) ro.Observable[T] {
+	return func(source ro.Observable[T]) ro.Observable[T] {
+		return ro.NewUnsafeObservableWithContext(func(subscriberCtx context.Context, destination ro.Observer[T]) ro.Teardown {
+			var builder strings.Builder
+			count := 0
+			exceeded := false
+
+			sub := source.SubscribeWithContext(
+				subscriberCtx,
+				ro.NewObserverWithContext(
+					func(ctx context.Context, value T) {
+						if exceeded {
+							return
+						}
+
+						size := builder.Len() + len(value)
+						if count > 0 {
+							size += len(sep)
+						}
+
+						if limit >= 0 && size > limit {
+							exceeded = true
+							destination.ErrorWithContext(ctx, ErrJoinLimitExceeded)
+							return
+						}
+
+						if count > 0 {
+							builder.WriteString(sep)
+						}
+						builder.WriteString(string(value))
+						count++
+					},
+					destination.ErrorWithContext,
+					func(ctx context.Context) {
+						destination.NextWithContext(ctx, T(builder.String()))
+						destination.CompleteWithContext(ctx)
+					},
+				),
+			)
+
+			return sub.Unsubscribe
+		})
+	}
+}
+
+// Join concatenates the strings emitted by the source, separated by sep. It is a
+// sink operator: it emits the joined string once the source completes, and an
+// empty string if the source completes without emitting.
+func Join[T ~string](sep string) func(destination ro.Observable[T]) ro.Observable[T] {
+	return join[T](sep, -1)
+}
+
+// JoinWithLimit is like Join, but emits ErrJoinLimitExceeded as soon as the
+// joined string would be longer than limit bytes, so that an unbounded stream
+// cannot exhaust memory.
+func JoinWithLimit[T ~string](sep string, limit int) func(destination ro.Observable[T]) ro.Observable[T] {
+	if limit < 0 {
+		panic("rostrings.JoinWithLimit: limit parameter must not be negative")
+	}
+
+	return join[T](sep, limit)
+}
@@ -0,0 +1,120 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rostrings
+
+import (
+	"testing"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJoin(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just("a", "b", "c"),
+			Join[string](", "),
+		),
+	)
+	is.Equal([]string{"a, b, c"}, values)
+	is.Nil(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Just("", "b", ""),
+			Join[string]("-"),
+		),
+	)
+	is.Equal([]string{"-b-"}, values)
+	is.Nil(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Empty[string](),
+			Join[string](", "),
+		),
+	)
+	is.Equal([]string{""}, values)
+	is.Nil(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Throw[string](assert.AnError),
+			Join[string](", "),
+		),
+	)
+	is.Equal([]string{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestJoinWithLimit(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// "a, b, c" is 7 bytes long
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just("a", "b", "c"),
+			JoinWithLimit[string](", ", 7),
+		),
+	)
+	is.Equal([]string{"a, b, c"}, values)
+	is.Nil(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Just("a", "b", "c"),
+			JoinWithLimit[string](", ", 6),
+		),
+	)
+	is.Equal([]string{}, values)
+	is.ErrorIs(err, ErrJoinLimitExceeded)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Empty[string](),
+			JoinWithLimit[string](", ", 0),
+		),
+	)
+	is.Equal([]string{""}, values)
+	is.Nil(err)
+
+	// the source is unsubscribed once the limit is exceeded
+	values, err = ro.Collect(
+		ro.Pipe2(
+			ro.Range(0, 1_000_000),
+			ro.Map(func(int64) string { return "x" }),
+			JoinWithLimit[string]("", 10),
+		),
+	)
+	is.Equal([]string{}, values)
+	is.ErrorIs(err, ErrJoinLimitExceeded)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Throw[string](assert.AnError),
+			JoinWithLimit[string](", ", 10),
+		),
+	)
+	is.Equal([]string{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithValue("rostrings.JoinWithLimit: limit parameter must not be negative", func() {
+		JoinWithLimit[string](", ", -1)
+	})
+}
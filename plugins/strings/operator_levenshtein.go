@@ -0,0 +1,84 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rostrings
+
+import (
+	"github.com/samber/ro"
+)
+
+// levenshtein returns the edit distance between a and b, counted in runes.
+func levenshtein(a, b string) int {
+	ra := []rune(a)
+	rb := []rune(b)
+
+	if len(ra) < len(rb) {
+		ra, rb = rb, ra
+	}
+
+	// single row of the dynamic programming matrix, over the shortest string
+	row := make([]int, len(rb)+1)
+	for j := range row {
+		row[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		prev := row[0]
+		row[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+			current := row[j]
+
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			row[j] = min3(row[j]+1, row[j-1]+1, prev+cost)
+			prev = current
+		}
+	}
+
+	return row[len(rb)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// MapLevenshtein emits the Levenshtein distance between each string and the
+// target, counted in runes.
+func MapLevenshtein[T ~string](target string) func(destination ro.Observable[T]) ro.Observable[int] {
+	return ro.Map(
+		func(value T) int {
+			return levenshtein(string(value), target)
+		},
+	)
+}
+
+// FilterSimilarTo emits the strings whose Levenshtein distance to the target is
+// lower than or equal to maxDistance.
+func FilterSimilarTo[T ~string](target string, maxDistance int) func(destination ro.Observable[T]) ro.Observable[T] {
+	return ro.Filter(
+		func(value T) bool {
+			return levenshtein(string(value), target) <= maxDistance
+		},
+	)
+}
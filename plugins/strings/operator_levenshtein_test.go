@@ -0,0 +1,92 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rostrings
+
+import (
+	"testing"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLevenshtein(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal(0, levenshtein("", ""))
+	is.Equal(3, levenshtein("abc", ""))
+	is.Equal(3, levenshtein("", "abc"))
+	is.Equal(0, levenshtein("kitten", "kitten"))
+	is.Equal(3, levenshtein("kitten", "sitting"))
+	is.Equal(3, levenshtein("sitting", "kitten"))
+	is.Equal(2, levenshtein("flaw", "lawn"))
+	is.Equal(1, levenshtein("café", "cafe"))
+	is.Equal(1, levenshtein("日本", "日本語"))
+}
+
+func TestMapLevenshtein(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just("kitten", "sitting", "", "mitten"),
+			MapLevenshtein[string]("kitten"),
+		),
+	)
+	is.Equal([]int{0, 3, 6, 1}, values)
+	is.Nil(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Throw[string](assert.AnError),
+			MapLevenshtein[string]("kitten"),
+		),
+	)
+	is.Equal([]int{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestFilterSimilarTo(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just("apple", "appel", "aple", "banana", "applesauce"),
+			FilterSimilarTo[string]("apple", 2),
+		),
+	)
+	is.Equal([]string{"apple", "appel", "aple"}, values)
+	is.Nil(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Just("apple", "appel"),
+			FilterSimilarTo[string]("apple", 0),
+		),
+	)
+	is.Equal([]string{"apple"}, values)
+	is.Nil(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Throw[string](assert.AnError),
+			FilterSimilarTo[string]("apple", 2),
+		),
+	)
+	is.Equal([]string{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
@@ -0,0 +1,40 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rostrings
+
+import (
+	"github.com/samber/ro"
+	"golang.org/x/text/unicode/norm"
+)
+
+// NormalizeNFC converts the string to the Unicode Normalization Form C
+// (canonical decomposition, followed by canonical composition).
+func NormalizeNFC[T ~string]() func(destination ro.Observable[T]) ro.Observable[T] {
+	return ro.Map(
+		func(value T) T {
+			return T(norm.NFC.String(string(value)))
+		},
+	)
+}
+
+// NormalizeNFD converts the string to the Unicode Normalization Form D
+// (canonical decomposition).
+func NormalizeNFD[T ~string]() func(destination ro.Observable[T]) ro.Observable[T] {
+	return ro.Map(
+		func(value T) T {
+			return T(norm.NFD.String(string(value)))
+		},
+	)
+}
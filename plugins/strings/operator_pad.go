@@ -0,0 +1,51 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rostrings
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/samber/ro"
+)
+
+func padding(str string, width int, pad rune) string {
+	missing := width - utf8.RuneCountInString(str)
+	if missing <= 0 {
+		return ""
+	}
+
+	return strings.Repeat(string(pad), missing)
+}
+
+// PadLeft prepends the pad rune to the string until it is width runes long.
+// Longer strings are left unchanged.
+func PadLeft[T ~string](width int, pad rune) func(destination ro.Observable[T]) ro.Observable[T] {
+	return ro.Map(
+		func(value T) T {
+			return T(padding(string(value), width, pad)) + value
+		},
+	)
+}
+
+// PadRight appends the pad rune to the string until it is width runes long.
+// Longer strings are left unchanged.
+func PadRight[T ~string](width int, pad rune) func(destination ro.Observable[T]) ro.Observable[T] {
+	return ro.Map(
+		func(value T) T {
+			return value + T(padding(string(value), width, pad))
+		},
+	)
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rostrings
+
+import (
+	"testing"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPadLeft(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just("42", "héllo", "too long", ""),
+			PadLeft[string](5, '0'),
+		),
+	)
+	is.Equal([]string{"00042", "héllo", "too long", "00000"}, values)
+	is.Nil(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Just("ab"),
+			PadLeft[string](4, '·'),
+		),
+	)
+	is.Equal([]string{"··ab"}, values)
+	is.Nil(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Throw[string](assert.AnError),
+			PadLeft[string](5, '0'),
+		),
+	)
+	is.Equal([]string{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestPadRight(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just("42", "héllo", "too long", ""),
+			PadRight[string](5, ' '),
+		),
+	)
+	is.Equal([]string{"42   ", "héllo", "too long", "     "}, values)
+	is.Nil(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Just("ab"),
+			PadRight[string](4, '·'),
+		),
+	)
+	is.Equal([]string{"ab··"}, values)
+	is.Nil(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Throw[string](assert.AnError),
+			PadRight[string](5, ' '),
+		),
+	)
+	is.Equal([]string{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rostrings
+
+import (
+	"strings"
+
+	"github.com/samber/ro"
+)
+
+func splitLines(str string) []string {
+	lines := strings.Split(str, "\n")
+	// A trailing line break does not start an empty line.
+	if len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	for i := range lines {
+		lines[i] = strings.TrimSuffix(lines[i], "\r")
+	}
+	return lines
+}
+
+func splitRunes(str string) []string {
+	output := make([]string, 0, len(str))
+	for _, r := range str {
+		output = append(output, string(r))
+	}
+	return output
+}
+
+// flatSplit emits each item returned by split, for every string.
+func flatSplit[T ~string](split func(str string) []string) func(destination ro.Observable[T]) ro.Observable[T] {
+	return func(source ro.Observable[T]) ro.Observable[T] {
+		return ro.Pipe2(
+			source,
+			ro.Map(
+				func(value T) []T {
+					items := split(string(value))
+					output := make([]T, len(items))
+					for i := range items {
+						output[i] = T(items[i])
+					}
+					return output
+				},
+			),
+			ro.Flatten[T](),
+		)
+	}
+}
+
+// SplitWords splits each string into words, and emits every word.
+func SplitWords[T ~string]() func(destination ro.Observable[T]) ro.Observable[T] {
+	return flatSplit[T](words)
+}
+
+// SplitLines splits each string into lines, and emits every line. Both "\n" and
+// "\r\n" line breaks are supported. A trailing line break does not emit an empty line.
+func SplitLines[T ~string]() func(destination ro.Observable[T]) ro.Observable[T] {
+	return flatSplit[T](splitLines)
+}
+
+// SplitRunes splits each string into runes, and emits every rune as a string.
+func SplitRunes[T ~string]() func(destination ro.Observable[T]) ro.Observable[T] {
+	return flatSplit[T](splitRunes)
+}
+
+// SplitBy splits each string around each instance of sep, and emits every substring.
+// See strings.Split.
+func SplitBy[T ~string](sep string) func(destination ro.Observable[T]) ro.Observable[T] {
+	return flatSplit[T](func(str string) []string {
+		return strings.Split(str, sep)
+	})
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rostrings
+
+import (
+	"testing"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitWords(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just("Hello world!", "", "userName"),
+			SplitWords[string](),
+		),
+	)
+	is.Equal([]string{"Hello", "world", "user", "Name"}, values)
+	is.Nil(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Throw[string](assert.AnError),
+			SplitWords[string](),
+		),
+	)
+	is.Equal([]string{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestSplitLines(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just("a\nb\r\nc\n", "", "d\n\ne"),
+			SplitLines[string](),
+		),
+	)
+	is.Equal([]string{"a", "b", "c", "d", "", "e"}, values)
+	is.Nil(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Throw[string](assert.AnError),
+			SplitLines[string](),
+		),
+	)
+	is.Equal([]string{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestSplitRunes(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type myString string
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just[myString]("héllo", "", "世界"),
+			SplitRunes[myString](),
+		),
+	)
+	is.Equal([]myString{"h", "é", "l", "l", "o", "世", "界"}, values)
+	is.Nil(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Throw[myString](assert.AnError),
+			SplitRunes[myString](),
+		),
+	)
+	is.Equal([]myString{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
+
+func TestSplitBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just("a,b,,c", "d"),
+			SplitBy[string](","),
+		),
+	)
+	is.Equal([]string{"a", "b", "", "c", "d"}, values)
+	is.Nil(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Throw[string](assert.AnError),
+			SplitBy[string](","),
+		),
+	)
+	is.Equal([]string{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
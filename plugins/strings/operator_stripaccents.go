@@ -0,0 +1,46 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rostrings
+
+import (
+	"unicode"
+
+	"github.com/samber/ro"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+func stripAccents(str string) string {
+	// transformers are stateful: a new chain is built for each string.
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+	output, _, err := transform.String(t, str)
+	if err != nil {
+		return str
+	}
+
+	return output
+}
+
+// StripAccents removes the diacritical marks of the string ("é" becomes "e"),
+// and returns it in Normalization Form C.
+func StripAccents[T ~string]() func(destination ro.Observable[T]) ro.Observable[T] {
+	return ro.Map(
+		func(value T) T {
+			return T(stripAccents(string(value)))
+		},
+	)
+}
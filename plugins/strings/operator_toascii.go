@@ -0,0 +1,70 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rostrings
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/samber/ro"
+)
+
+// asciiTransliterations holds the characters that have no ASCII equivalent
+// once their accents are stripped.
+var asciiTransliterations = map[rune]string{
+	'Æ': "AE", 'æ': "ae",
+	'Œ': "OE", 'œ': "oe",
+	'Ø': "O", 'ø': "o",
+	'Ł': "L", 'ł': "l",
+	'Đ': "D", 'đ': "d",
+	'Ð': "D", 'ð': "d",
+	'Þ': "TH", 'þ': "th",
+	'ß': "ss",
+	'ı': "i",
+	'‘': "'", '’': "'", '‚': "'",
+	'“': `"`, '”': `"`, '„': `"`,
+	'«': `"`, '»': `"`,
+	'‐': "-", '–': "-", '—': "-",
+	'…':      "...",
+	'\u00a0': " ",
+}
+
+func toASCII(str string) string {
+	str = stripAccents(str)
+
+	var builder strings.Builder
+	builder.Grow(len(str))
+
+	for _, r := range str {
+		if r < utf8.RuneSelf {
+			builder.WriteRune(r)
+		} else if replacement, ok := asciiTransliterations[r]; ok {
+			builder.WriteString(replacement)
+		}
+	}
+
+	return builder.String()
+}
+
+// ToASCII transliterates the string to ASCII: accents are stripped, common
+// letters and punctuation are replaced ("ß" becomes "ss", "œ" becomes "oe",
+// "’" becomes "'"), and other non-ASCII characters are dropped.
+func ToASCII[T ~string]() func(destination ro.Observable[T]) ro.Observable[T] {
+	return ro.Map(
+		func(value T) T {
+			return T(toASCII(string(value)))
+		},
+	)
+}
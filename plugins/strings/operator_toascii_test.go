@@ -0,0 +1,61 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rostrings
+
+import (
+	"testing"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToASCII(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just(
+				"Crème brûlée",
+				"Straße",
+				"Œuvre, Æsir, Łódź",
+				"“quoted” – it’s…",
+				"a b",
+				"日本 ok",
+				"",
+			),
+			ToASCII[string](),
+		),
+	)
+	is.Equal([]string{
+		"Creme brulee",
+		"Strasse",
+		"OEuvre, AEsir, Lodz",
+		`"quoted" - it's...`,
+		"a b",
+		" ok",
+		"",
+	}, values)
+	is.Nil(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Throw[string](assert.AnError),
+			ToASCII[string](),
+		),
+	)
+	is.Equal([]string{}, values)
+	is.EqualError(err, assert.AnError.Error())
+}
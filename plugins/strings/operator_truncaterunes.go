@@ -0,0 +1,59 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rostrings
+
+import (
+	"unicode/utf8"
+
+	"github.com/samber/ro"
+)
+
+func truncateRunes(str string, n int, ellipsis string) string {
+	if utf8.RuneCountInString(str) <= n {
+		return str
+	}
+
+	// the ellipsis is dropped when it does not fit
+	keep := n - utf8.RuneCountInString(ellipsis)
+	if keep < 0 {
+		keep = n
+		ellipsis = ""
+	}
+
+	count := 0
+	for i := range str {
+		if count == keep {
+			return str[:i] + ellipsis
+		}
+		count++
+	}
+
+	return str + ellipsis
+}
+
+// TruncateRunes truncates the string to n runes. When the string is longer, the
+// ellipsis is appended and counted in the n runes. The ellipsis is omitted when
+// it is longer than n.
+func TruncateRunes[T ~string](n int, ellipsis string) func(destination ro.Observable[T]) ro.Observable[T] {
+	if n < 0 {
+		panic("rostrings.TruncateRunes: n parameter must not be negative")
+	}
+
+	return ro.Map(
+		func(value T) T {
+			return T(truncateRunes(string(value), n, ellipsis))
+		},
+	)
+}
@@ -0,0 +1,77 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rostrings
+
+import (
+	"testing"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncateRunes(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	values, err := ro.Collect(
+		ro.Pipe1(
+			ro.Just("Hello world", "Hello", "héllo wörld", "日本語のテキスト", ""),
+			TruncateRunes[string](8, "…"),
+		),
+	)
+	is.Equal([]string{"Hello w…", "Hello", "héllo w…", "日本語のテキスト", ""}, values)
+	is.Nil(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Just("Hello world", "Hi"),
+			TruncateRunes[string](4, ""),
+		),
+	)
+	is.Equal([]string{"Hell", "Hi"}, values)
+	is.Nil(err)
+
+	// the ellipsis does not fit
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Just("Hello world"),
+			TruncateRunes[string](2, "..."),
+		),
+	)
+	is.Equal([]string{"He"}, values)
+	is.Nil(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Just("Hello world"),
+			TruncateRunes[string](0, "..."),
+		),
+	)
+	is.Equal([]string{""}, values)
+	is.Nil(err)
+
+	values, err = ro.Collect(
+		ro.Pipe1(
+			ro.Throw[string](assert.AnError),
+			TruncateRunes[string](8, "…"),
+		),
+	)
+	is.Equal([]string{}, values)
+	is.EqualError(err, assert.AnError.Error())
+
+	is.PanicsWithValue("rostrings.TruncateRunes: n parameter must not be negative", func() {
+		TruncateRunes[string](-1, "...")
+	})
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"time"
+)
+
+// Calendar defines the holidays used by business-day operators. Saturdays and
+// Sundays are never business days, whatever the calendar.
+type Calendar interface {
+	// IsHoliday reports whether the day of the given time value is a holiday.
+	IsHoliday(value time.Time) bool
+}
+
+// CalendarFunc is a function implementing Calendar.
+type CalendarFunc func(value time.Time) bool
+
+// IsHoliday implements Calendar.
+func (f CalendarFunc) IsHoliday(value time.Time) bool {
+	return f(value)
+}
+
+// NewHolidayCalendar returns a Calendar holding a fixed set of holidays. Holidays
+// are matched by year, month and day, regardless of time and location.
+func NewHolidayCalendar(holidays ...time.Time) Calendar {
+	days := make(map[civilDate]struct{}, len(holidays))
+	for _, holiday := range holidays {
+		days[toCivilDate(holiday)] = struct{}{}
+	}
+
+	return CalendarFunc(func(value time.Time) bool {
+		_, ok := days[toCivilDate(value)]
+		return ok
+	})
+}
+
+type civilDate struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func toCivilDate(value time.Time) civilDate {
+	year, month, day := value.Date()
+	return civilDate{year: year, month: month, day: day}
+}
+
+func isBusinessDay(value time.Time, calendar Calendar) bool {
+	if weekday := value.Weekday(); weekday == time.Saturday || weekday == time.Sunday {
+		return false
+	}
+
+	return calendar == nil || !calendar.IsHoliday(value)
+}
+
+func addBusinessDays(value time.Time, n int, calendar Calendar) time.Time {
+	step := 1
+	if n < 0 {
+		step = -1
+		n = -n
+	}
+
+	for n > 0 {
+		value = value.AddDate(0, 0, step)
+		if isBusinessDay(value, calendar) {
+			n--
+		}
+	}
+
+	return value
+}
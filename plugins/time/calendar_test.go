@@ -0,0 +1,50 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHolidayCalendar(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	calendar := NewHolidayCalendar(
+		time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, time.December, 25, 12, 0, 0, 0, time.UTC),
+	)
+
+	is.True(calendar.IsHoliday(time.Date(2026, time.January, 1, 18, 30, 0, 0, time.UTC)))
+	is.True(calendar.IsHoliday(time.Date(2026, time.December, 25, 0, 0, 0, 0, time.FixedZone("CET", 1*60*60))))
+	is.False(calendar.IsHoliday(time.Date(2026, time.January, 2, 0, 0, 0, 0, time.UTC)))
+	is.False(calendar.IsHoliday(time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestCalendarFunc(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// every 1st of the month
+	calendar := CalendarFunc(func(value time.Time) bool {
+		return value.Day() == 1
+	})
+
+	is.True(calendar.IsHoliday(time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC)))
+	is.False(calendar.IsHoliday(time.Date(2026, time.May, 2, 0, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,209 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSchedule is a parsed standard cron expression. Each field is a bitset of the
+// allowed values.
+type cronSchedule struct {
+	minute   uint64
+	hour     uint64
+	dom      uint64
+	month    uint64
+	dow      uint64
+	location *time.Location
+
+	// When both the day of month and the day of week are restricted, a day
+	// matches if either matches, as in Vixie cron. A field starting with "*"
+	// or "?", such as "*/2", is not restricted.
+	domRestricted bool
+	dowRestricted bool
+}
+
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	cronMinute = cronField{name: "minute", min: 0, max: 59}
+	cronHour   = cronField{name: "hour", min: 0, max: 23}
+	cronDom    = cronField{name: "day of month", min: 1, max: 31}
+	cronMonth  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// 7 is an alias for Sunday.
+	cronDow = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// parseCron parses a standard 5-field cron expression (minute, hour, day of month,
+// month, day of week), or a descriptor such as "@daily". The expression may start
+// with "CRON_TZ=<location> " or "TZ=<location> ".
+func parseCron(spec string) (*cronSchedule, error) {
+	schedule := &cronSchedule{location: time.Local}
+
+	spec = strings.TrimSpace(spec)
+
+	if strings.HasPrefix(spec, "CRON_TZ=") || strings.HasPrefix(spec, "TZ=") {
+		i := strings.IndexByte(spec, ' ')
+		if i == -1 {
+			return nil, fmt.Errorf("%w: %q: missing fields after time zone", ErrInvalidCronSpec, spec)
+		}
+
+		name := spec[strings.IndexByte(spec, '=')+1 : i]
+
+		location, err := time.LoadLocation(name)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %s", ErrInvalidCronSpec, spec, err.Error())
+		}
+
+		schedule.location = location
+		spec = strings.TrimSpace(spec[i:])
+	}
+
+	if descriptor, ok := cronDescriptors[strings.ToLower(spec)]; ok {
+		spec = descriptor
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("%w: %q: expected 5 fields, got %d", ErrInvalidCronSpec, spec, len(fields))
+	}
+
+	targets := []*uint64{&schedule.minute, &schedule.hour, &schedule.dom, &schedule.month, &schedule.dow}
+	for i, field := range []cronField{cronMinute, cronHour, cronDom, cronMonth, cronDow} {
+		bits, err := field.parse(fields[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %s", ErrInvalidCronSpec, spec, err.Error())
+		}
+
+		*targets[i] = bits
+	}
+
+	if schedule.dow&(1<<7) != 0 {
+		schedule.dow |= 1 << 0
+	}
+
+	schedule.domRestricted = !strings.HasPrefix(fields[2], "*") && !strings.HasPrefix(fields[2], "?")
+	schedule.dowRestricted = !strings.HasPrefix(fields[4], "*") && !strings.HasPrefix(fields[4], "?")
+
+	return schedule, nil
+}
+
+func (f cronField) parse(expr string) (uint64, error) {
+	var bits uint64
+
+	for _, part := range strings.Split(expr, ",") {
+		low, high, step := f.min, f.max, 1
+
+		rangeExpr := part
+		if i := strings.IndexByte(part, '/'); i != -1 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid %s step %q", f.name, part)
+			}
+
+			step = n
+			rangeExpr = part[:i]
+		}
+
+		switch {
+		case rangeExpr == "*" || rangeExpr == "?":
+		case strings.Contains(rangeExpr, "-"):
+			bounds := strings.SplitN(rangeExpr, "-", 2)
+
+			var err error
+			if low, err = f.value(bounds[0]); err != nil {
+				return 0, err
+			}
+			if high, err = f.value(bounds[1]); err != nil {
+				return 0, err
+			}
+			if low > high {
+				return 0, fmt.Errorf("invalid %s range %q", f.name, rangeExpr)
+			}
+		default:
+			value, err := f.value(rangeExpr)
+			if err != nil {
+				return 0, err
+			}
+
+			low = value
+			if step == 1 {
+				high = value
+			}
+		}
+
+		for v := low; v <= high; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+
+	return bits, nil
+}
+
+func (f cronField) value(expr string) (int, error) {
+	if value, ok := f.names[strings.ToLower(expr)]; ok {
+		return value, nil
+	}
+
+	value, err := strconv.Atoi(expr)
+	if err != nil || value < f.min || value > f.max {
+		return 0, fmt.Errorf("invalid %s %q", f.name, expr)
+	}
+
+	return value, nil
+}
+
+// match reports whether the minute of the time value matches the schedule.
+func (s *cronSchedule) match(value time.Time) bool {
+	value = value.In(s.location)
+
+	if s.minute&(1<<uint(value.Minute())) == 0 ||
+		s.hour&(1<<uint(value.Hour())) == 0 ||
+		s.month&(1<<uint(value.Month())) == 0 {
+		return false
+	}
+
+	domMatch := s.dom&(1<<uint(value.Day())) != 0
+	dowMatch := s.dow&(1<<uint(value.Weekday())) != 0
+
+	if s.domRestricted && s.dowRestricted {
+		return domMatch || dowMatch
+	}
+
+	return domMatch && dowMatch
+}
@@ -0,0 +1,128 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCron(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	at := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2026, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		spec     string
+		matching []time.Time
+		others   []time.Time
+	}{
+		{
+			spec:     "* * * * *",
+			matching: []time.Time{at(time.January, 7, 14, 30), at(time.December, 31, 23, 59)},
+		},
+		{
+			spec:     "*/15 9-17 * * *",
+			matching: []time.Time{at(time.January, 7, 9, 0), at(time.January, 7, 17, 45)},
+			others:   []time.Time{at(time.January, 7, 9, 10), at(time.January, 7, 18, 0)},
+		},
+		{
+			spec:     "5/20 * * * *",
+			matching: []time.Time{at(time.January, 7, 9, 5), at(time.January, 7, 9, 25), at(time.January, 7, 9, 45)},
+			others:   []time.Time{at(time.January, 7, 9, 0), at(time.January, 7, 9, 20)},
+		},
+		{
+			spec:     "0 0 1,15 jan-mar *",
+			matching: []time.Time{at(time.January, 1, 0, 0), at(time.March, 15, 0, 0)},
+			others:   []time.Time{at(time.April, 1, 0, 0), at(time.January, 2, 0, 0)},
+		},
+		{
+			// Sunday, written as 7
+			spec:     "0 12 * * 7",
+			matching: []time.Time{at(time.January, 4, 12, 0)},
+			others:   []time.Time{at(time.January, 5, 12, 0)},
+		},
+		{
+			// day of month OR day of week
+			spec:     "0 0 13 * FRI",
+			matching: []time.Time{at(time.February, 13, 0, 0), at(time.January, 13, 0, 0), at(time.January, 9, 0, 0)},
+			others:   []time.Time{at(time.January, 8, 0, 0)},
+		},
+		{
+			spec:     "@daily",
+			matching: []time.Time{at(time.January, 7, 0, 0)},
+			others:   []time.Time{at(time.January, 7, 0, 1)},
+		},
+		{
+			spec:     "CRON_TZ=Asia/Tokyo 0 9 * * *",
+			matching: []time.Time{at(time.January, 7, 0, 0)},
+			others:   []time.Time{at(time.January, 7, 9, 0)},
+		},
+		{
+			// "*/2" is not restricted: odd days of month AND Mondays
+			spec:     "CRON_TZ=Europe/Paris 0 0 */2 * 1",
+			matching: []time.Time{at(time.January, 4, 23, 0), at(time.January, 18, 23, 0)},
+			others:   []time.Time{at(time.January, 11, 23, 0), at(time.January, 6, 23, 0), at(time.January, 5, 0, 0)},
+		},
+		{
+			// "*" day of week: the day of month alone
+			spec:     "CRON_TZ=Europe/Paris 0 0 1 * *",
+			matching: []time.Time{at(time.January, 31, 23, 0), at(time.May, 31, 22, 0)},
+			others:   []time.Time{at(time.February, 1, 0, 0), at(time.January, 1, 0, 0), at(time.January, 4, 23, 0)},
+		},
+	}
+
+	for _, tt := range tests {
+		schedule, err := parseCron(tt.spec)
+		is.NoError(err, tt.spec)
+
+		for _, value := range tt.matching {
+			is.True(schedule.match(value), "%s should match %s", tt.spec, value)
+		}
+
+		for _, value := range tt.others {
+			is.False(schedule.match(value), "%s should not match %s", tt.spec, value)
+		}
+	}
+}
+
+func TestParseCron_Invalid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"10-5 * * * *",
+		"a * * * *",
+		"CRON_TZ=Nowhere/Nowhere * * * * *",
+		"CRON_TZ=UTC",
+	} {
+		_, err := parseCron(spec)
+		is.ErrorIs(err, ErrInvalidCronSpec, spec)
+	}
+}
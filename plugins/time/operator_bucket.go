@@ -0,0 +1,58 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"time"
+
+	"github.com/samber/ro"
+)
+
+// BucketBy returns an operator that maps each time value to the start of its bucket
+// of duration d. Buckets are aligned on the zero time, like time.Time.Truncate, so
+// that a 5-minute bucket starts at :00, :05, :10... Time values are emitted
+// unchanged if d <= 0.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(
+//	        time.Date(2026, time.January, 7, 14, 31, 0, 0, time.UTC),
+//	        time.Date(2026, time.January, 7, 14, 36, 0, 0, time.UTC),
+//	    ),
+//	    rotime.BucketBy(5*time.Minute),
+//	)
+//
+// The observable then emits: time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+// time.Date(2026, time.January, 7, 14, 35, 0, 0, time.UTC).
+func BucketBy(d time.Duration) func(ro.Observable[time.Time]) ro.Observable[time.Time] {
+	return ro.Map(BucketKey(d, func(value time.Time) time.Time { return value }))
+}
+
+// BucketKey returns a function mapping an item to the start of the bucket of duration
+// d holding its timestamp, as returned by selector. It is designed to be used as
+// the key of ro.GroupBy, for per-interval aggregation. See BucketBy.
+//
+// Example:
+//
+//	groups := ro.Pipe1(
+//	    events,
+//	    ro.GroupBy(rotime.BucketKey(time.Minute, func(e Event) time.Time { return e.CreatedAt })),
+//	)
+func BucketKey[T any](d time.Duration, selector func(item T) time.Time) func(item T) time.Time {
+	return func(item T) time.Time {
+		return selector(item).Truncate(d)
+	}
+}
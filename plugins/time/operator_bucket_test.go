@@ -0,0 +1,86 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucketBy(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Just(
+					time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+					time.Date(2026, time.January, 7, 14, 34, 59, 0, time.UTC),
+					time.Date(2026, time.January, 7, 14, 35, 0, 0, time.UTC),
+				),
+				BucketBy(5*time.Minute),
+			),
+		)
+		is.Nil(err)
+		is.Equal([]time.Time{
+			time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+			time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+			time.Date(2026, time.January, 7, 14, 35, 0, 0, time.UTC),
+		}, values)
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				BucketBy(time.Minute),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
+
+func TestBucketKey(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type event struct {
+		name string
+		at   time.Time
+	}
+
+	values, err := ro.Collect(
+		ro.Pipe2(
+			ro.Just(
+				event{"a", time.Date(2026, time.January, 7, 14, 30, 10, 0, time.UTC)},
+				event{"b", time.Date(2026, time.January, 7, 14, 31, 0, 0, time.UTC)},
+				event{"c", time.Date(2026, time.January, 7, 14, 30, 50, 0, time.UTC)},
+			),
+			ro.GroupBy(BucketKey(time.Minute, func(e event) time.Time { return e.at })),
+			ro.MergeMap(func(group ro.Observable[event]) ro.Observable[int64] {
+				return ro.Count[event]()(group)
+			}),
+		),
+	)
+	is.Nil(err)
+	is.ElementsMatch([]int64{2, 1}, values)
+}
@@ -0,0 +1,64 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"time"
+
+	"github.com/samber/ro"
+)
+
+// IsBusinessDay returns an operator that filters out time values falling on a
+// Saturday, a Sunday or a holiday of the calendar. A nil calendar has no holidays.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(
+//	        time.Date(2026, time.January, 1, 9, 0, 0, 0, time.UTC), // holiday
+//	        time.Date(2026, time.January, 2, 9, 0, 0, 0, time.UTC), // Friday
+//	        time.Date(2026, time.January, 3, 9, 0, 0, 0, time.UTC), // Saturday
+//	    ),
+//	    rotime.IsBusinessDay(rotime.NewHolidayCalendar(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))),
+//	)
+//
+// The observable then emits: time.Date(2026, time.January, 2, 9, 0, 0, 0, time.UTC).
+func IsBusinessDay(calendar Calendar) func(ro.Observable[time.Time]) ro.Observable[time.Time] {
+	return ro.Filter(
+		func(value time.Time) bool {
+			return isBusinessDay(value, calendar)
+		},
+	)
+}
+
+// AddBusinessDays returns an operator that shifts each time value by n business days,
+// skipping Saturdays, Sundays and the holidays of the calendar. A negative n shifts
+// backward. The time of day is kept. A nil calendar has no holidays.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(time.Date(2026, time.January, 9, 14, 30, 0, 0, time.UTC)), // Friday
+//	    rotime.AddBusinessDays(1, nil),
+//	)
+//
+// The observable then emits: time.Date(2026, time.January, 12, 14, 30, 0, 0, time.UTC).
+func AddBusinessDays(n int, calendar Calendar) func(ro.Observable[time.Time]) ro.Observable[time.Time] {
+	return ro.Map(
+		func(value time.Time) time.Time {
+			return addBusinessDays(value, n, calendar)
+		},
+	)
+}
@@ -0,0 +1,170 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+var newYearCalendar = NewHolidayCalendar(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))
+
+type timeAddBusinessDaysTest struct {
+	input    time.Time
+	days     int
+	calendar Calendar
+	expected time.Time
+}
+
+var addBusinessDaysTests = []timeAddBusinessDaysTest{
+	{
+		// Wednesday -> Thursday
+		input:    time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+		days:     1,
+		expected: time.Date(2026, time.January, 8, 14, 30, 0, 0, time.UTC),
+	},
+	{
+		// Friday -> Monday
+		input:    time.Date(2026, time.January, 9, 14, 30, 0, 0, time.UTC),
+		days:     1,
+		expected: time.Date(2026, time.January, 12, 14, 30, 0, 0, time.UTC),
+	},
+	{
+		// Saturday -> Monday
+		input:    time.Date(2026, time.January, 10, 14, 30, 0, 0, time.UTC),
+		days:     1,
+		expected: time.Date(2026, time.January, 12, 14, 30, 0, 0, time.UTC),
+	},
+	{
+		// Wednesday -> Wednesday, two weeks later
+		input:    time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+		days:     10,
+		expected: time.Date(2026, time.January, 21, 14, 30, 0, 0, time.UTC),
+	},
+	{
+		// Monday -> Friday, backward
+		input:    time.Date(2026, time.January, 12, 14, 30, 0, 0, time.UTC),
+		days:     -1,
+		expected: time.Date(2026, time.January, 9, 14, 30, 0, 0, time.UTC),
+	},
+	{
+		// Wednesday, Dec 31 -> Friday, Jan 2, skipping the holiday
+		input:    time.Date(2025, time.December, 31, 14, 30, 0, 0, time.UTC),
+		days:     1,
+		calendar: newYearCalendar,
+		expected: time.Date(2026, time.January, 2, 14, 30, 0, 0, time.UTC),
+	},
+	{
+		// Friday, Jan 2 -> Wednesday, Dec 31, backward, skipping the holiday
+		input:    time.Date(2026, time.January, 2, 14, 30, 0, 0, time.UTC),
+		days:     -1,
+		calendar: newYearCalendar,
+		expected: time.Date(2025, time.December, 31, 14, 30, 0, 0, time.UTC),
+	},
+	{
+		input:    time.Date(2026, time.January, 10, 14, 30, 0, 0, time.UTC),
+		days:     0,
+		expected: time.Date(2026, time.January, 10, 14, 30, 0, 0, time.UTC),
+	},
+}
+
+func TestIsBusinessDay(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Just(
+					time.Date(2026, time.January, 1, 9, 0, 0, 0, time.UTC),
+					time.Date(2026, time.January, 2, 9, 0, 0, 0, time.UTC),
+					time.Date(2026, time.January, 3, 9, 0, 0, 0, time.UTC),
+					time.Date(2026, time.January, 4, 9, 0, 0, 0, time.UTC),
+					time.Date(2026, time.January, 5, 9, 0, 0, 0, time.UTC),
+				),
+				IsBusinessDay(newYearCalendar),
+			),
+		)
+		is.Nil(err)
+		is.Equal([]time.Time{
+			time.Date(2026, time.January, 2, 9, 0, 0, 0, time.UTC),
+			time.Date(2026, time.January, 5, 9, 0, 0, 0, time.UTC),
+		}, values)
+	})
+
+	t.Run("Test nil calendar case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Just(
+					time.Date(2026, time.January, 1, 9, 0, 0, 0, time.UTC),
+					time.Date(2026, time.January, 3, 9, 0, 0, 0, time.UTC),
+				),
+				IsBusinessDay(nil),
+			),
+		)
+		is.Nil(err)
+		is.Equal([]time.Time{time.Date(2026, time.January, 1, 9, 0, 0, 0, time.UTC)}, values)
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				IsBusinessDay(nil),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		for _, tt := range addBusinessDaysTests {
+			values, err := ro.Collect(
+				ro.Pipe1(
+					ro.Just(tt.input),
+					AddBusinessDays(tt.days, tt.calendar),
+				),
+			)
+			is.Nil(err)
+			is.Equal([]time.Time{tt.expected}, values)
+		}
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				AddBusinessDays(1, nil),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
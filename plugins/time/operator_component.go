@@ -0,0 +1,95 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"time"
+
+	"github.com/samber/ro"
+)
+
+// YearWeek is an ISO 8601 week. The ISO year may differ from the calendar year in
+// early January and late December.
+type YearWeek struct {
+	Year int
+	Week int
+}
+
+// ISOWeek returns an operator that emits the ISO 8601 year and week number of each
+// time value.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(time.Date(2027, time.January, 1, 14, 30, 0, 0, time.UTC)),
+//	    rotime.ISOWeek(),
+//	)
+//
+// The observable then emits: rotime.YearWeek{Year: 2026, Week: 53}.
+func ISOWeek() func(ro.Observable[time.Time]) ro.Observable[YearWeek] {
+	return ro.Map(
+		func(value time.Time) YearWeek {
+			year, week := value.ISOWeek()
+			return YearWeek{Year: year, Week: week}
+		},
+	)
+}
+
+// Quarter returns an operator that emits the quarter of each time value, in [1, 4].
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(time.Date(2026, time.May, 7, 14, 30, 0, 0, time.UTC)),
+//	    rotime.Quarter(),
+//	)
+//
+// The observable then emits: 2.
+func Quarter() func(ro.Observable[time.Time]) ro.Observable[int] {
+	return ro.Map(
+		func(value time.Time) int {
+			return (int(value.Month())-1)/3 + 1
+		},
+	)
+}
+
+// DayOfYear returns an operator that emits the day of the year of each time value,
+// in [1, 365] for non-leap years and [1, 366] for leap years.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(time.Date(2026, time.February, 1, 14, 30, 0, 0, time.UTC)),
+//	    rotime.DayOfYear(),
+//	)
+//
+// The observable then emits: 32.
+func DayOfYear() func(ro.Observable[time.Time]) ro.Observable[int] {
+	return ro.Map(time.Time.YearDay)
+}
+
+// Weekday returns an operator that emits the day of the week of each time value.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC)),
+//	    rotime.Weekday(),
+//	)
+//
+// The observable then emits: time.Wednesday.
+func Weekday() func(ro.Observable[time.Time]) ro.Observable[time.Weekday] {
+	return ro.Map(time.Time.Weekday)
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+var componentInputs = []time.Time{
+	time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+	time.Date(2026, time.May, 7, 0, 0, 0, 0, time.UTC),
+	time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(2024, time.December, 31, 23, 59, 59, 0, time.UTC),
+}
+
+func TestISOWeek(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Just(componentInputs...),
+				ISOWeek(),
+			),
+		)
+		is.Nil(err)
+		is.Equal([]YearWeek{
+			{Year: 2026, Week: 2},
+			{Year: 2026, Week: 19},
+			{Year: 2026, Week: 53},
+			{Year: 2025, Week: 1},
+		}, values)
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				ISOWeek(),
+			),
+		)
+		is.Equal([]YearWeek{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
+
+func TestQuarter(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Just(componentInputs...),
+				Quarter(),
+			),
+		)
+		is.Nil(err)
+		is.Equal([]int{1, 2, 1, 4}, values)
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				Quarter(),
+			),
+		)
+		is.Equal([]int{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
+
+func TestDayOfYear(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Just(componentInputs...),
+				DayOfYear(),
+			),
+		)
+		is.Nil(err)
+		is.Equal([]int{7, 127, 1, 366}, values)
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				DayOfYear(),
+			),
+		)
+		is.Equal([]int{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
+
+func TestWeekday(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Just(componentInputs...),
+				Weekday(),
+			),
+		)
+		is.Nil(err)
+		is.Equal([]time.Weekday{time.Wednesday, time.Thursday, time.Friday, time.Tuesday}, values)
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				Weekday(),
+			),
+		)
+		is.Equal([]time.Weekday{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
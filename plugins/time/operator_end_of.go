@@ -0,0 +1,113 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"time"
+
+	"github.com/samber/ro"
+)
+
+// EndOfDay returns an operator that moves each time value to the last nanosecond of its day.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC)),
+//	    rotime.EndOfDay(),
+//	)
+//
+// The observable then emits: time.Date(2026, time.January, 7, 23, 59, 59, 999999999, time.UTC).
+func EndOfDay() func(ro.Observable[time.Time]) ro.Observable[time.Time] {
+	return ro.Map(
+		func(value time.Time) time.Time {
+			return startOfDay(value).AddDate(0, 0, 1).Add(-time.Nanosecond)
+		},
+	)
+}
+
+// EndOfWeek returns an operator that moves each time value to the last nanosecond of its week.
+// Weeks start on the given weekday, usually time.Sunday or time.Monday.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC)),
+//	    rotime.EndOfWeek(time.Monday),
+//	)
+//
+// The observable then emits: time.Date(2026, time.January, 11, 23, 59, 59, 999999999, time.UTC).
+func EndOfWeek(weekStart time.Weekday) func(ro.Observable[time.Time]) ro.Observable[time.Time] {
+	return ro.Map(
+		func(value time.Time) time.Time {
+			return startOfWeek(value, weekStart).AddDate(0, 0, 7).Add(-time.Nanosecond)
+		},
+	)
+}
+
+// EndOfMonth returns an operator that moves each time value to the last nanosecond of its month.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(time.Date(2026, time.February, 7, 14, 30, 0, 0, time.UTC)),
+//	    rotime.EndOfMonth(),
+//	)
+//
+// The observable then emits: time.Date(2026, time.February, 28, 23, 59, 59, 999999999, time.UTC).
+func EndOfMonth() func(ro.Observable[time.Time]) ro.Observable[time.Time] {
+	return ro.Map(
+		func(value time.Time) time.Time {
+			return startOfMonth(value).AddDate(0, 1, 0).Add(-time.Nanosecond)
+		},
+	)
+}
+
+// EndOfQuarter returns an operator that moves each time value to the last nanosecond of its
+// quarter (March, June, September or December).
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(time.Date(2026, time.May, 7, 14, 30, 0, 0, time.UTC)),
+//	    rotime.EndOfQuarter(),
+//	)
+//
+// The observable then emits: time.Date(2026, time.June, 30, 23, 59, 59, 999999999, time.UTC).
+func EndOfQuarter() func(ro.Observable[time.Time]) ro.Observable[time.Time] {
+	return ro.Map(
+		func(value time.Time) time.Time {
+			return startOfQuarter(value).AddDate(0, 3, 0).Add(-time.Nanosecond)
+		},
+	)
+}
+
+// EndOfYear returns an operator that moves each time value to the last nanosecond of its year.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(time.Date(2026, time.May, 7, 14, 30, 0, 0, time.UTC)),
+//	    rotime.EndOfYear(),
+//	)
+//
+// The observable then emits: time.Date(2026, time.December, 31, 23, 59, 59, 999999999, time.UTC).
+func EndOfYear() func(ro.Observable[time.Time]) ro.Observable[time.Time] {
+	return ro.Map(
+		func(value time.Time) time.Time {
+			return startOfYear(value).AddDate(1, 0, 0).Add(-time.Nanosecond)
+		},
+	)
+}
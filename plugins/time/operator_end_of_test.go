@@ -0,0 +1,318 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+var endOfDayTests = []timeTruncateTest{
+	{
+		input:    time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+		expected: time.Date(2026, time.January, 7, 23, 59, 59, 999999999, time.UTC),
+	},
+	{
+		input:    time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC),
+		expected: time.Date(2025, time.December, 31, 23, 59, 59, 999999999, time.UTC),
+	},
+	{
+		input:    time.Date(2026, time.January, 7, 14, 30, 0, 0, time.FixedZone("CET", 1*60*60)),
+		expected: time.Date(2026, time.January, 7, 23, 59, 59, 999999999, time.FixedZone("CET", 1*60*60)),
+	},
+}
+
+var endOfWeekTests = []timeTruncateTest{
+	{
+		input:    time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+		expected: time.Date(2026, time.January, 11, 23, 59, 59, 999999999, time.UTC),
+	},
+	{
+		input:    time.Date(2026, time.January, 11, 23, 59, 59, 999999999, time.UTC),
+		expected: time.Date(2026, time.January, 11, 23, 59, 59, 999999999, time.UTC),
+	},
+	{
+		input:    time.Date(2025, time.December, 29, 0, 0, 0, 0, time.UTC),
+		expected: time.Date(2026, time.January, 4, 23, 59, 59, 999999999, time.UTC),
+	},
+}
+
+var endOfMonthTests = []timeTruncateTest{
+	{
+		input:    time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+		expected: time.Date(2026, time.January, 31, 23, 59, 59, 999999999, time.UTC),
+	},
+	{
+		input:    time.Date(2026, time.February, 7, 0, 0, 0, 0, time.UTC),
+		expected: time.Date(2026, time.February, 28, 23, 59, 59, 999999999, time.UTC),
+	},
+	{
+		input:    time.Date(2024, time.February, 7, 0, 0, 0, 0, time.UTC),
+		expected: time.Date(2024, time.February, 29, 23, 59, 59, 999999999, time.UTC),
+	},
+	{
+		input:    time.Date(2025, time.December, 1, 0, 0, 0, 0, time.UTC),
+		expected: time.Date(2025, time.December, 31, 23, 59, 59, 999999999, time.UTC),
+	},
+}
+
+var endOfQuarterTests = []timeTruncateTest{
+	{
+		input:    time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+		expected: time.Date(2026, time.March, 31, 23, 59, 59, 999999999, time.UTC),
+	},
+	{
+		input:    time.Date(2026, time.May, 7, 0, 0, 0, 0, time.UTC),
+		expected: time.Date(2026, time.June, 30, 23, 59, 59, 999999999, time.UTC),
+	},
+	{
+		input:    time.Date(2026, time.November, 30, 0, 0, 0, 0, time.UTC),
+		expected: time.Date(2026, time.December, 31, 23, 59, 59, 999999999, time.UTC),
+	},
+}
+
+var endOfYearTests = []timeTruncateTest{
+	{
+		input:    time.Date(2026, time.May, 7, 14, 30, 0, 0, time.UTC),
+		expected: time.Date(2026, time.December, 31, 23, 59, 59, 999999999, time.UTC),
+	},
+	{
+		input:    time.Date(2026, time.December, 31, 23, 59, 59, 999999999, time.UTC),
+		expected: time.Date(2026, time.December, 31, 23, 59, 59, 999999999, time.UTC),
+	},
+}
+
+func TestEndOfDay(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		for _, tt := range endOfDayTests {
+			values, err := ro.Collect(
+				ro.Pipe1(
+					ro.Just(tt.input),
+					EndOfDay(),
+				),
+			)
+			is.Nil(err)
+			is.Equal([]time.Time{tt.expected}, values)
+		}
+	})
+
+	t.Run("Test empty observable case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Empty[time.Time](),
+				EndOfDay(),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.Nil(err)
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				EndOfDay(),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
+
+func TestEndOfWeek(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		for _, tt := range endOfWeekTests {
+			values, err := ro.Collect(
+				ro.Pipe1(
+					ro.Just(tt.input),
+					EndOfWeek(time.Monday),
+				),
+			)
+			is.Nil(err)
+			is.Equal([]time.Time{tt.expected}, values)
+		}
+	})
+
+	t.Run("Test empty observable case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Empty[time.Time](),
+				EndOfWeek(time.Monday),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.Nil(err)
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				EndOfWeek(time.Monday),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
+
+func TestEndOfMonth(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		for _, tt := range endOfMonthTests {
+			values, err := ro.Collect(
+				ro.Pipe1(
+					ro.Just(tt.input),
+					EndOfMonth(),
+				),
+			)
+			is.Nil(err)
+			is.Equal([]time.Time{tt.expected}, values)
+		}
+	})
+
+	t.Run("Test empty observable case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Empty[time.Time](),
+				EndOfMonth(),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.Nil(err)
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				EndOfMonth(),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
+
+func TestEndOfQuarter(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		for _, tt := range endOfQuarterTests {
+			values, err := ro.Collect(
+				ro.Pipe1(
+					ro.Just(tt.input),
+					EndOfQuarter(),
+				),
+			)
+			is.Nil(err)
+			is.Equal([]time.Time{tt.expected}, values)
+		}
+	})
+
+	t.Run("Test empty observable case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Empty[time.Time](),
+				EndOfQuarter(),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.Nil(err)
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				EndOfQuarter(),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
+
+func TestEndOfYear(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		for _, tt := range endOfYearTests {
+			values, err := ro.Collect(
+				ro.Pipe1(
+					ro.Just(tt.input),
+					EndOfYear(),
+				),
+			)
+			is.Nil(err)
+			is.Equal([]time.Time{tt.expected}, values)
+		}
+	})
+
+	t.Run("Test empty observable case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Empty[time.Time](),
+				EndOfYear(),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.Nil(err)
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				EndOfYear(),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
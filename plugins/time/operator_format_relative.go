@@ -0,0 +1,125 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/samber/ro"
+)
+
+// ErrUnsupportedLanguage is the panic value of FormatRelative for an unknown language.
+var ErrUnsupportedLanguage = errors.New("rotime.FormatRelative: unsupported language")
+
+type relativeUnit struct {
+	duration time.Duration
+	singular string
+	plural   string
+}
+
+type relativeLanguage struct {
+	now    string
+	past   string
+	future string
+	units  []relativeUnit // from the largest to the smallest
+}
+
+var relativeLanguages = map[string]relativeLanguage{
+	"en": {
+		now:    "just now",
+		past:   "%s ago",
+		future: "in %s",
+		units: []relativeUnit{
+			{365 * 24 * time.Hour, "year", "years"},
+			{30 * 24 * time.Hour, "month", "months"},
+			{24 * time.Hour, "day", "days"},
+			{time.Hour, "hour", "hours"},
+			{time.Minute, "minute", "minutes"},
+			{time.Second, "second", "seconds"},
+		},
+	},
+	"fr": {
+		now:    "à l'instant",
+		past:   "il y a %s",
+		future: "dans %s",
+		units: []relativeUnit{
+			{365 * 24 * time.Hour, "an", "ans"},
+			{30 * 24 * time.Hour, "mois", "mois"},
+			{24 * time.Hour, "jour", "jours"},
+			{time.Hour, "heure", "heures"},
+			{time.Minute, "minute", "minutes"},
+			{time.Second, "seconde", "secondes"},
+		},
+	},
+}
+
+// FormatRelative returns an operator that formats each time value relatively to the
+// time returned by ref, such as "3 minutes ago" or "in 2 days". The largest unit is
+// used, and the count is rounded down. Months are 30 days and years are 365 days.
+// A nil ref defaults to time.Now.
+//
+// Supported languages are "en" and "fr". It panics with ErrUnsupportedLanguage
+// for other languages.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(time.Now().Add(-3*time.Minute)),
+//	    rotime.FormatRelative(time.Now, "en"),
+//	)
+//
+// The observable then emits: "3 minutes ago".
+func FormatRelative(ref func() time.Time, lang string) func(ro.Observable[time.Time]) ro.Observable[string] {
+	language, ok := relativeLanguages[lang]
+	if !ok {
+		panic(fmt.Errorf("%w: %q", ErrUnsupportedLanguage, lang))
+	}
+
+	if ref == nil {
+		ref = time.Now
+	}
+
+	return ro.Map(
+		func(value time.Time) string {
+			return formatRelative(value.Sub(ref()), language)
+		},
+	)
+}
+
+func formatRelative(diff time.Duration, language relativeLanguage) string {
+	format := language.future
+	if diff < 0 {
+		format = language.past
+		diff = -diff
+	}
+
+	for _, unit := range language.units {
+		count := int64(diff / unit.duration)
+		if count == 0 {
+			continue
+		}
+
+		label := unit.plural
+		if count == 1 {
+			label = unit.singular
+		}
+
+		return fmt.Sprintf(format, fmt.Sprintf("%d %s", count, label))
+	}
+
+	return language.now
+}
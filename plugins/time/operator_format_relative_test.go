@@ -0,0 +1,101 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+type timeFormatRelativeTest struct {
+	input    time.Time
+	lang     string
+	expected string
+}
+
+var relativeRef = time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC)
+
+var formatRelativeTests = []timeFormatRelativeTest{
+	{input: relativeRef, lang: "en", expected: "just now"},
+	{input: relativeRef.Add(-500 * time.Millisecond), lang: "en", expected: "just now"},
+	{input: relativeRef.Add(-1 * time.Second), lang: "en", expected: "1 second ago"},
+	{input: relativeRef.Add(-3*time.Minute - 20*time.Second), lang: "en", expected: "3 minutes ago"},
+	{input: relativeRef.Add(time.Hour), lang: "en", expected: "in 1 hour"},
+	{input: relativeRef.AddDate(0, 0, 2), lang: "en", expected: "in 2 days"},
+	{input: relativeRef.AddDate(0, 0, -45), lang: "en", expected: "1 month ago"},
+	{input: relativeRef.AddDate(-3, 0, 0), lang: "en", expected: "3 years ago"},
+	{input: relativeRef, lang: "fr", expected: "à l'instant"},
+	{input: relativeRef.Add(-3 * time.Minute), lang: "fr", expected: "il y a 3 minutes"},
+	{input: relativeRef.AddDate(0, 0, 2), lang: "fr", expected: "dans 2 jours"},
+	{input: relativeRef.AddDate(0, -2, 0), lang: "fr", expected: "il y a 2 mois"},
+	{input: relativeRef.AddDate(1, 0, 0), lang: "fr", expected: "dans 1 an"},
+}
+
+func TestFormatRelative(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		ref := func() time.Time { return relativeRef }
+
+		for _, tt := range formatRelativeTests {
+			values, err := ro.Collect(
+				ro.Pipe1(
+					ro.Just(tt.input),
+					FormatRelative(ref, tt.lang),
+				),
+			)
+			is.Nil(err)
+			is.Equal([]string{tt.expected}, values, tt.input.String())
+		}
+	})
+
+	t.Run("Test default reference case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Just(time.Now().Add(-3*time.Hour)),
+				FormatRelative(nil, "en"),
+			),
+		)
+		is.Equal([]string{"3 hours ago"}, values)
+		is.Nil(err)
+	})
+
+	t.Run("Test unsupported language case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		is.PanicsWithError(`rotime.FormatRelative: unsupported language: "xx"`, func() {
+			FormatRelative(nil, "xx")
+		})
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				FormatRelative(nil, "en"),
+			),
+		)
+		is.Equal([]string{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
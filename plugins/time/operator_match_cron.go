@@ -0,0 +1,55 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"errors"
+	"time"
+
+	"github.com/samber/ro"
+)
+
+// ErrInvalidCronSpec is the panic value of MatchCron for an invalid cron expression.
+var ErrInvalidCronSpec = errors.New("rotime.MatchCron: invalid cron expression")
+
+// MatchCron returns an operator that passes through only the time values matching a
+// cron expression, such as time values emitted by ro.Interval or event timestamps.
+// It panics with an error wrapping ErrInvalidCronSpec for an invalid expression.
+//
+// The expression has the standard 5 fields (minute, hour, day of month, month, day
+// of week), supporting "*", lists, ranges, steps and month or weekday names, or is
+// a descriptor such as "@hourly" or "@daily". Time values are matched at the minute
+// level: seconds are ignored. The expression is evaluated in the local time zone,
+// unless prefixed with "CRON_TZ=<location> ".
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(
+//	        time.Date(2026, time.January, 7, 9, 0, 30, 0, time.UTC),  // Wednesday
+//	        time.Date(2026, time.January, 10, 9, 0, 0, 0, time.UTC), // Saturday
+//	    ),
+//	    rotime.MatchCron("CRON_TZ=UTC 0 9 * * MON-FRI"),
+//	)
+//
+// The observable then emits: time.Date(2026, time.January, 7, 9, 0, 30, 0, time.UTC).
+func MatchCron(spec string) func(ro.Observable[time.Time]) ro.Observable[time.Time] {
+	schedule, err := parseCron(spec)
+	if err != nil {
+		panic(err)
+	}
+
+	return ro.Filter(schedule.match)
+}
@@ -0,0 +1,68 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchCron(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Just(
+					time.Date(2026, time.January, 7, 9, 0, 30, 0, time.UTC),
+					time.Date(2026, time.January, 7, 9, 1, 0, 0, time.UTC),
+					time.Date(2026, time.January, 10, 9, 0, 0, 0, time.UTC),
+					time.Date(2026, time.January, 8, 10, 0, 0, 0, time.FixedZone("CET", 1*60*60)),
+				),
+				MatchCron("CRON_TZ=UTC 0 9 * * MON-FRI"),
+			),
+		)
+		is.Nil(err)
+		is.Equal([]time.Time{
+			time.Date(2026, time.January, 7, 9, 0, 30, 0, time.UTC),
+			time.Date(2026, time.January, 8, 10, 0, 0, 0, time.FixedZone("CET", 1*60*60)),
+		}, values)
+	})
+
+	t.Run("Test invalid expression case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		is.Panics(func() {
+			MatchCron("* * *")
+		})
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				MatchCron("@hourly"),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/ro"
+)
+
+// LayoutUnixEpoch is a pseudo-layout for ParseAny, matching integer Unix timestamps.
+// The unit (seconds, milliseconds, microseconds or nanoseconds) is detected from
+// the number of digits. Parsed times are in UTC.
+const LayoutUnixEpoch = "@unix"
+
+// ErrNoMatchingLayout is returned by ParseAny when no layout matches a value.
+var ErrNoMatchingLayout = errors.New("rotime.ParseAny: no matching layout")
+
+// ParseAny returns an operator that parses time strings, trying each layout in order
+// until one matches. It emits an error wrapping ErrNoMatchingLayout if none matches.
+// Use LayoutUnixEpoch to accept Unix timestamps. Without layouts, time.RFC3339Nano
+// and LayoutUnixEpoch are tried.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just("2026-01-07 14:30:00", "2026-01-07T14:30:00Z", "1767796200"),
+//	    rotime.ParseAny[string]("2006-01-02 15:04:05", time.RFC3339, rotime.LayoutUnixEpoch),
+//	)
+//
+// The observable then emits: time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC), 3 times.
+func ParseAny[T ~string](layouts ...string) func(ro.Observable[T]) ro.Observable[time.Time] {
+	if len(layouts) == 0 {
+		layouts = []string{time.RFC3339Nano, LayoutUnixEpoch}
+	}
+
+	return ro.MapErr(
+		func(value T) (time.Time, error) {
+			return parseAny(string(value), layouts)
+		},
+	)
+}
+
+func parseAny(value string, layouts []string) (time.Time, error) {
+	for _, layout := range layouts {
+		if layout == LayoutUnixEpoch {
+			if t, ok := parseUnixEpoch(value); ok {
+				return t, nil
+			}
+
+			continue
+		}
+
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%w: %q", ErrNoMatchingLayout, value)
+}
+
+func parseUnixEpoch(value string) (time.Time, bool) {
+	epoch, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	switch digits := len(strings.TrimPrefix(value, "-")); {
+	case digits <= 10:
+		return time.Unix(epoch, 0).UTC(), true
+	case digits <= 13:
+		return time.UnixMilli(epoch).UTC(), true
+	case digits <= 16:
+		return time.UnixMicro(epoch).UTC(), true
+	default:
+		return time.Unix(0, epoch).UTC(), true
+	}
+}
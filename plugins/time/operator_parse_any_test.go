@@ -0,0 +1,108 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAny(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Just("2026-01-07 14:30:00", "2026-01-07T14:30:00Z", "07/01/2026 14:30"),
+				ParseAny[string]("2006-01-02 15:04:05", time.RFC3339, "02/01/2006 15:04"),
+			),
+		)
+		is.Nil(err)
+		is.Equal([]time.Time{
+			time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+			time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+			time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+		}, values)
+	})
+
+	t.Run("Test Unix epoch cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Just("1767796200", "1767796200000", "1767796200000000", "1767796200000000000", "0"),
+				ParseAny[string](LayoutUnixEpoch),
+			),
+		)
+		is.Nil(err)
+		is.Equal([]time.Time{
+			time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+			time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+			time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+			time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+			time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC),
+		}, values)
+	})
+
+	t.Run("Test default layouts case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Just("2026-01-07T14:30:00.5Z", "1767796200"),
+				ParseAny[string](),
+			),
+		)
+		is.Nil(err)
+		is.Equal([]time.Time{
+			time.Date(2026, time.January, 7, 14, 30, 0, 500000000, time.UTC),
+			time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+		}, values)
+	})
+
+	t.Run("Test no matching layout case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Just("2026-01-07T14:30:00Z", "yesterday"),
+				ParseAny[string](time.RFC3339),
+			),
+		)
+		is.Equal([]time.Time{time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC)}, values)
+		is.ErrorIs(err, ErrNoMatchingLayout)
+		is.EqualError(err, `rotime.ParseAny: no matching layout: "yesterday"`)
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[string](assert.AnError),
+				ParseAny[string](),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
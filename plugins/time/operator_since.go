@@ -0,0 +1,82 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"time"
+
+	"github.com/samber/ro"
+)
+
+// Since returns an operator that emits the duration elapsed since each time value,
+// as returned by time.Since.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(time.Now().Add(-2*time.Hour)),
+//	    rotime.Since(),
+//	)
+//
+// The observable then emits: ~2 * time.Hour.
+func Since() func(ro.Observable[time.Time]) ro.Observable[time.Duration] {
+	return ro.Map(time.Since)
+}
+
+// Until returns an operator that emits the duration from each time value until the
+// reference time. The duration is negative for time values after the reference.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC)),
+//	    rotime.Until(time.Date(2026, time.January, 8, 0, 0, 0, 0, time.UTC)),
+//	)
+//
+// The observable then emits: 9*time.Hour + 30*time.Minute.
+func Until(ref time.Time) func(ro.Observable[time.Time]) ro.Observable[time.Duration] {
+	return ro.Map(
+		func(value time.Time) time.Duration {
+			return ref.Sub(value)
+		},
+	)
+}
+
+// Elapsed returns an operator that emits the duration between each time value and
+// the previous one. Nothing is emitted for the first time value.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(
+//	        time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+//	        time.Date(2026, time.January, 7, 14, 35, 0, 0, time.UTC),
+//	        time.Date(2026, time.January, 7, 15, 0, 0, 0, time.UTC),
+//	    ),
+//	    rotime.Elapsed(),
+//	)
+//
+// The observable then emits: 5*time.Minute, 25*time.Minute.
+func Elapsed() func(ro.Observable[time.Time]) ro.Observable[time.Duration] {
+	return func(source ro.Observable[time.Time]) ro.Observable[time.Duration] {
+		return ro.Pipe2(
+			source,
+			ro.Pairwise[time.Time](),
+			ro.Map(func(pair []time.Time) time.Duration {
+				return pair[1].Sub(pair[0])
+			}),
+		)
+	}
+}
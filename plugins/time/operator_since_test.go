@@ -0,0 +1,136 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSince(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Just(time.Now().Add(-2*time.Hour), time.Now().Add(time.Hour)),
+				Since(),
+			),
+		)
+		is.Nil(err)
+		is.Len(values, 2)
+		is.InDelta(2*time.Hour, values[0], float64(time.Second))
+		is.InDelta(-time.Hour, values[1], float64(time.Second))
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				Since(),
+			),
+		)
+		is.Equal([]time.Duration{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
+
+func TestUntil(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		ref := time.Date(2026, time.January, 8, 0, 0, 0, 0, time.UTC)
+
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Just(
+					time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+					ref,
+					time.Date(2026, time.January, 8, 1, 0, 0, 0, time.UTC),
+				),
+				Until(ref),
+			),
+		)
+		is.Nil(err)
+		is.Equal([]time.Duration{9*time.Hour + 30*time.Minute, 0, -time.Hour}, values)
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				Until(time.Now()),
+			),
+		)
+		is.Equal([]time.Duration{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
+
+func TestElapsed(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Just(
+					time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+					time.Date(2026, time.January, 7, 14, 35, 0, 0, time.UTC),
+					time.Date(2026, time.January, 7, 15, 0, 0, 0, time.UTC),
+					time.Date(2026, time.January, 7, 14, 0, 0, 0, time.UTC),
+				),
+				Elapsed(),
+			),
+		)
+		is.Nil(err)
+		is.Equal([]time.Duration{5 * time.Minute, 25 * time.Minute, -time.Hour}, values)
+	})
+
+	t.Run("Test single value case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Just(time.Now()),
+				Elapsed(),
+			),
+		)
+		is.Equal([]time.Duration{}, values)
+		is.Nil(err)
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				Elapsed(),
+			),
+		)
+		is.Equal([]time.Duration{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"time"
+
+	"github.com/samber/ro"
+)
+
+// StartOfWeek returns an operator that truncates each time value to the start of its week.
+// Weeks start on the given weekday, usually time.Sunday or time.Monday.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC)),
+//	    rotime.StartOfWeek(time.Monday),
+//	)
+//
+// The observable then emits: time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC).
+func StartOfWeek(weekStart time.Weekday) func(ro.Observable[time.Time]) ro.Observable[time.Time] {
+	return ro.Map(
+		func(value time.Time) time.Time {
+			return startOfWeek(value, weekStart)
+		},
+	)
+}
+
+// StartOfMonth returns an operator that truncates each time value to the start of its month.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC)),
+//	    rotime.StartOfMonth(),
+//	)
+//
+// The observable then emits: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC).
+func StartOfMonth() func(ro.Observable[time.Time]) ro.Observable[time.Time] {
+	return ro.Map(startOfMonth)
+}
+
+// StartOfQuarter returns an operator that truncates each time value to the start of its
+// quarter (January, April, July or October).
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(time.Date(2026, time.May, 7, 14, 30, 0, 0, time.UTC)),
+//	    rotime.StartOfQuarter(),
+//	)
+//
+// The observable then emits: time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC).
+func StartOfQuarter() func(ro.Observable[time.Time]) ro.Observable[time.Time] {
+	return ro.Map(startOfQuarter)
+}
+
+// StartOfYear returns an operator that truncates each time value to the start of its year.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(time.Date(2026, time.May, 7, 14, 30, 0, 0, time.UTC)),
+//	    rotime.StartOfYear(),
+//	)
+//
+// The observable then emits: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC).
+func StartOfYear() func(ro.Observable[time.Time]) ro.Observable[time.Time] {
+	return ro.Map(startOfYear)
+}
+
+func startOfDay(value time.Time) time.Time {
+	year, month, day := value.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, value.Location())
+}
+
+func startOfWeek(value time.Time, weekStart time.Weekday) time.Time {
+	offset := (int(value.Weekday()) - int(weekStart) + 7) % 7
+	year, month, day := value.Date()
+
+	return time.Date(year, month, day-offset, 0, 0, 0, 0, value.Location())
+}
+
+func startOfMonth(value time.Time) time.Time {
+	year, month, _ := value.Date()
+	return time.Date(year, month, 1, 0, 0, 0, 0, value.Location())
+}
+
+func startOfQuarter(value time.Time) time.Time {
+	year, month, _ := value.Date()
+	month = month - (month-1)%3
+
+	return time.Date(year, month, 1, 0, 0, 0, 0, value.Location())
+}
+
+func startOfYear(value time.Time) time.Time {
+	return time.Date(value.Year(), time.January, 1, 0, 0, 0, 0, value.Location())
+}
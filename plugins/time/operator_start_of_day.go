@@ -31,10 +31,5 @@ import (
 //
 // The observable then emits: time.Date(2026, time.January, 7, 0, 0, 0, 0, time.UTC).
 func StartOfDay() func(ro.Observable[time.Time]) ro.Observable[time.Time] {
-	return ro.Map(
-		func(value time.Time) time.Time {
-			year, month, day := value.Date()
-			return time.Date(year, month, day, 0, 0, 0, 0, value.Location())
-		},
-	)
+	return ro.Map(startOfDay)
 }
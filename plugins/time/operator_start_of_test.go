@@ -0,0 +1,330 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+var startOfWeekMondayTests = []timeTruncateTest{
+	{
+		input:    time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+		expected: time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		input:    time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC),
+		expected: time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		input:    time.Date(2026, time.January, 4, 23, 59, 59, 0, time.UTC),
+		expected: time.Date(2025, time.December, 29, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		input:    time.Date(2026, time.January, 7, 14, 30, 0, 0, time.FixedZone("CET", 1*60*60)),
+		expected: time.Date(2026, time.January, 5, 0, 0, 0, 0, time.FixedZone("CET", 1*60*60)),
+	},
+}
+
+var startOfWeekSundayTests = []timeTruncateTest{
+	{
+		input:    time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+		expected: time.Date(2026, time.January, 4, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		input:    time.Date(2026, time.January, 4, 0, 0, 0, 0, time.UTC),
+		expected: time.Date(2026, time.January, 4, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		input:    time.Date(2026, time.January, 3, 23, 59, 59, 0, time.UTC),
+		expected: time.Date(2025, time.December, 28, 0, 0, 0, 0, time.UTC),
+	},
+}
+
+var startOfMonthTests = []timeTruncateTest{
+	{
+		input:    time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+		expected: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		input:    time.Date(2024, time.February, 29, 23, 59, 59, 0, time.UTC),
+		expected: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		input:    time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC),
+		expected: time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		input:    time.Date(2026, time.January, 7, 14, 30, 0, 0, time.FixedZone("CET", 1*60*60)),
+		expected: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.FixedZone("CET", 1*60*60)),
+	},
+}
+
+var startOfQuarterTests = []timeTruncateTest{
+	{
+		input:    time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+		expected: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		input:    time.Date(2026, time.May, 7, 14, 30, 0, 0, time.UTC),
+		expected: time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		input:    time.Date(2026, time.September, 30, 23, 59, 59, 0, time.UTC),
+		expected: time.Date(2026, time.July, 1, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		input:    time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC),
+		expected: time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC),
+	},
+}
+
+var startOfYearTests = []timeTruncateTest{
+	{
+		input:    time.Date(2026, time.May, 7, 14, 30, 0, 0, time.UTC),
+		expected: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		input:    time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+		expected: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		input:    time.Date(2025, time.December, 31, 23, 59, 59, 0, time.FixedZone("CET", 1*60*60)),
+		expected: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.FixedZone("CET", 1*60*60)),
+	},
+}
+
+func TestStartOfWeek_Monday(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		for _, tt := range startOfWeekMondayTests {
+			values, err := ro.Collect(
+				ro.Pipe1(
+					ro.Just(tt.input),
+					StartOfWeek(time.Monday),
+				),
+			)
+			is.Nil(err)
+			is.Equal([]time.Time{tt.expected}, values)
+		}
+	})
+
+	t.Run("Test empty observable case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Empty[time.Time](),
+				StartOfWeek(time.Monday),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.Nil(err)
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				StartOfWeek(time.Monday),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
+
+func TestStartOfWeek_Sunday(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		for _, tt := range startOfWeekSundayTests {
+			values, err := ro.Collect(
+				ro.Pipe1(
+					ro.Just(tt.input),
+					StartOfWeek(time.Sunday),
+				),
+			)
+			is.Nil(err)
+			is.Equal([]time.Time{tt.expected}, values)
+		}
+	})
+
+	t.Run("Test empty observable case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Empty[time.Time](),
+				StartOfWeek(time.Sunday),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.Nil(err)
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				StartOfWeek(time.Sunday),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
+
+func TestStartOfMonth(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		for _, tt := range startOfMonthTests {
+			values, err := ro.Collect(
+				ro.Pipe1(
+					ro.Just(tt.input),
+					StartOfMonth(),
+				),
+			)
+			is.Nil(err)
+			is.Equal([]time.Time{tt.expected}, values)
+		}
+	})
+
+	t.Run("Test empty observable case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Empty[time.Time](),
+				StartOfMonth(),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.Nil(err)
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				StartOfMonth(),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
+
+func TestStartOfQuarter(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		for _, tt := range startOfQuarterTests {
+			values, err := ro.Collect(
+				ro.Pipe1(
+					ro.Just(tt.input),
+					StartOfQuarter(),
+				),
+			)
+			is.Nil(err)
+			is.Equal([]time.Time{tt.expected}, values)
+		}
+	})
+
+	t.Run("Test empty observable case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Empty[time.Time](),
+				StartOfQuarter(),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.Nil(err)
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				StartOfQuarter(),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
+
+func TestStartOfYear(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		for _, tt := range startOfYearTests {
+			values, err := ro.Collect(
+				ro.Pipe1(
+					ro.Just(tt.input),
+					StartOfYear(),
+				),
+			)
+			is.Nil(err)
+			is.Equal([]time.Time{tt.expected}, values)
+		}
+	})
+
+	t.Run("Test empty observable case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Empty[time.Time](),
+				StartOfYear(),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.Nil(err)
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				StartOfYear(),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
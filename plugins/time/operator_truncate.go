@@ -0,0 +1,100 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"time"
+
+	"github.com/samber/ro"
+)
+
+// Truncate returns an operator that rounds each time value down to a multiple of d,
+// since the zero time. It is useful to bucket timestamps to 5-minute or hourly
+// boundaries. Time values are emitted unchanged if d <= 0.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(time.Date(2026, time.January, 7, 14, 37, 12, 0, time.UTC)),
+//	    rotime.Truncate(5*time.Minute),
+//	)
+//
+// The observable then emits: time.Date(2026, time.January, 7, 14, 35, 0, 0, time.UTC).
+func Truncate(d time.Duration) func(ro.Observable[time.Time]) ro.Observable[time.Time] {
+	return ro.Map(
+		func(value time.Time) time.Time {
+			return value.Truncate(d)
+		},
+	)
+}
+
+// Round returns an operator that rounds each time value to the nearest multiple of d,
+// since the zero time. Halfway values are rounded up. Time values are emitted
+// unchanged if d <= 0.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(time.Date(2026, time.January, 7, 14, 37, 12, 0, time.UTC)),
+//	    rotime.Round(5*time.Minute),
+//	)
+//
+// The observable then emits: time.Date(2026, time.January, 7, 14, 35, 0, 0, time.UTC).
+func Round(d time.Duration) func(ro.Observable[time.Time]) ro.Observable[time.Time] {
+	return ro.Map(
+		func(value time.Time) time.Time {
+			return value.Round(d)
+		},
+	)
+}
+
+// TruncateDuration returns an operator that rounds each duration toward zero to a
+// multiple of m. Durations are emitted unchanged if m <= 0.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(1500*time.Millisecond),
+//	    rotime.TruncateDuration(time.Second),
+//	)
+//
+// The observable then emits: time.Second.
+func TruncateDuration(m time.Duration) func(ro.Observable[time.Duration]) ro.Observable[time.Duration] {
+	return ro.Map(
+		func(value time.Duration) time.Duration {
+			return value.Truncate(m)
+		},
+	)
+}
+
+// RoundDuration returns an operator that rounds each duration to the nearest multiple
+// of m. Halfway values are rounded away from zero. Durations are emitted unchanged
+// if m <= 0.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(1500*time.Millisecond),
+//	    rotime.RoundDuration(time.Second),
+//	)
+//
+// The observable then emits: 2 * time.Second.
+func RoundDuration(m time.Duration) func(ro.Observable[time.Duration]) ro.Observable[time.Duration] {
+	return ro.Map(
+		func(value time.Duration) time.Duration {
+			return value.Round(m)
+		},
+	)
+}
@@ -0,0 +1,179 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+type timeRoundTest struct {
+	input    time.Time
+	duration time.Duration
+	expected time.Time
+}
+
+var truncateTests = []timeRoundTest{
+	{
+		input:    time.Date(2026, time.January, 7, 14, 37, 12, 0, time.UTC),
+		duration: 5 * time.Minute,
+		expected: time.Date(2026, time.January, 7, 14, 35, 0, 0, time.UTC),
+	},
+	{
+		input:    time.Date(2026, time.January, 7, 14, 59, 59, 0, time.UTC),
+		duration: time.Hour,
+		expected: time.Date(2026, time.January, 7, 14, 0, 0, 0, time.UTC),
+	},
+	{
+		input:    time.Date(2026, time.January, 7, 14, 37, 12, 0, time.UTC),
+		duration: 0,
+		expected: time.Date(2026, time.January, 7, 14, 37, 12, 0, time.UTC),
+	},
+}
+
+var roundTests = []timeRoundTest{
+	{
+		input:    time.Date(2026, time.January, 7, 14, 37, 12, 0, time.UTC),
+		duration: 5 * time.Minute,
+		expected: time.Date(2026, time.January, 7, 14, 35, 0, 0, time.UTC),
+	},
+	{
+		input:    time.Date(2026, time.January, 7, 14, 37, 30, 0, time.UTC),
+		duration: 5 * time.Minute,
+		expected: time.Date(2026, time.January, 7, 14, 40, 0, 0, time.UTC),
+	},
+	{
+		input:    time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC),
+		duration: time.Hour,
+		expected: time.Date(2026, time.January, 7, 15, 0, 0, 0, time.UTC),
+	},
+}
+
+type durationRoundTest struct {
+	input    time.Duration
+	multiple time.Duration
+	expected time.Duration
+}
+
+var truncateDurationTests = []durationRoundTest{
+	{input: 1500 * time.Millisecond, multiple: time.Second, expected: time.Second},
+	{input: -1500 * time.Millisecond, multiple: time.Second, expected: -time.Second},
+	{input: 90 * time.Minute, multiple: time.Hour, expected: time.Hour},
+	{input: 1500 * time.Millisecond, multiple: 0, expected: 1500 * time.Millisecond},
+}
+
+var roundDurationTests = []durationRoundTest{
+	{input: 1500 * time.Millisecond, multiple: time.Second, expected: 2 * time.Second},
+	{input: -1500 * time.Millisecond, multiple: time.Second, expected: -2 * time.Second},
+	{input: 1400 * time.Millisecond, multiple: time.Second, expected: time.Second},
+	{input: 1500 * time.Millisecond, multiple: 0, expected: 1500 * time.Millisecond},
+}
+
+func TestTruncate(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		for _, tt := range truncateTests {
+			values, err := ro.Collect(
+				ro.Pipe1(
+					ro.Just(tt.input),
+					Truncate(tt.duration),
+				),
+			)
+			is.Nil(err)
+			is.Equal([]time.Time{tt.expected}, values)
+		}
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				Truncate(time.Hour),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
+
+func TestRound(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		for _, tt := range roundTests {
+			values, err := ro.Collect(
+				ro.Pipe1(
+					ro.Just(tt.input),
+					Round(tt.duration),
+				),
+			)
+			is.Nil(err)
+			is.Equal([]time.Time{tt.expected}, values)
+		}
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				Round(time.Hour),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
+
+func TestTruncateDuration(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, tt := range truncateDurationTests {
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Just(tt.input),
+				TruncateDuration(tt.multiple),
+			),
+		)
+		is.Nil(err)
+		is.Equal([]time.Duration{tt.expected}, values)
+	}
+}
+
+func TestRoundDuration(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, tt := range roundDurationTests {
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Just(tt.input),
+				RoundDuration(tt.multiple),
+			),
+		)
+		is.Nil(err)
+		is.Equal([]time.Duration{tt.expected}, values)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"errors"
+	"time"
+
+	"github.com/samber/ro"
+	"golang.org/x/exp/constraints"
+)
+
+// ErrUnsupportedUnixUnit is the panic value of FromUnix and ToUnix for a unit other than
+// time.Second, time.Millisecond, time.Microsecond or time.Nanosecond.
+var ErrUnsupportedUnixUnit = errors.New("rotime: unsupported Unix epoch unit")
+
+// FromUnix returns an operator that converts Unix epoch values into time values, in
+// UTC. The unit is time.Second, time.Millisecond, time.Microsecond or time.Nanosecond.
+// It panics with ErrUnsupportedUnixUnit for other units.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(int64(1767796200000)),
+//	    rotime.FromUnix[int64](time.Millisecond),
+//	)
+//
+// The observable then emits: time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC).
+func FromUnix[T constraints.Integer](unit time.Duration) func(ro.Observable[T]) ro.Observable[time.Time] {
+	var convert func(epoch int64) time.Time
+
+	switch unit {
+	case time.Second:
+		convert = func(epoch int64) time.Time { return time.Unix(epoch, 0) }
+	case time.Millisecond:
+		convert = time.UnixMilli
+	case time.Microsecond:
+		convert = time.UnixMicro
+	case time.Nanosecond:
+		convert = func(epoch int64) time.Time { return time.Unix(0, epoch) }
+	default:
+		panic(ErrUnsupportedUnixUnit)
+	}
+
+	return ro.Map(
+		func(value T) time.Time {
+			return convert(int64(value)).UTC()
+		},
+	)
+}
+
+// ToUnix returns an operator that converts time values into Unix epoch values. The
+// unit is time.Second, time.Millisecond, time.Microsecond or time.Nanosecond. It
+// panics with ErrUnsupportedUnixUnit for other units.
+//
+// Example:
+//
+//	obs := ro.Pipe1(
+//	    ro.Just(time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC)),
+//	    rotime.ToUnix(time.Second),
+//	)
+//
+// The observable then emits: int64(1767796200).
+func ToUnix(unit time.Duration) func(ro.Observable[time.Time]) ro.Observable[int64] {
+	var convert func(value time.Time) int64
+
+	switch unit {
+	case time.Second:
+		convert = time.Time.Unix
+	case time.Millisecond:
+		convert = time.Time.UnixMilli
+	case time.Microsecond:
+		convert = time.Time.UnixMicro
+	case time.Nanosecond:
+		convert = time.Time.UnixNano
+	default:
+		panic(ErrUnsupportedUnixUnit)
+	}
+
+	return ro.Map(convert)
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+var unixUnitTests = []struct {
+	unit  time.Duration
+	epoch int64
+}{
+	{unit: time.Second, epoch: 1767796200},
+	{unit: time.Millisecond, epoch: 1767796200123},
+	{unit: time.Microsecond, epoch: 1767796200123456},
+	{unit: time.Nanosecond, epoch: 1767796200123456789},
+}
+
+var unixTime = time.Date(2026, time.January, 7, 14, 30, 0, 123456789, time.UTC)
+
+func TestFromUnix(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		for _, tt := range unixUnitTests {
+			values, err := ro.Collect(
+				ro.Pipe1(
+					ro.Just(tt.epoch),
+					FromUnix[int64](tt.unit),
+				),
+			)
+			is.Nil(err)
+			is.Equal([]time.Time{unixTime.Truncate(tt.unit)}, values)
+		}
+	})
+
+	t.Run("Test integer types case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Just(uint32(1767796200)),
+				FromUnix[uint32](time.Second),
+			),
+		)
+		is.Nil(err)
+		is.Equal([]time.Time{time.Date(2026, time.January, 7, 14, 30, 0, 0, time.UTC)}, values)
+	})
+
+	t.Run("Test unsupported unit case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		is.PanicsWithError(ErrUnsupportedUnixUnit.Error(), func() {
+			FromUnix[int64](time.Minute)
+		})
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[int64](assert.AnError),
+				FromUnix[int64](time.Second),
+			),
+		)
+		is.Equal([]time.Time{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
+
+func TestToUnix(t *testing.T) {
+	t.Run("Test Simple cases", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+
+		for _, tt := range unixUnitTests {
+			values, err := ro.Collect(
+				ro.Pipe1(
+					ro.Just(unixTime),
+					ToUnix(tt.unit),
+				),
+			)
+			is.Nil(err)
+			is.Equal([]int64{tt.epoch}, values)
+		}
+	})
+
+	t.Run("Test unsupported unit case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		is.PanicsWithError(ErrUnsupportedUnixUnit.Error(), func() {
+			ToUnix(time.Hour)
+		})
+	})
+
+	t.Run("Test error handling case", func(t *testing.T) {
+		t.Parallel()
+		is := assert.New(t)
+		values, err := ro.Collect(
+			ro.Pipe1(
+				ro.Throw[time.Time](assert.AnError),
+				ToUnix(time.Second),
+			),
+		)
+		is.Equal([]int64{}, values)
+		is.EqualError(err, assert.AnError.Error())
+	})
+}
@@ -142,6 +142,11 @@ func (ws *websocketSubject[In, Out]) CompleteWithContext(ctx context.Context) {
 	ws.output.CompleteWithContext(ctx)
 }
 
+// Implements ro.Subject[Out]
+func (ws *websocketSubject[In, Out]) CompleteAndDrain(ctx context.Context) error {
+	return ws.output.CompleteAndDrain(ctx)
+}
+
 // Implements ro.Observer[In]
 func (ws *websocketSubject[In, Out]) IsClosed() bool {
 	return ws.output.IsClosed()
@@ -45,6 +45,21 @@ var (
 	// OnDroppedNotification is called when a notification is emitted by an Observable and
 	// no notification handler is registered.
 	OnDroppedNotification = IgnoreOnDroppedNotification
+	// PanicOnUnsubscriptionError restores the legacy behavior of Unsubscribe:
+	// when enabled, the errors returned or panics raised by teardowns are
+	// joined and rethrown by Unsubscribe, instead of being reported to
+	// OnUnhandledError. See ErrorUnsubscribable.
+	PanicOnUnsubscriptionError = false
+	// PipeStageErrors makes PipeX() and PipeOpX() wrap the errors flowing
+	// through the pipeline into a *StageError, telling which operator emitted
+	// them. It is read when the pipeline is built. Disabled by default, since
+	// it adds a stage per operator and changes the identity of the errors.
+	PipeStageErrors = false
+	// PipeDescriptions makes PipeX() record their source and operators, so
+	// that the resulting Observable implements Describer. It is read when the
+	// pipeline is built. Disabled by default, since it wraps the resulting
+	// Observable.
+	PipeDescriptions = false
 )
 
 // IgnoreOnUnhandledError is the default implementation of `OnUnhandledError`.
@@ -20,9 +20,11 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"math/big"
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/samber/lo"
@@ -1285,6 +1287,52 @@ func ExampleContextWithValue() {
 	// Next context value: 42
 }
 
+func ExampleContextWithPipelineName() {
+	observable := Pipe3(
+		Just(1, 2),
+		ContextWithPipelineName[int]("orders"),
+		ContextWithStageTag[int]("tenant", "acme"),
+		TapOnNextWithContext(func(ctx context.Context, value int) {
+			tenant, _ := StageTag(ctx, "tenant")
+			fmt.Println(PipelineName(ctx), tenant, value)
+		}),
+	)
+
+	subscription := observable.Subscribe(NoopObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// orders acme 1
+	// orders acme 2
+}
+
+func ExampleContextFromSubscription() {
+	type contextValue struct{}
+
+	ctx := context.WithValue(context.Background(), contextValue{}, "subscription")
+
+	observable := Pipe2(
+		Just(1, 2, 3),
+		ContextMap[int](func(ctx context.Context) context.Context {
+			return context.WithValue(ctx, contextValue{}, "item")
+		}),
+		ContextFromSubscription[int](),
+	)
+
+	subscription := observable.SubscribeWithContext(
+		ctx,
+		OnNextWithContext(func(ctx context.Context, value int) {
+			fmt.Printf("Next: %v (%v)\n", value, ctx.Value(contextValue{}))
+		}),
+	)
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 1 (subscription)
+	// Next: 2 (subscription)
+	// Next: 3 (subscription)
+}
+
 func ExampleNewObservable_ok() {
 	observable := NewObservable(func(observer Observer[int]) Teardown {
 		observer.Next(1)
@@ -1399,6 +1447,21 @@ func ExampleInterval() {
 	// Next: 1
 }
 
+func ExampleInterval_withOptions() {
+	observable := Pipe1(
+		Interval(100*time.Millisecond, WithIntervalImmediate(), WithIntervalJitter(10*time.Millisecond)),
+		Take[int64](2),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int64]())
+	subscription.Wait()
+
+	// Output:
+	// Next: 0
+	// Next: 1
+	// Completed
+}
+
 func ExampleIntervalWithInitial() {
 	observable := IntervalWithInitial(50*time.Millisecond, 100*time.Millisecond)
 
@@ -1656,6 +1719,72 @@ func ExampleFuture_error() {
 	// Error: Get "": unsupported protocol scheme ""
 }
 
+func ExampleFutureWithTimeout() {
+	observable := FutureWithTimeout(func() (int, error) {
+		time.Sleep(100 * time.Millisecond)
+		return 42, nil
+	}, 10*time.Millisecond)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	subscription.Wait() // Note: using .Wait() is not recommended.
+
+	// Output:
+	// Error: ro.Timeout: timeout after 10ms
+}
+
+func ExampleAllFutures() {
+	observable := AllFutures(
+		func() (int, error) {
+			time.Sleep(20 * time.Millisecond)
+			return 1, nil
+		},
+		func() (int, error) {
+			return 2, nil
+		},
+	)
+
+	subscription := observable.Subscribe(PrintObserver[[]int]())
+	subscription.Wait() // Note: using .Wait() is not recommended.
+
+	// Output:
+	// Next: [1 2]
+	// Completed
+}
+
+func ExampleAnyFuture() {
+	observable := AnyFuture(
+		func() (int, error) {
+			return 0, errors.New("unavailable")
+		},
+		func() (int, error) {
+			time.Sleep(20 * time.Millisecond)
+			return 2, nil
+		},
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	subscription.Wait() // Note: using .Wait() is not recommended.
+
+	// Output:
+	// Next: 2
+	// Completed
+}
+
+func ExampleForkJoin() {
+	observable := ForkJoin(
+		Just(1, 2, 3),
+		Pipe1(Just(4, 5), Delay[int](10*time.Millisecond)),
+		Just(6),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[[]int]())
+	subscription.Wait() // Note: using .Wait() is not recommended.
+
+	// Output:
+	// Next: [3 5 6]
+	// Completed
+}
+
 func ExampleMerge_ok() {
 	observable := Merge(
 		RangeWithInterval(0, 2, 50*time.Millisecond),
@@ -2250,6 +2379,36 @@ func ExampleRetryWithConfig() {
 	// Error: assert.AnError general error for testing
 }
 
+func ExampleCircuitBreaker() {
+	observable := Pipe1(
+		Just(1, 2, 3, 4, 5),
+		CircuitBreaker(CircuitBreakerConfig[int]{
+			Action: func(ctx context.Context, item int) error {
+				if item >= 2 {
+					return assert.AnError
+				}
+				return nil
+			},
+			Fallback: func(ctx context.Context, item int, err error) {
+				fmt.Printf("Rejected: %d (%v)\n", item, err)
+			},
+			WindowSize:      2,
+			MinimumRequests: 2,
+		}),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 1
+	// Rejected: 2 (assert.AnError general error for testing)
+	// Rejected: 3 (ro.CircuitBreaker: circuit breaker is open)
+	// Rejected: 4 (ro.CircuitBreaker: circuit breaker is open)
+	// Rejected: 5 (ro.CircuitBreaker: circuit breaker is open)
+	// Completed
+}
+
 func ExampleThrowIfEmpty() {
 	observable := Pipe1(
 		Empty[int](),
@@ -2397,6 +2556,22 @@ func ExampleDistinct_error() {
 	// Error: assert.AnError general error for testing
 }
 
+func ExampleDistinctSorted() {
+	observable := Pipe1(
+		Just("banana", "apple", "banana", "cherry"),
+		DistinctSorted[string](),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: apple
+	// Next: banana
+	// Next: cherry
+	// Completed
+}
+
 func ExampleDistinctBy_ok() {
 	type user struct {
 		id   int
@@ -2467,6 +2642,19 @@ func ExampleIgnoreElements_ok() {
 	// Completed
 }
 
+func ExampleToCompletion() {
+	observable := Merge(
+		ToCompletion(Just(1, 2, 3)),
+		ToCompletion(Just("a", "b", "c")),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[struct{}]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Completed
+}
+
 func ExampleIgnoreElements_error() {
 	observable := Pipe1(
 		NewObservable(func(observer Observer[int]) Teardown {
@@ -2791,6 +2979,24 @@ func ExampleTakeWhile_ok() {
 	// Completed
 }
 
+func ExampleTakeWhileInclusive() {
+	observable := Pipe1(
+		Just(1, 2, 3, 4, 5),
+		TakeWhileInclusive(func(n int) bool {
+			return n < 3
+		}),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 1
+	// Next: 2
+	// Next: 3
+	// Completed
+}
+
 func ExampleTakeWhile_error1() {
 	observable := Pipe1(
 		NewObservable(func(observer Observer[int]) Teardown {
@@ -3068,6 +3274,37 @@ func ExampleLast_error() {
 	// Error: assert.AnError general error for testing
 }
 
+func ExampleSingle_ok() {
+	observable := Pipe1(
+		Just(1, 2, 3, 4, 5),
+		Single(func(n int) bool {
+			return n == 3
+		}),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 3
+	// Completed
+}
+
+func ExampleSingle_moreThanOne() {
+	observable := Pipe1(
+		Just(1, 2, 3, 4, 5),
+		Single(func(n int) bool {
+			return n > 3
+		}),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Error: ro.Single: more than one value
+}
+
 func ExampleElementAt_ok() {
 	observable := Pipe1(
 		Just(1, 2, 3, 4, 5),
@@ -3270,173 +3507,623 @@ func ExampleSum_error() {
 	// Error: assert.AnError general error for testing
 }
 
-func ExampleRound_ok() {
+func ExampleSumBatch() {
 	observable := Pipe1(
-		Just[float64](1, 2, 3, 4, 5),
-		Round(),
+		Just([]float64{1, 2, 3}, []float64{4, 5}),
+		SumBatch(),
 	)
 
 	subscription := observable.Subscribe(PrintObserver[float64]())
 	defer subscription.Unsubscribe()
 
 	// Output:
-	// Next: 1
-	// Next: 2
-	// Next: 3
-	// Next: 4
-	// Next: 5
+	// Next: 15
 	// Completed
 }
 
-func ExampleRound_error() {
+func ExampleAverageBatch() {
 	observable := Pipe1(
-		NewObservable(func(observer Observer[float64]) Teardown {
-			observer.Next(1)
-			observer.Next(2)
-			observer.Next(3)
-			observer.Error(assert.AnError)
-			observer.Next(4)
-
-			return nil
-		}),
-		Round(),
+		Just([]float64{1, 2, 3}, []float64{4, 5}),
+		AverageBatch(),
 	)
 
 	subscription := observable.Subscribe(PrintObserver[float64]())
 	defer subscription.Unsubscribe()
 
 	// Output:
-	// Next: 1
-	// Next: 2
 	// Next: 3
-	// Error: assert.AnError general error for testing
+	// Completed
 }
 
-func ExampleMin_ok() {
+func ExampleMinMaxBatch() {
 	observable := Pipe1(
-		Just(1, 2, 3, 4, 5),
-		Min[int](),
+		Just([]float64{3, 1, 4}, []float64{-1, 5}),
+		MinMaxBatch(),
 	)
 
-	subscription := observable.Subscribe(PrintObserver[int]())
+	subscription := observable.Subscribe(PrintObserver[lo.Tuple2[float64, float64]]())
 	defer subscription.Unsubscribe()
 
 	// Output:
-	// Next: 1
+	// Next: {-1 5}
 	// Completed
 }
 
-func ExampleMin_error() {
+func ExamplePercentile() {
 	observable := Pipe1(
-		NewObservable(func(observer Observer[int]) Teardown {
-			observer.Next(1)
-			observer.Next(2)
-			observer.Next(3)
-			observer.Error(assert.AnError)
-			observer.Next(4)
-
-			return nil
-		}),
-		Min[int](),
+		Just(5, 1, 4, 2, 3),
+		Percentile[int](50),
 	)
 
-	subscription := observable.Subscribe(PrintObserver[int]())
+	subscription := observable.Subscribe(PrintObserver[float64]())
 	defer subscription.Unsubscribe()
 
 	// Output:
-	// Error: assert.AnError general error for testing
+	// Next: 3
+	// Completed
 }
 
-func ExampleMax_ok() {
+func ExampleQuantiles() {
 	observable := Pipe1(
-		Just(1, 2, 3, 4, 5),
-		Max[int](),
+		Just(10, 1, 9, 2, 8, 3, 7, 4, 6, 5),
+		Quantiles[int](0.25, 0.5, 0.75),
 	)
 
-	subscription := observable.Subscribe(PrintObserver[int]())
+	subscription := observable.Subscribe(PrintObserver[[]float64]())
 	defer subscription.Unsubscribe()
 
 	// Output:
-	// Next: 5
+	// Next: [3.25 5.5 7.75]
 	// Completed
 }
 
-func ExampleMax_error() {
+func ExamplePercentileApprox() {
 	observable := Pipe1(
-		NewObservable(func(observer Observer[int]) Teardown {
-			observer.Next(1)
-			observer.Next(2)
-			observer.Next(3)
-			observer.Error(assert.AnError)
-			observer.Next(4)
-
-			return nil
-		}),
-		Max[int](),
+		Just(3, 1, 2),
+		PercentileApprox[int](50),
 	)
 
-	subscription := observable.Subscribe(PrintObserver[int]())
+	subscription := observable.Subscribe(PrintObserver[float64]())
 	defer subscription.Unsubscribe()
 
 	// Output:
-	// Error: assert.AnError general error for testing
+	// Next: 3
+	// Next: 2
+	// Next: 2
+	// Completed
 }
 
-func ExampleClamp_ok() {
+func ExampleVariance() {
 	observable := Pipe1(
-		Just(1, 2, 3, 4, 5),
-		Clamp(2, 4),
+		Just(2, 4, 4, 4, 5, 5, 7, 9),
+		Variance[int](),
 	)
 
-	subscription := observable.Subscribe(PrintObserver[int]())
+	subscription := observable.Subscribe(PrintObserver[float64]())
 	defer subscription.Unsubscribe()
 
 	// Output:
-	// Next: 2
-	// Next: 2
-	// Next: 3
-	// Next: 4
 	// Next: 4
 	// Completed
 }
 
-func ExampleClamp_error() {
+func ExampleStdDev() {
 	observable := Pipe1(
-		NewObservable(func(observer Observer[int]) Teardown {
-			observer.Next(1)
-			observer.Next(2)
-			observer.Next(3)
-			observer.Error(assert.AnError)
-			observer.Next(4)
-
-			return nil
-		}),
-		Clamp(2, 4),
+		Just(2, 4, 4, 4, 5, 5, 7, 9),
+		StdDev[int](),
 	)
 
-	subscription := observable.Subscribe(PrintObserver[int]())
+	subscription := observable.Subscribe(PrintObserver[float64]())
 	defer subscription.Unsubscribe()
 
 	// Output:
 	// Next: 2
-	// Next: 2
-	// Next: 3
-	// Error: assert.AnError general error for testing
+	// Completed
 }
 
-func ExampleAbs_ok() {
+func ExampleMovingAverage() {
 	observable := Pipe1(
-		Just[float64](-5, -4, -3, -2, -1, 0, 1, 2, 3, 4, 5),
-		Abs(),
+		Just(1, 2, 3, 4, 5),
+		MovingAverage[int](3),
 	)
 
 	subscription := observable.Subscribe(PrintObserver[float64]())
 	defer subscription.Unsubscribe()
 
 	// Output:
-	// Next: 5
-	// Next: 4
-	// Next: 3
+	// Next: 1
+	// Next: 1.5
+	// Next: 2
+	// Next: 3
+	// Next: 4
+	// Completed
+}
+
+func ExampleMovingAverageTime() {
+	observable := Pipe1(
+		Just(1, 2, 3),
+		MovingAverageTime[int](time.Second),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[float64]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 1
+	// Next: 1.5
+	// Next: 2
+	// Completed
+}
+
+func ExampleEWMA() {
+	observable := Pipe1(
+		Just(10, 20, 20, 0),
+		EWMA[int](0.5),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[float64]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 10
+	// Next: 15
+	// Next: 17.5
+	// Next: 8.75
+	// Completed
+}
+
+func ExampleHistogram() {
+	observable := Pipe1(
+		Just(0.5, 1, 1.5, 5, 7, 10, 11),
+		Histogram[float64]([]float64{1, 5, 10}),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[[]int64]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: [2 2 2 1]
+	// Completed
+}
+
+func ExampleTopK() {
+	observable := Pipe1(
+		Just(5, 1, 9, 3, 7),
+		TopK[int](3),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 9
+	// Next: 7
+	// Next: 5
+	// Completed
+}
+
+func ExampleTopKBy() {
+	observable := Pipe1(
+		Just("apple", "fig", "banana", "kiwi"),
+		TopKBy(2, func(a, b string) bool {
+			return len(a) < len(b)
+		}),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: banana
+	// Next: apple
+	// Completed
+}
+
+func ExampleBottomK() {
+	observable := Pipe1(
+		Just(5, 1, 9, 3, 7),
+		BottomK[int](2),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 1
+	// Next: 3
+	// Completed
+}
+
+func ExampleBottomKBy() {
+	observable := Pipe1(
+		Just("apple", "fig", "banana", "kiwi"),
+		BottomKBy(2, func(a, b string) bool {
+			return len(a) < len(b)
+		}),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: fig
+	// Next: kiwi
+	// Completed
+}
+
+func ExampleMode() {
+	observable := Pipe1(
+		Just("a", "b", "a", "c", "b", "a"),
+		Mode[string](),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: a
+	// Completed
+}
+
+func ExampleCountBy() {
+	observable := Pipe1(
+		Just("apple", "avocado", "banana", "cherry", "blueberry"),
+		CountBy(func(s string) string { return s[:1] }),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[map[string]int64]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: map[a:2 b:2 c:1]
+	// Completed
+}
+
+func ExampleRunningStats() {
+	observable := Pipe1(
+		Just(2, 4, 9),
+		RunningStats[int](),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[Stats]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: {1 2 2 2 2 0}
+	// Next: {2 6 2 4 3 1}
+	// Next: {3 15 2 9 5 2.943920288775949}
+	// Completed
+}
+
+func ExampleRoundWithPrecision() {
+	observable := Pipe1(
+		Just(3.14159, 2.71828, -1.2345),
+		RoundWithPrecision(2),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[float64]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 3.14
+	// Next: 2.72
+	// Next: -1.23
+	// Completed
+}
+
+func ExampleSumBig() {
+	observable := Pipe1(
+		Just[int64](math.MaxInt64, math.MaxInt64, 2),
+		SumBig[int64](),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[*big.Int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 18446744073709551616
+	// Completed
+}
+
+func ExampleSumBigFloat() {
+	observable := Pipe2(
+		Just(1e20, 1, 1, -1e20),
+		SumBigFloat[float64](),
+		Map(func(sum *big.Float) string {
+			return sum.Text('g', 10)
+		}),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 2
+	// Completed
+}
+
+func ExampleAverageBig() {
+	observable := Pipe2(
+		Just[int64](math.MaxInt64, math.MaxInt64),
+		AverageBig[int64](),
+		Map(func(avg *big.Float) string {
+			return avg.Text('f', 0)
+		}),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 9223372036854775807
+	// Completed
+}
+
+func ExampleCovariance() {
+	observable := Pipe1(
+		Zip2(Just(1, 2, 3, 4), Just(4, 3, 2, 1)),
+		Covariance[int](),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[float64]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: -1.25
+	// Completed
+}
+
+func ExampleCorrelation() {
+	observable := Pipe1(
+		Just(lo.T2(1.0, 2.0), lo.T2(2.0, 4.0), lo.T2(3.0, 6.0)),
+		Correlation[float64](),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[float64]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 1
+	// Completed
+}
+
+func ExampleRatePerSecond() {
+	// a counter increasing by 5 every 10ms: about 500 per second
+	observable := Pipe2(
+		RangeWithInterval(0, 5, 10*time.Millisecond),
+		Map(func(i int64) int64 {
+			return i * 5
+		}),
+		RatePerSecond[int64](),
+	)
+
+	subscription := observable.Subscribe(NoopObserver[float64]())
+	defer subscription.Unsubscribe()
+}
+
+func ExampleRound_ok() {
+	observable := Pipe1(
+		Just[float64](1, 2, 3, 4, 5),
+		Round(),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[float64]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 1
+	// Next: 2
+	// Next: 3
+	// Next: 4
+	// Next: 5
+	// Completed
+}
+
+func ExampleRound_error() {
+	observable := Pipe1(
+		NewObservable(func(observer Observer[float64]) Teardown {
+			observer.Next(1)
+			observer.Next(2)
+			observer.Next(3)
+			observer.Error(assert.AnError)
+			observer.Next(4)
+
+			return nil
+		}),
+		Round(),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[float64]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 1
+	// Next: 2
+	// Next: 3
+	// Error: assert.AnError general error for testing
+}
+
+func ExampleMin_ok() {
+	observable := Pipe1(
+		Just(1, 2, 3, 4, 5),
+		Min[int](),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 1
+	// Completed
+}
+
+func ExampleMin_error() {
+	observable := Pipe1(
+		NewObservable(func(observer Observer[int]) Teardown {
+			observer.Next(1)
+			observer.Next(2)
+			observer.Next(3)
+			observer.Error(assert.AnError)
+			observer.Next(4)
+
+			return nil
+		}),
+		Min[int](),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Error: assert.AnError general error for testing
+}
+
+func ExampleMax_ok() {
+	observable := Pipe1(
+		Just(1, 2, 3, 4, 5),
+		Max[int](),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 5
+	// Completed
+}
+
+func ExampleMax_error() {
+	observable := Pipe1(
+		NewObservable(func(observer Observer[int]) Teardown {
+			observer.Next(1)
+			observer.Next(2)
+			observer.Next(3)
+			observer.Error(assert.AnError)
+			observer.Next(4)
+
+			return nil
+		}),
+		Max[int](),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Error: assert.AnError general error for testing
+}
+
+func ExampleMinOrdered() {
+	observable := Pipe1(
+		Just("banana", "apple", "cherry"),
+		MinOrdered[string](),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: apple
+	// Completed
+}
+
+func ExampleMaxOrdered() {
+	observable := Pipe1(
+		Just("banana", "apple", "cherry"),
+		MaxOrdered[string](),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: cherry
+	// Completed
+}
+
+func ExampleMinBy() {
+	observable := Pipe1(
+		Just("banana", "fig", "cherry"),
+		MinBy(func(s string) int { return len(s) }),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: fig
+	// Completed
+}
+
+func ExampleMaxBy() {
+	observable := Pipe1(
+		Just("banana", "fig", "cherry"),
+		MaxBy(func(s string) int { return len(s) }),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: banana
+	// Completed
+}
+
+func ExampleClamp_ok() {
+	observable := Pipe1(
+		Just(1, 2, 3, 4, 5),
+		Clamp(2, 4),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 2
+	// Next: 2
+	// Next: 3
+	// Next: 4
+	// Next: 4
+	// Completed
+}
+
+func ExampleClamp_error() {
+	observable := Pipe1(
+		NewObservable(func(observer Observer[int]) Teardown {
+			observer.Next(1)
+			observer.Next(2)
+			observer.Next(3)
+			observer.Error(assert.AnError)
+			observer.Next(4)
+
+			return nil
+		}),
+		Clamp(2, 4),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 2
+	// Next: 2
+	// Next: 3
+	// Error: assert.AnError general error for testing
+}
+
+func ExampleAbs_ok() {
+	observable := Pipe1(
+		Just[float64](-5, -4, -3, -2, -1, 0, 1, 2, 3, 4, 5),
+		Abs(),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[float64]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 5
+	// Next: 4
+	// Next: 3
 	// Next: 2
 	// Next: 1
 	// Next: 0
@@ -3631,18 +4318,181 @@ func ExampleReduce_error() {
 			observer.Error(assert.AnError)
 			observer.Next(4)
 
-			return nil
-		}),
-		Reduce(func(agg, current int) int {
-			return agg + current
-		}, 42),
-	)
+			return nil
+		}),
+		Reduce(func(agg, current int) int {
+			return agg + current
+		}, 42),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Error: assert.AnError general error for testing
+}
+
+func ExampleRun() {
+	err := Run(context.Background(), Just(1, 2, 3), func(ctx context.Context, value int) error {
+		fmt.Printf("Next: %v\n", value)
+		return nil
+	})
+
+	fmt.Printf("Error: %v\n", err)
+
+	// Output:
+	// Next: 1
+	// Next: 2
+	// Next: 3
+	// Error: <nil>
+}
+
+func ExampleFirstValue() {
+	value, err := FirstValue(context.Background(), Just(1, 2, 3))
+
+	fmt.Printf("Value: %v\n", value)
+	fmt.Printf("Error: %v\n", err)
+
+	// Output:
+	// Value: 1
+	// Error: <nil>
+}
+
+func ExampleLastValue() {
+	value, err := LastValue(context.Background(), Just(1, 2, 3))
+
+	fmt.Printf("Value: %v\n", value)
+	fmt.Printf("Error: %v\n", err)
+
+	// Output:
+	// Value: 3
+	// Error: <nil>
+}
+
+func ExampleSingleValue() {
+	value, err := SingleValue(context.Background(), Just(1, 2, 3))
+
+	fmt.Printf("Value: %v\n", value)
+	fmt.Printf("Error: %v\n", err)
+
+	// Output:
+	// Value: 0
+	// Error: ro.SingleValue: more than one value
+}
+
+func ExampleElementAtValue() {
+	value, err := ElementAtValue(context.Background(), Just(1, 2, 3), 1)
+
+	fmt.Printf("Value: %v\n", value)
+	fmt.Printf("Error: %v\n", err)
+
+	// Output:
+	// Value: 2
+	// Error: <nil>
+}
+
+func ExampleCollectFunc() {
+	err := CollectFunc(context.Background(), Just(1, 2, 3), func(value int) error {
+		fmt.Println(value)
+		return nil
+	})
+
+	fmt.Printf("Error: %v\n", err)
+
+	// Output:
+	// 1
+	// 2
+	// 3
+	// Error: <nil>
+}
+
+func ExampleCollectN() {
+	values, err := CollectN(Interval(10*time.Millisecond), 3)
+
+	fmt.Println(values, err)
+
+	// Output:
+	// [0 1 2] <nil>
+}
+
+func ExampleCollectChunks() {
+	err := CollectChunks(context.Background(), Range(0, 5), 2, func(chunk []int64) error {
+		fmt.Println(chunk)
+		return nil
+	})
+
+	fmt.Printf("Error: %v\n", err)
+
+	// Output:
+	// [0 1]
+	// [2 3]
+	// [4]
+	// Error: <nil>
+}
+
+func ExampleFrom() {
+	observable := Via(
+		From(Just(1, 2, 3, 4, 5, 6)).
+			Filter(func(n int) bool {
+				return n%2 == 0
+			}).
+			Map(func(n int) int {
+				return n * 10
+			}),
+		Map(strconv.Itoa),
+	).Build()
+
+	subscription := observable.Subscribe(PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 20
+	// Next: 40
+	// Next: 60
+	// Completed
+}
+
+func ExampleRunGroup() {
+	group := NewRunGroup(context.Background())
+
+	RunInGroup(group, Just(1, 2, 3), func(ctx context.Context, value int) error {
+		if value == 2 {
+			return fmt.Errorf("invalid value: %d", value)
+		}
+
+		return nil
+	})
+
+	fmt.Printf("Error: %v\n", group.Wait())
+
+	// Output:
+	// Error: invalid value: 2
+}
+
+func ExampleShutdown() {
+	events := NewPublishSubject[int]()
+
+	subscription := Pipe2(
+		events.AsObservable(),
+		BufferWithCount[int](10),
+		ObserveOn[[]int](10),
+	).Subscribe(OnNext(func(batch []int) {
+		fmt.Printf("Flushed: %v\n", batch)
+	}))
 
-	subscription := observable.Subscribe(PrintObserver[int]())
-	defer subscription.Unsubscribe()
+	events.Next(1)
+	events.Next(2)
+	events.Next(3)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	err := Shutdown(ctx, DrainPipeline(events, subscription))
+	fmt.Printf("Error: %v\n", err)
 
 	// Output:
-	// Error: assert.AnError general error for testing
+	// Flushed: [1 2 3]
+	// Error: <nil>
 }
 
 func ExampleToSlice_ok() {
@@ -3659,6 +4509,20 @@ func ExampleToSlice_ok() {
 	// Completed
 }
 
+func ExampleToSortedSlice() {
+	observable := Pipe1(
+		Just("banana", "apple", "cherry"),
+		ToSortedSlice[string](),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[[]string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: [apple banana cherry]
+	// Completed
+}
+
 func ExampleToSlice_error() {
 	observable := Pipe1(
 		NewObservable(func(observer Observer[int]) Teardown {
@@ -3918,6 +4782,84 @@ func ExampleMapErr_error() {
 	// Error: assert.AnError general error for testing
 }
 
+func ExampleChain() {
+	observable := Pipe1(
+		Just(1, 2, 3, 4, 5),
+		Chain(
+			func(v int) (int, bool) { return v * 2, true },
+			func(v int) (int, bool) { return v, v%4 == 0 },
+			func(v int) (int, bool) { return v + 1, true },
+		),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 5
+	// Next: 9
+	// Completed
+}
+
+func ExampleMapParallel() {
+	observable := Pipe1(
+		Just(1, 2, 3, 4, 5),
+		MapParallel(func(item int) int {
+			return item * 2
+		}, 3, WithOrdered(true)),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	subscription.Wait() // MapParallel completes asynchronously
+
+	// Output:
+	// Next: 2
+	// Next: 4
+	// Next: 6
+	// Next: 8
+	// Next: 10
+	// Completed
+}
+
+func ExampleKeyedSequential() {
+	type Deposit struct {
+		Account string
+		Amount  int
+	}
+
+	balances := map[string]int{}
+	var mu sync.Mutex
+
+	observable := Pipe1(
+		Just(
+			Deposit{"alice", 10},
+			Deposit{"bob", 5},
+			Deposit{"alice", 20},
+			Deposit{"bob", 15},
+		),
+		// Deposits of an account are applied in order, accounts in parallel.
+		KeyedSequential(
+			func(d Deposit) string { return d.Account },
+			func(d Deposit) string {
+				mu.Lock()
+				defer mu.Unlock()
+
+				balances[d.Account] += d.Amount
+				return d.Account
+			},
+			2,
+		),
+	)
+
+	subscription := observable.Subscribe(NoopObserver[string]())
+	subscription.Wait() // KeyedSequential completes asynchronously
+
+	fmt.Println(balances["alice"], balances["bob"])
+
+	// Output:
+	// 30 20
+}
+
 func ExampleFlatMap_ok() {
 	observable := Pipe1(
 		Just(1, 2, 3),
@@ -3985,6 +4927,66 @@ func ExampleFlatMap_error() {
 	// Error: assert.AnError general error for testing
 }
 
+func ExampleToEntries() {
+	type user struct {
+		id   int
+		name string
+	}
+
+	observable := Pipe2(
+		Just(user{1, "alice"}, user{2, "bob"}),
+		ToEntries(
+			func(u user) int { return u.id },
+			func(u user) string { return u.name },
+		),
+		Values[int, string](),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: alice
+	// Next: bob
+	// Completed
+}
+
+func ExampleFlattenSlice() {
+	observable := Pipe2(
+		Just(1, 2, 3, 4, 5),
+		BufferWithCount[int](2),
+		FlattenSlice[int](),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 1
+	// Next: 2
+	// Next: 3
+	// Next: 4
+	// Next: 5
+	// Completed
+}
+
+func ExampleFlattenObservable() {
+	observable := Pipe1(
+		Just(Just(1, 2), Just(3, 4)),
+		FlattenObservable[int](WithFlattenConcurrency(1)),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 1
+	// Next: 2
+	// Next: 3
+	// Next: 4
+	// Completed
+}
+
 func ExampleScan_ok() {
 	observable := Pipe1(
 		Just(1, 2, 3, 4, 5),
@@ -4156,6 +5158,85 @@ func ExampleBufferWithTimeOrCount_error() {
 	// Error: assert.AnError general error for testing
 }
 
+func ExampleBufferWithEventTime() {
+	type Reading struct {
+		Value int
+		At    time.Time
+	}
+
+	origin := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(seconds int) time.Time { return origin.Add(time.Duration(seconds) * time.Second) }
+
+	observable := Pipe1(
+		Just(
+			Reading{1, at(1)},
+			Reading{2, at(12)},
+			Reading{3, at(8)}, // out-of-order, but within bounds
+			Reading{4, at(17)},
+			Reading{5, at(2)}, // too late
+		),
+		BufferWithEventTime(
+			func(r Reading) time.Time { return r.At },
+			10*time.Second,
+			EventTimeConfig[Reading]{
+				MaxOutOfOrderness: 5 * time.Second,
+				LateItems: OnNext(func(r Reading) {
+					fmt.Printf("Late: %d\n", r.Value)
+				}),
+			},
+		),
+	)
+
+	subscription := observable.Subscribe(
+		NewObserver(
+			func(w EventTimeWindow[Reading]) {
+				values := lo.Map(w.Items, func(r Reading, _ int) int { return r.Value })
+				fmt.Printf("Window %s-%s: %v\n", w.Start.Format("15:04:05"), w.End.Format("15:04:05"), values)
+			},
+			func(err error) {
+				fmt.Printf("Error: %s\n", err.Error())
+			},
+			func() {
+				fmt.Printf("Completed\n")
+			},
+		),
+	)
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Window 00:00:00-00:00:10: [1 3]
+	// Late: 5
+	// Window 00:00:10-00:00:20: [2 4]
+	// Completed
+}
+
+func ExamplePrioritizeByWithCount() {
+	observable := Pipe1(
+		Just("low", "high", "medium", "low", "high"),
+		PrioritizeByWithCount(func(item string) int {
+			switch item {
+			case "high":
+				return 2
+			case "medium":
+				return 1
+			default:
+				return 0
+			}
+		}, 3),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: high
+	// Next: medium
+	// Next: low
+	// Next: high
+	// Next: low
+	// Completed
+}
+
 func ExampleBufferWithCount_ok() {
 	observable := Pipe1(
 		Just(1, 2, 3, 4, 5),
@@ -4218,6 +5299,22 @@ func ExampleBufferWithTime_ok() {
 	// Completed
 }
 
+func ExampleBufferWithTime_withMaxSize() {
+	observable := Pipe1(
+		Just(1, 2, 3, 4, 5),
+		BufferWithTime[int](time.Second, WithBufferMaxSize(2), WithBufferEmitEmpty(false)),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[[]int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: [1 2]
+	// Next: [3 4]
+	// Next: [5]
+	// Completed
+}
+
 func ExampleBufferWithTime_error() {
 	observable := Pipe1(
 		NewObservable(func(observer Observer[int]) Teardown {
@@ -4409,6 +5506,32 @@ func ExampleTapOnComplete_error() {
 	// Output:
 }
 
+func ExampleTapOnTerminate() {
+	observable := Pipe1(
+		Throw[int](assert.AnError),
+		TapOnTerminate[int](func() { fmt.Println("Terminated") }),
+	)
+
+	subscription := observable.Subscribe(NoopObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Terminated
+}
+
+func ExampleTapOnUnsubscribe() {
+	observable := Pipe1(
+		Interval(10*time.Millisecond),
+		TapOnUnsubscribe[int64](func() { fmt.Println("Unsubscribed") }),
+	)
+
+	subscription := observable.Subscribe(NoopObserver[int64]())
+	subscription.Unsubscribe()
+
+	// Output:
+	// Unsubscribed
+}
+
 func ExampleTimeInterval() {
 	observable := Pipe1(
 		RangeWithInterval(0, 3, 10*time.Millisecond),
@@ -4483,6 +5606,84 @@ func ExampleDelay_error() {
 	// Error: assert.AnError general error for testing
 }
 
+func ExamplePace() {
+	observable := Pipe1(
+		Just(1, 2, 3),
+		Pace[int](10*time.Millisecond),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	subscription.Wait() // Note: using .Wait() is not recommended.
+
+	// Output:
+	// Next: 1
+	// Next: 2
+	// Next: 3
+	// Completed
+}
+
+func ExampleBulkhead() {
+	bulkhead := Bulkhead[int](2, 0)
+
+	observable := Pipe1(
+		Just(1, 2, 3),
+		MergeMap(func(item int) Observable[int] {
+			return bulkhead(Delay[int](10 * time.Millisecond)(Of(item)))
+		}),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	subscription.Wait() // Note: using .Wait() is not recommended.
+
+	// Output:
+	// Error: ro.Bulkhead: buffer overflow (capacity 0)
+}
+
+func ExampleShedLoad() {
+	// The downstream latency, as measured by the consumer.
+	latency := 250 * time.Millisecond
+
+	observable := Pipe1(
+		Just(1, 2, 3, 4),
+		ShedLoad(
+			100*time.Millisecond,
+			func() time.Duration { return latency },
+			WithShedLoadPriority(func(item int) int { return item % 2 }, 1),
+		),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: 1
+	// Next: 3
+	// Completed
+}
+
+func ExamplePausable() {
+	handle := Pausable[int]()
+	subject := NewPublishSubject[int]()
+
+	subscription := Pipe1(Observable[int](subject), handle.Operator).Subscribe(PrintObserver[int]())
+	defer subscription.Unsubscribe()
+
+	subject.Next(1)
+	handle.Pause()
+	subject.Next(2)
+	subject.Next(3)
+	fmt.Println("Resuming")
+	handle.Resume()
+	subject.Complete()
+
+	// Output:
+	// Next: 1
+	// Resuming
+	// Next: 2
+	// Next: 3
+	// Completed
+}
+
 func ExampleRepeatWith_ok() {
 	observable := Pipe1(
 		Just(1, 2, 3),
@@ -4670,6 +5871,46 @@ func ExamplePipe() {
 	// Completed
 }
 
+func ExamplePipeSame() {
+	observable := PipeSame(
+		Just(" Alice ", "BOB", "  carol"),
+		Map(strings.TrimSpace),
+		Map(strings.ToLower),
+		Filter(func(name string) bool {
+			return name != "bob"
+		}),
+	)
+
+	subscription := observable.Subscribe(PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: alice
+	// Next: carol
+	// Completed
+}
+
+func ExampleCompose() {
+	sanitize := Compose(
+		Map(strings.TrimSpace),
+		Filter(func(s string) bool { return s != "" }),
+		Map(strings.ToLower),
+	)
+
+	observable := Pipe1(
+		Just(" Alice ", "", "BOB"),
+		sanitize,
+	)
+
+	subscription := observable.Subscribe(PrintObserver[string]())
+	defer subscription.Unsubscribe()
+
+	// Output:
+	// Next: alice
+	// Next: bob
+	// Completed
+}
+
 func ExamplePipe1() {
 	observable := Pipe1(
 		Just(1, 2, 3, 4, 5),
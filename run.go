@@ -0,0 +1,433 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ro
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/samber/ro/internal/xerrors"
+)
+
+// Run subscribes to the Observable and calls handler for each item, blocking
+// until the Observable completes, errors, or ctx is canceled. It returns nil on
+// completion, the error emitted by the Observable, the first error returned by
+// handler, or ctx.Err() on cancellation. In the last two cases, the
+// subscription is canceled before Run returns.
+//
+// Run is a safer alternative to Subscription.Wait(): it cannot block forever
+// once ctx is canceled, and it reports the outcome of the pipeline. A nil
+// handler drains the Observable.
+func Run[T any](ctx context.Context, obs Observable[T], handler func(ctx context.Context, value T) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	done := make(chan error, 1)
+	var once sync.Once
+
+	finish := func(err error) {
+		once.Do(func() {
+			done <- err
+		})
+	}
+
+	var subscriber Subscriber[T]
+
+	subscriber = NewSafeSubscriber(
+		NewObserverWithContext(
+			func(ctx context.Context, value T) {
+				if handler == nil {
+					return
+				}
+
+				if err := handler(ctx, value); err != nil {
+					finish(err)
+					subscriber.Unsubscribe()
+				}
+			},
+			func(ctx context.Context, err error) {
+				finish(err)
+			},
+			func(_ context.Context) {
+				// Some sources (Interval, FromChannel...) complete when the
+				// subscription context is canceled: report the cancellation.
+				finish(ctx.Err())
+			},
+		),
+	)
+
+	sub := obs.SubscribeWithContext(ctx, subscriber)
+
+	select {
+	case err := <-done:
+		sub.Unsubscribe()
+		return err
+	case <-ctx.Done():
+		finish(ctx.Err())
+		sub.Unsubscribe()
+
+		// A notification may have won the race against the cancellation.
+		return <-done
+	}
+}
+
+// errRunStopped is returned by Run handlers to stop a pipeline early.
+var errRunStopped = errors.New("ro.Run: stopped")
+
+// FirstValue subscribes to the Observable and returns its first value, then
+// unsubscribes. It returns ErrFirstEmpty if the Observable completes without
+// emitting, the error emitted by the Observable, or ctx.Err() when ctx is
+// canceled first. See Run.
+func FirstValue[T any](ctx context.Context, obs Observable[T]) (T, error) {
+	return elementAtValue(ctx, obs, 0, ErrFirstEmpty)
+}
+
+// LastValue subscribes to the Observable and returns its last value, once
+// completed. It returns ErrLastEmpty if the Observable completes without
+// emitting, the error emitted by the Observable, or ctx.Err() when ctx is
+// canceled first. See Run.
+func LastValue[T any](ctx context.Context, obs Observable[T]) (T, error) {
+	var last T
+	found := false
+
+	err := Run(ctx, obs, func(_ context.Context, value T) error {
+		last = value
+		found = true
+
+		return nil
+	})
+	if err != nil {
+		return lo.Empty[T](), err
+	} else if !found {
+		return lo.Empty[T](), ErrLastEmpty
+	}
+
+	return last, nil
+}
+
+// SingleValue subscribes to the Observable and returns its only value, once
+// completed. It returns ErrSingleValueEmpty if the Observable completes without
+// emitting, ErrSingleValueMultiple as soon as a second value is emitted, the
+// error emitted by the Observable, or ctx.Err() when ctx is canceled first.
+// See Run.
+func SingleValue[T any](ctx context.Context, obs Observable[T]) (T, error) {
+	var single T
+	found := false
+
+	err := Run(ctx, obs, func(_ context.Context, value T) error {
+		if found {
+			return ErrSingleValueMultiple
+		}
+
+		single = value
+		found = true
+
+		return nil
+	})
+	if err != nil {
+		return lo.Empty[T](), err
+	} else if !found {
+		return lo.Empty[T](), ErrSingleValueEmpty
+	}
+
+	return single, nil
+}
+
+// ElementAtValue subscribes to the Observable and returns its nth value
+// (starting at 0), then unsubscribes. It returns ErrElementAtNotFound if the
+// Observable completes before, the error emitted by the Observable, or
+// ctx.Err() when ctx is canceled first. See Run.
+func ElementAtValue[T any](ctx context.Context, obs Observable[T], nth int) (T, error) {
+	if nth < 0 {
+		panic(ErrElementAtWrongNth)
+	}
+
+	return elementAtValue(ctx, obs, nth, ErrElementAtNotFound)
+}
+
+func elementAtValue[T any](ctx context.Context, obs Observable[T], nth int, notFound error) (T, error) {
+	var element T
+	count := 0
+
+	err := Run(ctx, obs, func(_ context.Context, value T) error {
+		if count == nth {
+			element = value
+			return errRunStopped
+		}
+
+		count++
+
+		return nil
+	})
+	if err == errRunStopped {
+		return element, nil
+	} else if err != nil {
+		return lo.Empty[T](), err
+	}
+
+	return lo.Empty[T](), notFound
+}
+
+// CollectFunc subscribes to the Observable and calls fn for each value, as it
+// arrives, instead of accumulating the whole stream like Collect. It blocks
+// until the Observable completes, errors, or ctx is canceled, and returns nil
+// on completion, the error emitted by the Observable, the first error returned
+// by fn, or ctx.Err(). See Run.
+func CollectFunc[T any](ctx context.Context, obs Observable[T], fn func(value T) error) error {
+	return Run(ctx, obs, func(_ context.Context, value T) error {
+		return fn(value)
+	})
+}
+
+// CollectChunks subscribes to the Observable and calls fn with chunks of
+// chunkSize values, as they arrive, so that memory stays bounded on large or
+// unbounded sources. The last chunk may be smaller, and is passed to fn on
+// completion. fn owns the chunks: they are not reused. It blocks until the
+// Observable completes, errors, or ctx is canceled, and returns nil on
+// completion, the error emitted by the Observable, the first error returned by
+// fn, or ctx.Err(). On error, the pending values are dropped. See Run.
+//
+// It panics if chunkSize is lower than 1.
+func CollectChunks[T any](ctx context.Context, obs Observable[T], chunkSize int, fn func(chunk []T) error) error {
+	if chunkSize < 1 {
+		panic(ErrCollectChunksWrongSize)
+	}
+
+	chunk := make([]T, 0, chunkSize)
+
+	err := Run(ctx, obs, func(_ context.Context, value T) error {
+		chunk = append(chunk, value)
+		if len(chunk) < chunkSize {
+			return nil
+		}
+
+		full := chunk
+		chunk = make([]T, 0, chunkSize)
+
+		return fn(full)
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(chunk) > 0 {
+		return fn(chunk)
+	}
+
+	return nil
+}
+
+// MustCollect is like Collect, but panics if the Observable emits an error. It
+// is intended for scripts, examples and tests.
+func MustCollect[T any](obs Observable[T]) []T {
+	values, err := Collect(obs)
+	if err != nil {
+		panic(err)
+	}
+
+	return values
+}
+
+// CollectN subscribes to the Observable and returns its first n values, then
+// unsubscribes. It returns fewer values if the Observable completes before.
+// If the Observable emits an error, the error is returned along with the
+// values collected so far. See Run.
+//
+// It panics if n is lower than 0.
+func CollectN[T any](obs Observable[T], n int) ([]T, error) {
+	if n < 0 {
+		panic(ErrCollectNWrongCount)
+	}
+
+	values := make([]T, 0, n)
+	if n == 0 {
+		return values, nil
+	}
+
+	err := Run(context.Background(), obs, func(_ context.Context, value T) error {
+		values = append(values, value)
+		if len(values) == n {
+			return errRunStopped
+		}
+
+		return nil
+	})
+	if err == errRunStopped {
+		return values, nil
+	}
+
+	return values, err
+}
+
+// CollectWithTimeout is like Collect, but gives up after timeout. On timeout,
+// the subscription is canceled and context.DeadlineExceeded is returned along
+// with the values collected so far. See Run.
+func CollectWithTimeout[T any](obs Observable[T], timeout time.Duration) ([]T, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var mu sync.Mutex
+	values := []T{}
+
+	err := Run(ctx, obs, func(_ context.Context, value T) error {
+		mu.Lock()
+		values = append(values, value)
+		mu.Unlock()
+
+		return nil
+	})
+
+	// On timeout, a late value may still be in flight.
+	mu.Lock()
+	defer mu.Unlock()
+
+	return append([]T{}, values...), err
+}
+
+// RunGroup runs several pipelines concurrently, in the manner of errgroup.Group.
+// The first pipeline returning an error cancels the context shared by the
+// group, and Wait returns that error.
+//
+// A RunGroup must be created with NewRunGroup and must not be reused after Wait.
+type RunGroup struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	wg      sync.WaitGroup
+	errOnce sync.Once
+	err     error
+}
+
+// NewRunGroup creates a RunGroup whose context is derived from ctx.
+func NewRunGroup(ctx context.Context) *RunGroup {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &RunGroup{
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Context returns the context shared by the pipelines of the group. It is
+// canceled when a pipeline fails or when Wait returns.
+func (g *RunGroup) Context() context.Context {
+	return g.ctx
+}
+
+// Go calls run in a new goroutine, with the context of the group.
+func (g *RunGroup) Go(run func(ctx context.Context) error) {
+	g.wg.Add(1)
+
+	go func() {
+		defer g.wg.Done()
+
+		if err := run(g.ctx); err != nil {
+			g.errOnce.Do(func() {
+				g.err = err
+				g.cancel()
+			})
+		}
+	}()
+}
+
+// Wait blocks until every pipeline of the group returned, then returns the
+// first error, if any.
+func (g *RunGroup) Wait() error {
+	g.wg.Wait()
+	g.cancel()
+
+	return g.err
+}
+
+// RunInGroup runs the Observable in the group: see Run.
+func RunInGroup[T any](group *RunGroup, obs Observable[T], handler func(ctx context.Context, value T) error) {
+	group.Go(func(ctx context.Context) error {
+		return Run(ctx, obs, handler)
+	})
+}
+
+// Drainer is implemented by the pipelines supporting a graceful shutdown:
+// CompleteAndDrain stops accepting new values, flushes the values in flight,
+// then completes. It returns ctx.Err() if the pipeline could not be drained
+// before ctx is done. Subjects implement Drainer.
+type Drainer interface {
+	CompleteAndDrain(ctx context.Context) error
+}
+
+// DrainPipeline returns a Drainer for a pipeline fed by source, whose end is
+// subscribed by subscription. Draining the pipeline drains the source, then
+// waits for the completion to reach the end of the pipeline: buffered values
+// are flushed through the sinks on the way. If ctx is done first, the
+// subscription is canceled with ctx.Err() as cause.
+func DrainPipeline(source Drainer, subscription Subscription) Drainer {
+	return &pipelineDrainer{
+		source:       source,
+		subscription: subscription,
+	}
+}
+
+type pipelineDrainer struct {
+	source       Drainer
+	subscription Subscription
+}
+
+func (d *pipelineDrainer) CompleteAndDrain(ctx context.Context) error {
+	err := d.source.CompleteAndDrain(ctx)
+	if err == nil {
+		err = waitWithContext(ctx, d.subscription)
+	}
+
+	if err != nil {
+		unsubscribeWithCause(d.subscription, err)
+	}
+
+	return err
+}
+
+// Shutdown drains the pipelines concurrently, in a graceful shutdown: each
+// of them stops accepting new values, flushes the values in flight, then
+// completes. Pass a context with a deadline to bound the shutdown. It returns
+// the errors of the pipelines that could not be drained, joined.
+//
+// Example, on SIGTERM:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+//	defer cancel()
+//
+//	err := ro.Shutdown(ctx, ro.DrainPipeline(events, subscription))
+func Shutdown(ctx context.Context, pipelines ...Drainer) error {
+	errs := make([]error, len(pipelines))
+
+	var wg sync.WaitGroup
+
+	for i := range pipelines {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			errs[i] = pipelines[i].CompleteAndDrain(ctx)
+		}(i)
+	}
+
+	wg.Wait()
+
+	// errors.Join has been introduced in go 1.20
+	return xerrors.Join(errs...)
+}
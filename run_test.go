@@ -0,0 +1,502 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ro
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	values := []int64{}
+	err := Run(context.Background(), Range(0, 5), func(ctx context.Context, value int64) error {
+		values = append(values, value)
+		return nil
+	})
+	is.NoError(err)
+	is.Equal([]int64{0, 1, 2, 3, 4}, values)
+
+	// async source
+	values = []int64{}
+	err = Run(context.Background(), RangeWithInterval(0, 3, 10*time.Millisecond), func(ctx context.Context, value int64) error {
+		values = append(values, value)
+		return nil
+	})
+	is.NoError(err)
+	is.Equal([]int64{0, 1, 2}, values)
+
+	// nil handler drains the source
+	is.NoError(Run[int64](context.Background(), Range(0, 5), nil))
+
+	// source error
+	err = Run(context.Background(), Throw[int](assert.AnError), func(ctx context.Context, value int) error {
+		return nil
+	})
+	is.ErrorIs(err, assert.AnError)
+}
+
+func TestRunHandlerError(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	var teardown int32
+
+	source := NewObservable(func(destination Observer[int]) Teardown {
+		for i := 0; i < 10 && !destination.IsClosed(); i++ {
+			destination.Next(i)
+		}
+
+		destination.Complete()
+
+		return func() {
+			atomic.AddInt32(&teardown, 1)
+		}
+	})
+
+	values := []int{}
+	err := Run(context.Background(), source, func(ctx context.Context, value int) error {
+		values = append(values, value)
+		if value == 2 {
+			return assert.AnError
+		}
+
+		return nil
+	})
+	is.ErrorIs(err, assert.AnError)
+	is.Equal([]int{0, 1, 2}, values)
+	is.Equal(int32(1), atomic.LoadInt32(&teardown))
+
+	// async source
+	err = Run(context.Background(), Interval(5*time.Millisecond), func(ctx context.Context, value int64) error {
+		if value == 3 {
+			return assert.AnError
+		}
+
+		return nil
+	})
+	is.ErrorIs(err, assert.AnError)
+}
+
+func TestRunContextCanceled(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+
+	err := Run(ctx, Interval(5*time.Millisecond), func(ctx context.Context, value int64) error {
+		return nil
+	})
+	is.ErrorIs(err, context.DeadlineExceeded)
+
+	// already canceled
+	err = Run(ctx, Range(0, 5), func(ctx context.Context, value int64) error {
+		is.Fail("should not be called")
+		return nil
+	})
+	is.ErrorIs(err, context.DeadlineExceeded)
+
+	// subscription context is the one passed to Run
+	type ctxKey struct{}
+
+	err = Run(
+		context.WithValue(context.Background(), ctxKey{}, 42),
+		Range(0, 1),
+		func(ctx context.Context, value int64) error {
+			is.Equal(42, ctx.Value(ctxKey{}))
+			return nil
+		},
+	)
+	is.NoError(err)
+}
+
+func TestFirstValue(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	value, err := FirstValue(context.Background(), Just(1, 2, 3))
+	is.NoError(err)
+	is.Equal(1, value)
+
+	// unsubscribes after the first value
+	value64, err := FirstValue(context.Background(), Interval(5*time.Millisecond))
+	is.NoError(err)
+	is.Equal(int64(0), value64)
+
+	value, err = FirstValue(context.Background(), Empty[int]())
+	is.ErrorIs(err, ErrFirstEmpty)
+	is.Equal(0, value)
+
+	value, err = FirstValue(context.Background(), Throw[int](assert.AnError))
+	is.ErrorIs(err, assert.AnError)
+	is.Equal(0, value)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = FirstValue(ctx, Never())
+	is.ErrorIs(err, context.DeadlineExceeded)
+}
+
+func TestLastValue(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	value, err := LastValue(context.Background(), Just(1, 2, 3))
+	is.NoError(err)
+	is.Equal(3, value)
+
+	value64, err := LastValue(context.Background(), RangeWithInterval(0, 3, 5*time.Millisecond))
+	is.NoError(err)
+	is.Equal(int64(2), value64)
+
+	value, err = LastValue(context.Background(), Empty[int]())
+	is.ErrorIs(err, ErrLastEmpty)
+	is.Equal(0, value)
+
+	value, err = LastValue(context.Background(), Concat(Just(1), Throw[int](assert.AnError)))
+	is.ErrorIs(err, assert.AnError)
+	is.Equal(0, value)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = LastValue(ctx, Interval(5*time.Millisecond))
+	is.ErrorIs(err, context.DeadlineExceeded)
+}
+
+func TestSingleValue(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	value, err := SingleValue(context.Background(), Just(42))
+	is.NoError(err)
+	is.Equal(42, value)
+
+	value, err = SingleValue(context.Background(), Just(1, 2, 3))
+	is.ErrorIs(err, ErrSingleValueMultiple)
+	is.Equal(0, value)
+
+	// fails as soon as a second value is emitted
+	_, err = SingleValue(context.Background(), Interval(5*time.Millisecond))
+	is.ErrorIs(err, ErrSingleValueMultiple)
+
+	value, err = SingleValue(context.Background(), Empty[int]())
+	is.ErrorIs(err, ErrSingleValueEmpty)
+	is.Equal(0, value)
+
+	_, err = SingleValue(context.Background(), Throw[int](assert.AnError))
+	is.ErrorIs(err, assert.AnError)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = SingleValue(ctx, Never())
+	is.ErrorIs(err, context.DeadlineExceeded)
+}
+
+func TestElementAtValue(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	value, err := ElementAtValue(context.Background(), Just(1, 2, 3), 1)
+	is.NoError(err)
+	is.Equal(2, value)
+
+	value, err = ElementAtValue(context.Background(), Just(1, 2, 3), 0)
+	is.NoError(err)
+	is.Equal(1, value)
+
+	value, err = ElementAtValue(context.Background(), Just(1, 2, 3), 3)
+	is.ErrorIs(err, ErrElementAtNotFound)
+	is.Equal(0, value)
+
+	value64, err := ElementAtValue(context.Background(), Interval(5*time.Millisecond), 2)
+	is.NoError(err)
+	is.Equal(int64(2), value64)
+
+	is.PanicsWithValue(ErrElementAtWrongNth, func() {
+		_, _ = ElementAtValue(context.Background(), Just(1), -1)
+	})
+}
+
+func TestCollectFunc(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	values := []int{}
+	err := CollectFunc(context.Background(), Just(1, 2, 3), func(value int) error {
+		values = append(values, value)
+		return nil
+	})
+	is.NoError(err)
+	is.Equal([]int{1, 2, 3}, values)
+
+	values = []int{}
+	err = CollectFunc(context.Background(), Just(1, 2, 3), func(value int) error {
+		values = append(values, value)
+		if value == 2 {
+			return assert.AnError
+		}
+
+		return nil
+	})
+	is.ErrorIs(err, assert.AnError)
+	is.Equal([]int{1, 2}, values)
+
+	err = CollectFunc(context.Background(), Throw[int](assert.AnError), func(value int) error {
+		return nil
+	})
+	is.ErrorIs(err, assert.AnError)
+
+	// unbounded source
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err = CollectFunc(ctx, Interval(time.Millisecond), func(value int64) error {
+		return nil
+	})
+	is.ErrorIs(err, context.DeadlineExceeded)
+}
+
+func TestCollectChunks(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	chunks := [][]int64{}
+	err := CollectChunks(context.Background(), Range(0, 7), 3, func(chunk []int64) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+	is.NoError(err)
+	is.Equal([][]int64{{0, 1, 2}, {3, 4, 5}, {6}}, chunks)
+
+	chunks = [][]int64{}
+	err = CollectChunks(context.Background(), Range(0, 6), 3, func(chunk []int64) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+	is.NoError(err)
+	is.Equal([][]int64{{0, 1, 2}, {3, 4, 5}}, chunks)
+
+	chunks = [][]int64{}
+	err = CollectChunks(context.Background(), Empty[int64](), 3, func(chunk []int64) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+	is.NoError(err)
+	is.Equal([][]int64{}, chunks)
+
+	// fn error
+	chunks = [][]int64{}
+	err = CollectChunks(context.Background(), Range(0, 10), 3, func(chunk []int64) error {
+		chunks = append(chunks, chunk)
+		return assert.AnError
+	})
+	is.ErrorIs(err, assert.AnError)
+	is.Equal([][]int64{{0, 1, 2}}, chunks)
+
+	// the last chunk is passed to fn
+	err = CollectChunks(context.Background(), Range(0, 1), 3, func(chunk []int64) error {
+		return assert.AnError
+	})
+	is.ErrorIs(err, assert.AnError)
+
+	// source error
+	chunks = [][]int64{}
+	err = CollectChunks(context.Background(), Concat(Range(0, 4), Throw[int64](assert.AnError)), 3, func(chunk []int64) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+	is.ErrorIs(err, assert.AnError)
+	is.Equal([][]int64{{0, 1, 2}}, chunks)
+
+	is.PanicsWithValue(ErrCollectChunksWrongSize, func() {
+		_ = CollectChunks(context.Background(), Range(0, 1), 0, func(chunk []int64) error { return nil })
+	})
+}
+
+func TestMustCollect(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	is.Equal([]int{1, 2, 3}, MustCollect(Just(1, 2, 3)))
+	is.Equal([]int{}, MustCollect(Empty[int]()))
+
+	is.PanicsWithValue(assert.AnError, func() {
+		_ = MustCollect(Throw[int](assert.AnError))
+	})
+}
+
+func TestCollectN(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := CollectN(Just(1, 2, 3, 4), 2)
+	is.NoError(err)
+	is.Equal([]int{1, 2}, values)
+
+	values, err = CollectN(Just(1, 2), 5)
+	is.NoError(err)
+	is.Equal([]int{1, 2}, values)
+
+	values, err = CollectN(Just(1, 2), 0)
+	is.NoError(err)
+	is.Equal([]int{}, values)
+
+	values, err = CollectN(Concat(Just(1), Throw[int](assert.AnError)), 2)
+	is.ErrorIs(err, assert.AnError)
+	is.Equal([]int{1}, values)
+
+	// unbounded source
+	ints, err := CollectN(Interval(time.Millisecond), 3)
+	is.NoError(err)
+	is.Equal([]int64{0, 1, 2}, ints)
+
+	is.PanicsWithValue(ErrCollectNWrongCount, func() {
+		_, _ = CollectN(Just(1), -1)
+	})
+}
+
+func TestCollectWithTimeout(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := CollectWithTimeout(Just(1, 2, 3), 100*time.Millisecond)
+	is.NoError(err)
+	is.Equal([]int{1, 2, 3}, values)
+
+	values, err = CollectWithTimeout(Throw[int](assert.AnError), 100*time.Millisecond)
+	is.ErrorIs(err, assert.AnError)
+	is.Equal([]int{}, values)
+
+	// unbounded source
+	ints, err := CollectWithTimeout(Interval(10*time.Millisecond), 55*time.Millisecond)
+	is.ErrorIs(err, context.DeadlineExceeded)
+	is.NotEmpty(ints)
+	is.Equal(int64(0), ints[0])
+}
+
+func TestRunGroup(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	var sum int64
+
+	group := NewRunGroup(context.Background())
+	RunInGroup(group, Range(0, 5), func(ctx context.Context, value int64) error {
+		atomic.AddInt64(&sum, value)
+		return nil
+	})
+	RunInGroup(group, RangeWithInterval(0, 5, 5*time.Millisecond), func(ctx context.Context, value int64) error {
+		atomic.AddInt64(&sum, value)
+		return nil
+	})
+
+	is.NoError(group.Wait())
+	is.Equal(int64(20), atomic.LoadInt64(&sum))
+	is.ErrorIs(group.Context().Err(), context.Canceled)
+
+	// the first error cancels the other pipelines
+	group = NewRunGroup(context.Background())
+	RunInGroup(group, Interval(5*time.Millisecond), func(ctx context.Context, value int64) error {
+		return nil
+	})
+	RunInGroup(group, Interval(5*time.Millisecond), func(ctx context.Context, value int64) error {
+		if value == 2 {
+			return assert.AnError
+		}
+
+		return nil
+	})
+	group.Go(func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	is.ErrorIs(group.Wait(), assert.AnError)
+}
+
+func TestShutdown(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	// buffered values are flushed to the sink
+	source := NewPublishSubject[int]()
+
+	var batches [][]int
+
+	sub := Pipe2(
+		source.AsObservable(),
+		BufferWithCount[int](10),
+		ObserveOn[[]int](10),
+	).Subscribe(OnNext(func(batch []int) {
+		time.Sleep(10 * time.Millisecond) // slow sink
+		batches = append(batches, batch)
+	}))
+
+	source.Next(1)
+	source.Next(2)
+	source.Next(3)
+
+	err := Shutdown(context.Background(), DrainPipeline(source, sub))
+	is.NoError(err)
+	is.Equal([][]int{{1, 2, 3}}, batches)
+	is.True(sub.IsClosed())
+
+	// the deadline is exceeded
+	source = NewPublishSubject[int]()
+
+	sub = Pipe1(
+		source.AsObservable(),
+		ObserveOn[int](10),
+	).Subscribe(OnNext(func(value int) {
+		time.Sleep(50 * time.Millisecond) // slow sink
+	}))
+
+	source.Next(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err = Shutdown(ctx, DrainPipeline(source, sub), NewPublishSubject[int]())
+	is.ErrorIs(err, context.DeadlineExceeded)
+	is.True(sub.IsClosed())
+	is.ErrorIs(sub.(CauseSubscription).Cause(), context.DeadlineExceeded)
+
+	// no pipeline
+	is.NoError(Shutdown(context.Background()))
+}
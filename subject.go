@@ -14,6 +14,8 @@
 
 package ro
 
+import "context"
+
 // Subject is a sort of bridge or proxy, that acts both as an observer and
 // as an Observable. Because it is an observer, it can subscribe to one
 // or more Observables, and because it is an Observable, it can pass through
@@ -31,6 +33,11 @@ type Subject[T any] interface {
 
 	AsObservable() Observable[T]
 	AsObserver() Observer[T]
+
+	// CompleteAndDrain stops accepting new values, delivers the values held
+	// by the subject, then completes. It returns ctx.Err() if the values
+	// could not be delivered before ctx is done. See Drainer.
+	CompleteAndDrain(ctx context.Context) error
 }
 
 // NewSubject is an alias to NewPublishSubject.
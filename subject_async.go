@@ -161,6 +161,16 @@ func (s *asyncSubjectImpl[T]) CompleteWithContext(ctx context.Context) {
 	s.unsubscribeAll()
 }
 
+// CompleteAndDrain completes the subject. Notifications are broadcast
+// synchronously, so the observers have processed the completion when it
+// returns.
+//
+// Implements Drainer.
+func (s *asyncSubjectImpl[T]) CompleteAndDrain(ctx context.Context) error {
+	s.CompleteWithContext(ctx)
+	return nil
+}
+
 func (s *asyncSubjectImpl[T]) HasObserver() bool {
 	has := false
 
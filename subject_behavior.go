@@ -153,6 +153,38 @@ func (s *behaviorSubjectImpl[T]) CompleteWithContext(ctx context.Context) {
 	s.unsubscribeAll()
 }
 
+// CompleteAndDrain completes the subject. Notifications are broadcast
+// synchronously, so the observers have processed the completion when it
+// returns.
+//
+// Implements Drainer.
+func (s *behaviorSubjectImpl[T]) CompleteAndDrain(ctx context.Context) error {
+	s.CompleteWithContext(ctx)
+	return nil
+}
+
+func (s *behaviorSubjectImpl[T]) snapshotEntries() []snapshotEntry[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return []snapshotEntry[T]{{value: s.last.B}}
+}
+
+func (s *behaviorSubjectImpl[T]) restoreEntries(entries []snapshotEntry[T]) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.status != KindNext {
+		return ErrSubjectRestoreClosed
+	}
+
+	if len(entries) > 0 {
+		s.last = lo.T2(context.Background(), entries[len(entries)-1].value)
+	}
+
+	return nil
+}
+
 func (s *behaviorSubjectImpl[T]) HasObserver() (has bool) {
 	has = false
 
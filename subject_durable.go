@@ -0,0 +1,600 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ro
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/samber/lo"
+)
+
+// DurableSubjectLogFile is the name of the write-ahead log of a
+// DurableSubject, in its directory.
+const DurableSubjectLogFile = "wal.log"
+
+// DurableSubject is a Subject persisting its notifications to a write-ahead
+// log. See NewDurableSubject.
+type DurableSubject[T any] interface {
+	Subject[T]
+
+	// Pending returns the number of values that have not been acknowledged
+	// yet.
+	Pending() int
+	// Close closes the write-ahead log. The notifications received
+	// afterwards are dropped. The values that have not been acknowledged are
+	// replayed by the next DurableSubject opened on the same directory.
+	Close() error
+}
+
+var _ DurableSubject[int] = (*durableSubjectImpl[int])(nil)
+
+// NewDurableSubject creates a Subject appending its notifications to a
+// write-ahead log in dir, encoded by codec, so that they survive a crash. It
+// behaves like a UnicastSubject with an unlimited buffer: the values are
+// queued until a single Observer subscribes to it, then relayed live.
+//
+// A value is acknowledged once it has been delivered to an Observer that is
+// still subscribed afterwards. When the subject is opened again on the same
+// directory, for instance after a crash, the values that have not been
+// acknowledged are replayed to the first Observer, followed by the error or
+// the completion, if any: delivery is at least once. The log is compacted on
+// opening, and truncated when every value has been acknowledged. Since the
+// error and the completion are persisted too, a terminated subject stays
+// terminated: remove the directory to start over.
+//
+// Each notification is synced to disk before being delivered, which bounds
+// the throughput: the DurableSubject is intended for small workloads.
+// A directory must not be opened by several DurableSubjects at once.
+func NewDurableSubject[T any](dir string, codec Codec[T]) (DurableSubject[T], error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(dir, DurableSubjectLogFile)
+
+	records, err := readDurableLog(path)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &durableSubjectImpl[T]{
+		mu:     sync.Mutex{},
+		status: KindNext,
+
+		codec: codec,
+
+		err:    lo.Tuple2[context.Context, error]{},
+		values: []durableEntry[T]{},
+	}
+
+	acked := map[uint64]struct{}{}
+
+	for _, record := range records {
+		if record.kind == durableRecordAck {
+			acked[record.seq] = struct{}{}
+		}
+	}
+
+	// The log is rewritten with the pending notifications only.
+	compacted := []byte{}
+
+	for _, record := range records {
+		if record.seq > s.seq {
+			s.seq = record.seq
+		}
+
+		switch record.kind {
+		case durableRecordNext:
+			if _, ok := acked[record.seq]; ok {
+				continue
+			}
+
+			value, err := codec.Decode(record.payload)
+			if err != nil {
+				return nil, err
+			}
+
+			s.values = append(s.values, durableEntry[T]{seq: record.seq, value: value})
+		case durableRecordError:
+			s.status = KindError
+			s.err = lo.T2(context.Background(), errors.New(string(record.payload)))
+		case durableRecordComplete:
+			s.status = KindComplete
+		default:
+			continue
+		}
+
+		compacted = appendDurableRecord(compacted, record)
+	}
+
+	s.unacked = len(s.values)
+
+	if err := writeFileAtomic(path, compacted); err != nil {
+		return nil, err
+	}
+
+	s.file, err = os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+type durableEntry[T any] struct {
+	seq   uint64
+	ctx   context.Context // nil when replayed from the log
+	value T
+}
+
+type durableSubjectImpl[T any] struct {
+	mu     sync.Mutex
+	status Kind
+	closed bool
+
+	codec   Codec[T]
+	file    *os.File
+	seq     uint64 // last sequence number
+	unacked int
+
+	observer Observer[T]
+
+	err    lo.Tuple2[context.Context, error]
+	values []durableEntry[T] // not delivered yet
+
+	// draining is not nil while CompleteAndDrain waits for an observer
+	// to flush the pending values to.
+	draining chan struct{}
+}
+
+// Implements Observable.
+func (s *durableSubjectImpl[T]) Subscribe(destination Observer[T]) Subscription {
+	return s.SubscribeWithContext(context.Background(), destination)
+}
+
+// Implements Observable.
+func (s *durableSubjectImpl[T]) SubscribeWithContext(subscriberCtx context.Context, destination Observer[T]) Subscription {
+	subscription := NewSubscriber(destination)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.observer != nil {
+		subscription.ErrorWithContext(subscriberCtx, ErrDurableSubjectConcurrent)
+		return subscription
+	}
+
+	for len(s.values) > 0 {
+		entry := s.values[0]
+
+		ctx := entry.ctx
+		if ctx == nil {
+			ctx = subscriberCtx
+		}
+
+		subscription.NextWithContext(ctx, entry.value)
+
+		if subscription.IsClosed() {
+			// The value might not have been processed.
+			return subscription
+		}
+
+		s.values = s.values[1:]
+		s.ack(ctx, entry.seq)
+	}
+
+	switch s.status {
+	case KindNext:
+		// fallthrough
+	case KindError:
+		s.stopDraining()
+		subscription.ErrorWithContext(s.err.A, s.err.B)
+
+		return subscription
+	case KindComplete:
+		s.stopDraining()
+		subscription.CompleteWithContext(subscriberCtx)
+
+		return subscription
+	}
+
+	s.observer = subscription
+
+	subscription.Add(func() {
+		s.mu.Lock()
+		if s.observer == subscription {
+			s.observer = nil
+		}
+		s.mu.Unlock()
+	})
+
+	return subscription
+}
+
+// Implements Observer.
+func (s *durableSubjectImpl[T]) Next(value T) {
+	s.NextWithContext(context.Background(), value)
+}
+
+// Implements Observer.
+func (s *durableSubjectImpl[T]) NextWithContext(ctx context.Context, value T) {
+	s.mu.Lock()
+
+	if s.status != KindNext || s.closed {
+		s.mu.Unlock()
+		OnDroppedNotification(ctx, NewNotificationNext(value))
+
+		return
+	}
+
+	payload, err := s.codec.Encode(value)
+	if err == nil {
+		s.seq++
+		err = s.append(durableRecord{kind: durableRecordNext, seq: s.seq, payload: payload})
+	}
+
+	if err != nil {
+		s.mu.Unlock()
+		s.ErrorWithContext(ctx, err)
+
+		return
+	}
+
+	s.unacked++
+
+	entry := durableEntry[T]{seq: s.seq, ctx: ctx, value: value}
+
+	observer := s.observer
+	if observer == nil {
+		s.values = append(s.values, entry)
+		s.mu.Unlock()
+
+		return
+	}
+
+	s.mu.Unlock()
+
+	observer.NextWithContext(ctx, value) // out of lock
+
+	s.mu.Lock()
+
+	if observer.IsClosed() {
+		// The value might not have been processed: it is queued again for
+		// the next observer.
+		s.requeue(entry)
+	} else {
+		s.ack(ctx, entry.seq)
+	}
+
+	s.mu.Unlock()
+}
+
+// Implements Observer.
+func (s *durableSubjectImpl[T]) Error(err error) {
+	s.ErrorWithContext(context.Background(), err)
+}
+
+// Implements Observer.
+func (s *durableSubjectImpl[T]) ErrorWithContext(ctx context.Context, err error) {
+	s.mu.Lock()
+
+	if s.status != KindNext || s.closed {
+		s.mu.Unlock()
+		OnDroppedNotification(ctx, NewNotificationError[T](err))
+
+		return
+	}
+
+	if walErr := s.append(durableRecord{kind: durableRecordError, seq: s.seq, payload: []byte(err.Error())}); walErr != nil {
+		OnUnhandledError(ctx, walErr)
+	}
+
+	s.status = KindError
+	s.err = lo.T2(ctx, err)
+
+	observer := s.observer
+	s.observer = nil
+
+	s.mu.Unlock()
+
+	// Without observer, the error is delivered to the next one.
+	if observer != nil {
+		observer.ErrorWithContext(ctx, err)
+	}
+}
+
+// Implements Observer.
+func (s *durableSubjectImpl[T]) Complete() {
+	s.CompleteWithContext(context.Background())
+}
+
+// Implements Observer.
+func (s *durableSubjectImpl[T]) CompleteWithContext(ctx context.Context) {
+	s.mu.Lock()
+
+	if s.status != KindNext || s.closed {
+		s.mu.Unlock()
+		OnDroppedNotification(ctx, NewNotificationComplete[T]())
+
+		return
+	}
+
+	s.complete(ctx)
+
+	observer := s.observer
+	s.observer = nil
+
+	s.mu.Unlock()
+
+	// Without observer, the completion is delivered to the next one.
+	if observer != nil {
+		observer.CompleteWithContext(ctx)
+	}
+}
+
+// CompleteAndDrain stops accepting new values and completes the subject. When
+// values are pending and no observer subscribed yet, it waits for the next
+// observer to receive them, before completing it. If ctx is done first,
+// ctx.Err() is returned: the values stay in the log, and are delivered to the
+// next observer.
+//
+// Implements Drainer.
+func (s *durableSubjectImpl[T]) CompleteAndDrain(ctx context.Context) error {
+	s.mu.Lock()
+
+	if s.status != KindNext || s.closed || s.observer != nil || len(s.values) == 0 {
+		s.mu.Unlock()
+		s.CompleteWithContext(ctx)
+
+		return nil
+	}
+
+	s.complete(ctx)
+	s.draining = make(chan struct{})
+	draining := s.draining
+
+	s.mu.Unlock()
+
+	select {
+	case <-draining:
+		return nil
+	case <-ctx.Done():
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.draining != draining {
+		// The values were delivered concurrently.
+		return nil
+	}
+
+	s.draining = nil
+
+	return ctx.Err()
+}
+
+// Pending implements DurableSubject.
+func (s *durableSubjectImpl[T]) Pending() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.unacked
+}
+
+// Close implements DurableSubject.
+func (s *durableSubjectImpl[T]) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	s.closed = true
+
+	return s.file.Close()
+}
+
+// complete must be called with the lock held.
+func (s *durableSubjectImpl[T]) complete(ctx context.Context) {
+	if err := s.append(durableRecord{kind: durableRecordComplete, seq: s.seq}); err != nil {
+		OnUnhandledError(ctx, err)
+	}
+
+	s.status = KindComplete
+}
+
+// ack must be called with the lock held. Once every value has been
+// acknowledged, the log is truncated.
+func (s *durableSubjectImpl[T]) ack(ctx context.Context, seq uint64) {
+	if s.closed {
+		// The value is replayed on the next opening.
+		return
+	}
+
+	s.unacked--
+
+	// Acknowledgements are not synced: when lost, the value is delivered
+	// again.
+	err := s.append(durableRecord{kind: durableRecordAck, seq: seq})
+	if err == nil && s.unacked == 0 && s.status == KindNext {
+		err = s.file.Truncate(0)
+	}
+
+	if err != nil {
+		OnUnhandledError(ctx, err)
+	}
+}
+
+// requeue must be called with the lock held.
+func (s *durableSubjectImpl[T]) requeue(entry durableEntry[T]) {
+	i := len(s.values)
+	for i > 0 && s.values[i-1].seq > entry.seq {
+		i--
+	}
+
+	s.values = append(s.values, durableEntry[T]{})
+	copy(s.values[i+1:], s.values[i:])
+	s.values[i] = entry
+}
+
+// stopDraining must be called with the lock held.
+func (s *durableSubjectImpl[T]) stopDraining() {
+	if s.draining != nil {
+		close(s.draining)
+		s.draining = nil
+	}
+}
+
+// append must be called with the lock held. Notifications are synced to disk.
+func (s *durableSubjectImpl[T]) append(record durableRecord) error {
+	_, err := s.file.Write(appendDurableRecord(nil, record))
+	if err == nil && record.kind != durableRecordAck {
+		err = s.file.Sync()
+	}
+
+	return err
+}
+
+func (s *durableSubjectImpl[T]) HasObserver() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.observer != nil
+}
+
+func (s *durableSubjectImpl[T]) CountObservers() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.observer != nil {
+		return 1
+	}
+
+	return 0
+}
+
+// Implements Observer.
+func (s *durableSubjectImpl[T]) IsClosed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.status != KindNext || s.closed
+}
+
+// Implements Observer.
+func (s *durableSubjectImpl[T]) HasThrown() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.status == KindError
+}
+
+// Implements Observer.
+func (s *durableSubjectImpl[T]) IsCompleted() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.status == KindComplete
+}
+
+func (s *durableSubjectImpl[T]) AsObservable() Observable[T] {
+	return s
+}
+
+func (s *durableSubjectImpl[T]) AsObserver() Observer[T] {
+	return s
+}
+
+/************************
+ *   Write-ahead log    *
+ ************************/
+
+const (
+	durableRecordNext     byte = 'N'
+	durableRecordError    byte = 'E'
+	durableRecordComplete byte = 'C'
+	durableRecordAck      byte = 'A'
+)
+
+// durableRecordHeaderSize is the size of the header of a record: the size of
+// the body (uint32), then its CRC-32 (uint32). The body holds the kind of the
+// record (1 byte), its sequence number (uint64), then the payload.
+const durableRecordHeaderSize = 8
+
+type durableRecord struct {
+	kind    byte
+	seq     uint64
+	payload []byte
+}
+
+func appendDurableRecord(buf []byte, record durableRecord) []byte {
+	body := make([]byte, 9, 9+len(record.payload))
+	body[0] = record.kind
+	binary.BigEndian.PutUint64(body[1:], record.seq)
+	body = append(body, record.payload...)
+
+	var header [durableRecordHeaderSize]byte
+	binary.BigEndian.PutUint32(header[0:], uint32(len(body)))
+	binary.BigEndian.PutUint32(header[4:], crc32.ChecksumIEEE(body))
+
+	buf = append(buf, header[:]...)
+
+	return append(buf, body...)
+}
+
+// readDurableLog reads the records of a log. A torn or corrupted record, left
+// by a crash during a write, ends the log.
+func readDurableLog(path string) ([]durableRecord, error) {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	records := []durableRecord{}
+
+	for len(content) >= durableRecordHeaderSize {
+		size := int(binary.BigEndian.Uint32(content[0:]))
+		checksum := binary.BigEndian.Uint32(content[4:])
+
+		if size < 9 || len(content)-durableRecordHeaderSize < size {
+			break
+		}
+
+		body := content[durableRecordHeaderSize : durableRecordHeaderSize+size]
+		if crc32.ChecksumIEEE(body) != checksum {
+			break
+		}
+
+		records = append(records, durableRecord{
+			kind:    body[0],
+			seq:     binary.BigEndian.Uint64(body[1:]),
+			payload: body[9:],
+		})
+
+		content = content[durableRecordHeaderSize+size:]
+	}
+
+	return records, nil
+}
@@ -0,0 +1,249 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ro
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func collectDurable[T any](subject Subject[T]) ([]T, bool, error) {
+	values := []T{}
+	var err error
+	completed := false
+
+	subject.Subscribe(NewObserver(
+		func(value T) {
+			values = append(values, value)
+		},
+		func(e error) {
+			err = e
+		},
+		func() {
+			completed = true
+		},
+	))
+
+	return values, completed, err
+}
+
+func TestDurableSubject(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	dir := t.TempDir()
+
+	subject, err := NewDurableSubject(dir, NewJSONCodec[string]())
+	is.NoError(err)
+	is.False(subject.HasObserver())
+	is.False(subject.IsClosed())
+
+	// queued until subscription
+	subject.Next("a")
+	subject.Next("b")
+	is.Equal(2, subject.Pending())
+
+	var values []string
+	sub := subject.Subscribe(OnNext(func(value string) {
+		values = append(values, value)
+	}))
+	is.Equal([]string{"a", "b"}, values)
+	is.Equal(0, subject.Pending())
+	is.True(subject.HasObserver())
+	is.Equal(1, subject.CountObservers())
+
+	// single subscriber
+	_, _, err = collectDurable[string](subject)
+	is.ErrorIs(err, ErrDurableSubjectConcurrent)
+
+	// live
+	subject.Next("c")
+	is.Equal([]string{"a", "b", "c"}, values)
+	is.Equal(0, subject.Pending())
+
+	// every value acknowledged: the log is truncated
+	info, err := os.Stat(filepath.Join(dir, DurableSubjectLogFile))
+	is.NoError(err)
+	is.EqualValues(0, info.Size())
+
+	sub.Unsubscribe()
+	is.False(subject.HasObserver())
+
+	subject.Next("d")
+	subject.Complete()
+	is.True(subject.IsClosed())
+	is.True(subject.IsCompleted())
+	is.NoError(subject.Close())
+	is.NoError(subject.Close())
+
+	// restart
+	subject, err = NewDurableSubject(dir, NewJSONCodec[string]())
+	is.NoError(err)
+	is.Equal(1, subject.Pending())
+	is.True(subject.IsCompleted())
+
+	values, completed, err := collectDurable[string](subject)
+	is.Equal([]string{"d"}, values)
+	is.NoError(err)
+	is.True(completed)
+	is.Equal(0, subject.Pending())
+	is.NoError(subject.Close())
+
+	// still completed
+	subject, err = NewDurableSubject(dir, NewJSONCodec[string]())
+	is.NoError(err)
+	values, completed, err = collectDurable[string](subject)
+	is.Empty(values)
+	is.NoError(err)
+	is.True(completed)
+	is.NoError(subject.Close())
+}
+
+func TestDurableSubjectCrash(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	dir := t.TempDir()
+
+	subject, err := NewDurableSubject(dir, NewJSONCodec[int]())
+	is.NoError(err)
+
+	// The observer stops while processing 2: 2 is not acknowledged.
+	var sub Subscription
+	sub = subject.Subscribe(OnNext(func(value int) {
+		if value == 2 {
+			sub.Unsubscribe()
+		}
+	}))
+	subject.Next(1)
+	subject.Next(2)
+	subject.Next(3)
+	subject.Error(assert.AnError)
+	is.Equal(2, subject.Pending())
+
+	// crash: the log is not closed, and a record is torn
+	file, err := os.OpenFile(filepath.Join(dir, DurableSubjectLogFile), os.O_WRONLY|os.O_APPEND, 0o600)
+	is.NoError(err)
+	_, err = file.Write([]byte{0, 0, 0, 42, 1, 2})
+	is.NoError(err)
+	is.NoError(file.Close())
+
+	subject, err = NewDurableSubject(dir, NewJSONCodec[int]())
+	is.NoError(err)
+	is.Equal(2, subject.Pending())
+	is.True(subject.HasThrown())
+
+	values, _, err := collectDurable[int](subject)
+	is.Equal([]int{2, 3}, values)
+	is.EqualError(err, assert.AnError.Error())
+	is.NoError(subject.Close())
+}
+
+func TestDurableSubjectErrors(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	dir := t.TempDir()
+
+	// encoding error
+	subject, err := NewDurableSubject(dir, NewJSONCodec[func()]())
+	is.NoError(err)
+	subject.Next(func() {})
+	is.True(subject.HasThrown())
+	is.Equal(0, subject.Pending())
+
+	_, _, err = collectDurable[func()](subject)
+	is.Error(err)
+	is.NoError(subject.Close())
+
+	// decoding error
+	dir = t.TempDir()
+	subject2, err := NewDurableSubject(dir, NewJSONCodec[string]())
+	is.NoError(err)
+	subject2.Next("a")
+	is.NoError(subject2.Close())
+
+	_, err = NewDurableSubject(dir, NewJSONCodec[int]())
+	is.Error(err)
+
+	// closed
+	subject2, err = NewDurableSubject(dir, NewJSONCodec[string]())
+	is.NoError(err)
+	is.NoError(subject2.Close())
+	subject2.Next("b")
+	is.True(subject2.IsClosed())
+
+	subject2, err = NewDurableSubject(dir, NewJSONCodec[string]())
+	is.NoError(err)
+	is.Equal(1, subject2.Pending())
+	is.NoError(subject2.Close())
+
+	// invalid directory
+	path := filepath.Join(t.TempDir(), "file")
+	is.NoError(os.WriteFile(path, nil, 0o600))
+	_, err = NewDurableSubject(path, NewJSONCodec[string]())
+	is.Error(err)
+}
+
+func TestDurableSubjectCompleteAndDrain(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 1*time.Second)
+	is := assert.New(t)
+
+	subject, err := NewDurableSubject(t.TempDir(), NewJSONCodec[int]())
+	is.NoError(err)
+
+	subject.Next(1)
+	subject.Next(2)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- subject.CompleteAndDrain(context.Background())
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	is.True(subject.IsClosed())
+
+	values, completed, err := collectDurable[int](subject)
+	is.Equal([]int{1, 2}, values)
+	is.NoError(err)
+	is.True(completed)
+	is.NoError(<-done)
+	is.NoError(subject.Close())
+
+	// timeout: the values stay pending
+	subject, err = NewDurableSubject(t.TempDir(), NewJSONCodec[int]())
+	is.NoError(err)
+	subject.Next(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	is.True(errors.Is(subject.CompleteAndDrain(ctx), context.DeadlineExceeded))
+	is.Equal(1, subject.Pending())
+
+	values, completed, _ = collectDurable[int](subject)
+	is.Equal([]int{1}, values)
+	is.True(completed)
+	is.NoError(subject.Close())
+}
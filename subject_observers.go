@@ -0,0 +1,161 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ro
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// subjectObservers is the list of observers of a subject, split into shards.
+// Observers are assigned to a shard by subscription index. With a single shard,
+// broadcasting iterates over the observers on the caller goroutine. With more
+// shards, every shard is broadcast to on its own goroutine, and the broadcast
+// returns once all of them are done: each observer still receives notifications
+// in order, but observers of different shards are called concurrently.
+type subjectObservers[T any] struct {
+	shards []subjectObserversShard[T]
+}
+
+type subjectObserversShard[T any] struct {
+	observers sync.Map
+	count     int64
+}
+
+func newSubjectObservers[T any](shards int) subjectObservers[T] {
+	if shards < 1 {
+		shards = 1
+	}
+
+	return subjectObservers[T]{
+		shards: make([]subjectObserversShard[T], shards),
+	}
+}
+
+func (s *subjectObservers[T]) shard(index uint32) *subjectObserversShard[T] {
+	return &s.shards[index%uint32(len(s.shards))]
+}
+
+func (s *subjectObservers[T]) store(index uint32, observer Observer[T]) {
+	shard := s.shard(index)
+	shard.observers.Store(index, observer)
+	atomic.AddInt64(&shard.count, 1)
+}
+
+func (s *subjectObservers[T]) delete(index uint32) {
+	shard := s.shard(index)
+	if _, ok := shard.observers.LoadAndDelete(index); ok {
+		atomic.AddInt64(&shard.count, -1)
+	}
+}
+
+func (s *subjectObservers[T]) clear() {
+	for i := range s.shards {
+		shard := &s.shards[i]
+		shard.observers.Range(func(key, _ any) bool {
+			if _, ok := shard.observers.LoadAndDelete(key); ok {
+				atomic.AddInt64(&shard.count, -1)
+			}
+			return true
+		})
+	}
+}
+
+func (s *subjectObservers[T]) count() int {
+	count := int64(0)
+	for i := range s.shards {
+		count += atomic.LoadInt64(&s.shards[i].count)
+	}
+
+	return int(count)
+}
+
+func (s *subjectObservers[T]) broadcastNext(ctx context.Context, value T) {
+	if len(s.shards) == 1 {
+		// Fast path: no closure escapes to the heap, so broadcasting does not allocate.
+		s.shards[0].observers.Range(func(_, observer any) bool {
+			observer.(Observer[T]).NextWithContext(ctx, value) //nolint:forcetypeassert
+			return true
+		})
+
+		return
+	}
+
+	s.broadcastParallel(func(observer Observer[T]) {
+		observer.NextWithContext(ctx, value)
+	})
+}
+
+func (s *subjectObservers[T]) broadcastError(ctx context.Context, err error) {
+	s.broadcastParallel(func(observer Observer[T]) {
+		observer.ErrorWithContext(ctx, err)
+	})
+}
+
+func (s *subjectObservers[T]) broadcastComplete(ctx context.Context) {
+	s.broadcastParallel(func(observer Observer[T]) {
+		observer.CompleteWithContext(ctx)
+	})
+}
+
+// broadcastParallel calls cb for every observer, with one goroutine per non-empty
+// shard. A single shard is broadcast to on the caller goroutine.
+func (s *subjectObservers[T]) broadcastParallel(cb func(observer Observer[T])) {
+	if len(s.shards) == 1 {
+		s.shards[0].broadcast(cb)
+		return
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var panicked any
+
+	for i := range s.shards {
+		shard := &s.shards[i]
+		if atomic.LoadInt64(&shard.count) == 0 {
+			continue
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			defer func() {
+				// Panics are rethrown on the caller goroutine, as for a single shard.
+				if e := recover(); e != nil {
+					mu.Lock()
+					panicked = e
+					mu.Unlock()
+				}
+			}()
+
+			shard.broadcast(cb)
+		}()
+	}
+
+	wg.Wait()
+
+	if panicked != nil {
+		panic(panicked)
+	}
+}
+
+func (s *subjectObserversShard[T]) broadcast(cb func(observer Observer[T])) {
+	s.observers.Range(func(_, observer any) bool {
+		cb(observer.(Observer[T])) //nolint:forcetypeassert
+		return true
+	})
+}
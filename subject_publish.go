@@ -27,11 +27,21 @@ var _ Subject[int] = (*publishSubjectImpl[int])(nil)
 // NewPublishSubject broadcasts a value to observers (fanout).
 // Values received before subscription are not transmitted.
 func NewPublishSubject[T any]() Subject[T] {
+	return NewShardedPublishSubject[T](1)
+}
+
+// NewShardedPublishSubject broadcasts a value to observers (fanout), like
+// NewPublishSubject, for subjects with thousands of observers. Observers are
+// split into `shards` lists, broadcast to in parallel: each observer receives
+// notifications in order, but observers of different shards are called
+// concurrently. Next returns once every observer received the value.
+// Values received before subscription are not transmitted.
+func NewShardedPublishSubject[T any](shards int) Subject[T] {
 	return &publishSubjectImpl[T]{
 		mu:     sync.Mutex{},
 		status: KindNext,
 
-		observers:     sync.Map{},
+		observers:     newSubjectObservers[T](shards),
 		observerIndex: 0,
 
 		err: lo.Tuple2[context.Context, error]{},
@@ -42,7 +52,7 @@ type publishSubjectImpl[T any] struct {
 	mu     sync.Mutex // sync.RWMutex would be better, but it is too slow for high-volume subjects
 	status Kind
 
-	observers     sync.Map
+	observers     subjectObservers[T]
 	observerIndex uint32
 
 	err lo.Tuple2[context.Context, error]
@@ -72,20 +82,17 @@ func (s *publishSubjectImpl[T]) SubscribeWithContext(subscriberCtx context.Conte
 	}
 
 	index := atomic.AddUint32(&s.observerIndex, 1) - 1
-	s.observers.Store(index, subscription)
+	s.observers.store(index, subscription)
 
 	subscription.Add(func() {
-		s.observers.Delete(index)
+		s.observers.delete(index)
 	})
 
 	return subscription
 }
 
 func (s *publishSubjectImpl[T]) unsubscribeAll() {
-	s.observers.Range(func(key, _ any) bool {
-		s.observers.Delete(key)
-		return true
-	})
+	s.observers.clear()
 }
 
 // Implements Observer.
@@ -147,26 +154,22 @@ func (s *publishSubjectImpl[T]) CompleteWithContext(ctx context.Context) {
 	s.unsubscribeAll()
 }
 
-func (s *publishSubjectImpl[T]) HasObserver() (has bool) {
-	has = false
-
-	s.observers.Range(func(key, value any) bool {
-		has = true
-		return false
-	})
+// CompleteAndDrain completes the subject. Notifications are broadcast
+// synchronously, so the observers have processed the completion when it
+// returns.
+//
+// Implements Drainer.
+func (s *publishSubjectImpl[T]) CompleteAndDrain(ctx context.Context) error {
+	s.CompleteWithContext(ctx)
+	return nil
+}
 
-	return has
+func (s *publishSubjectImpl[T]) HasObserver() bool {
+	return s.observers.count() > 0
 }
 
 func (s *publishSubjectImpl[T]) CountObservers() int {
-	count := 0
-
-	s.observers.Range(func(key, value any) bool {
-		count++
-		return true
-	})
-
-	return count
+	return s.observers.count()
 }
 
 // Implements Observer.
@@ -202,22 +205,13 @@ func (s *publishSubjectImpl[T]) AsObserver() Observer[T] {
 }
 
 func (s *publishSubjectImpl[T]) broadcastNext(ctx context.Context, value T) {
-	s.observers.Range(func(_, observer any) bool {
-		observer.(Observer[T]).NextWithContext(ctx, value) //nolint:errcheck,forcetypeassert
-		return true
-	})
+	s.observers.broadcastNext(ctx, value)
 }
 
 func (s *publishSubjectImpl[T]) broadcastError(ctx context.Context, err error) {
-	s.observers.Range(func(_, observer any) bool {
-		observer.(Observer[T]).ErrorWithContext(ctx, err) //nolint:errcheck,forcetypeassert
-		return true
-	})
+	s.observers.broadcastError(ctx, err)
 }
 
 func (s *publishSubjectImpl[T]) broadcastComplete(ctx context.Context) {
-	s.observers.Range(func(_, observer any) bool {
-		observer.(Observer[T]).CompleteWithContext(ctx) //nolint:errcheck,forcetypeassert
-		return true
-	})
+	s.observers.broadcastComplete(ctx)
 }
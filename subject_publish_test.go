@@ -15,6 +15,8 @@
 package ro
 
 import (
+	"context"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -33,7 +35,7 @@ func TestPublishSubject_internalOk(t *testing.T) {
 	// default state
 	is.Equal(KindNext, subject.status)
 	is.Empty(subject.err)
-	is.Equal(0, syncMapLength(&subject.observers))
+	is.Equal(0, subject.observers.count())
 	is.Equal(uint32(0), subject.observerIndex)
 
 	// send values
@@ -41,21 +43,21 @@ func TestPublishSubject_internalOk(t *testing.T) {
 	subject.Next(42)
 	is.Equal(KindNext, subject.status)
 	is.Empty(subject.err)
-	is.Equal(0, syncMapLength(&subject.observers))
+	is.Equal(0, subject.observers.count())
 	is.Equal(uint32(0), subject.observerIndex)
 
 	// completed state
 	subject.Complete()
 	is.Equal(KindComplete, subject.status)
 	is.Empty(subject.err)
-	is.Equal(0, syncMapLength(&subject.observers))
+	is.Equal(0, subject.observers.count())
 	is.Equal(uint32(0), subject.observerIndex)
 
 	// no change
 	subject.Next(84)
 	is.Equal(KindComplete, subject.status)
 	is.Empty(subject.err)
-	is.Equal(0, syncMapLength(&subject.observers))
+	is.Equal(0, subject.observers.count())
 	is.Equal(uint32(0), subject.observerIndex)
 }
 
@@ -70,7 +72,7 @@ func TestPublishSubject_internalError(t *testing.T) {
 	// default state
 	is.Equal(KindNext, subject.status)
 	is.Empty(subject.err)
-	is.Equal(0, syncMapLength(&subject.observers))
+	is.Equal(0, subject.observers.count())
 	is.Equal(uint32(0), subject.observerIndex)
 
 	// send values
@@ -78,21 +80,21 @@ func TestPublishSubject_internalError(t *testing.T) {
 	subject.Next(42)
 	is.Equal(KindNext, subject.status)
 	is.Empty(subject.err)
-	is.Equal(0, syncMapLength(&subject.observers))
+	is.Equal(0, subject.observers.count())
 	is.Equal(uint32(0), subject.observerIndex)
 
 	// trigger error
 	subject.Error(assert.AnError)
 	is.Equal(KindError, subject.status)
 	is.Equal(assert.AnError, subject.err.B)
-	is.Equal(0, syncMapLength(&subject.observers))
+	is.Equal(0, subject.observers.count())
 	is.Equal(uint32(0), subject.observerIndex)
 
 	// no change
 	subject.Next(84)
 	is.Equal(KindError, subject.status)
 	is.Equal(assert.AnError, subject.err.B)
-	is.Equal(0, syncMapLength(&subject.observers))
+	is.Equal(0, subject.observers.count())
 	is.Equal(uint32(0), subject.observerIndex)
 }
 
@@ -105,39 +107,39 @@ func TestPublishSubject_internalSubscription(t *testing.T) {
 	is.True(ok)
 
 	// default state
-	is.Equal(0, syncMapLength(&subject.observers))
+	is.Equal(0, subject.observers.count())
 	is.Equal(uint32(0), subject.observerIndex)
-	is.Equal(0, syncMapLength(&subject.observers))
+	is.Equal(0, subject.observers.count())
 	is.Equal(0, subject.CountObservers())
 
 	// subscribe
 	sub1 := subject.Subscribe(NoopObserver[int]())
 	is.Equal(uint32(1), subject.observerIndex)
-	is.Equal(1, syncMapLength(&subject.observers))
+	is.Equal(1, subject.observers.count())
 	is.Equal(1, subject.CountObservers())
 
 	// unsubscribe
 	sub1.Unsubscribe()
 	is.Equal(uint32(1), subject.observerIndex)
-	is.Equal(0, syncMapLength(&subject.observers))
+	is.Equal(0, subject.observers.count())
 	is.Equal(0, subject.CountObservers())
 
 	// resubscribe before completion
 	sub2 := subject.Subscribe(NoopObserver[int]())
 	is.Equal(uint32(2), subject.observerIndex)
-	is.Equal(1, syncMapLength(&subject.observers))
+	is.Equal(1, subject.observers.count())
 	is.Equal(1, subject.CountObservers())
 
 	// completed state
 	subject.Complete()
 	is.Equal(uint32(2), subject.observerIndex)
-	is.Equal(0, syncMapLength(&subject.observers))
+	is.Equal(0, subject.observers.count())
 	is.Equal(0, subject.CountObservers())
 
 	// no change
 	sub3 := subject.Subscribe(NoopObserver[int]())
 	is.Equal(uint32(2), subject.observerIndex)
-	is.Equal(0, syncMapLength(&subject.observers))
+	is.Equal(0, subject.observers.count())
 	is.Equal(0, subject.CountObservers())
 
 	sub2.Unsubscribe()
@@ -214,13 +216,13 @@ func TestPublishSubject_singleSubscription(t *testing.T) {
 	// subscribe single
 	subscription1 := subject.Subscribe(observer)
 	is.Equal(KindNext, subject.status)
-	is.Equal(1, syncMapLength(&subject.observers))
+	is.Equal(1, subject.observers.count())
 	is.Equal(uint32(1), subject.observerIndex)
 
 	// unsubscribe single
 	subscription1.Unsubscribe()
 	is.Equal(KindNext, subject.status)
-	is.Equal(0, syncMapLength(&subject.observers))
+	is.Equal(0, subject.observers.count())
 	is.Equal(uint32(1), subject.observerIndex)
 }
 
@@ -237,32 +239,32 @@ func TestPublishSubject_multipleSubscription(t *testing.T) {
 	// subscribe first
 	subscription1 := subject.Subscribe(observer)
 	is.Equal(KindNext, subject.status)
-	is.Equal(1, syncMapLength(&subject.observers))
+	is.Equal(1, subject.observers.count())
 	is.Equal(uint32(1), subject.observerIndex)
 
 	// subscribe second
 	subscription2 := subject.Subscribe(observer)
 	is.Equal(KindNext, subject.status)
-	is.Equal(2, syncMapLength(&subject.observers))
+	is.Equal(2, subject.observers.count())
 	is.Equal(uint32(2), subject.observerIndex)
 
 	// unsubscribe first
 	subscription1.Unsubscribe()
 	is.Equal(KindNext, subject.status)
-	is.Equal(1, syncMapLength(&subject.observers))
+	is.Equal(1, subject.observers.count())
 	is.Equal(uint32(2), subject.observerIndex)
 
 	// subscribe third
 	subscription3 := subject.Subscribe(observer)
 	is.Equal(KindNext, subject.status)
-	is.Equal(2, syncMapLength(&subject.observers))
+	is.Equal(2, subject.observers.count())
 	is.Equal(uint32(3), subject.observerIndex)
 
 	// unsubscribe all
 	subscription2.Unsubscribe()
 	subscription3.Unsubscribe()
 	is.Equal(KindNext, subject.status)
-	is.Equal(0, syncMapLength(&subject.observers))
+	is.Equal(0, subject.observers.count())
 	is.Equal(uint32(3), subject.observerIndex)
 }
 
@@ -280,14 +282,14 @@ func TestPublishSubject_subscriptionCanceledTwice(t *testing.T) {
 	subscription1 := subject.Subscribe(observer)
 	subscription2 := subject.Subscribe(observer)
 	is.Equal(KindNext, subject.status)
-	is.Equal(2, syncMapLength(&subject.observers))
+	is.Equal(2, subject.observers.count())
 	is.Equal(uint32(2), subject.observerIndex)
 
 	// unsubscribe single
 	subscription1.Unsubscribe()
 	subscription1.Unsubscribe()
 	is.Equal(KindNext, subject.status)
-	is.Equal(1, syncMapLength(&subject.observers))
+	is.Equal(1, subject.observers.count())
 	is.Equal(uint32(2), subject.observerIndex)
 
 	// clean before test exit
@@ -453,3 +455,118 @@ func TestPublishSubject_complete(t *testing.T) {
 	subscription3.Unsubscribe()
 	subscription4.Unsubscribe()
 }
+
+func TestPublishSubject_completeAndDrain(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	subject := NewPublishSubject[int]()
+
+	var values []int
+
+	completed := false
+
+	subject.Subscribe(NewObserver(
+		func(value int) { values = append(values, value) },
+		func(err error) { is.Fail("never") },
+		func() { completed = true },
+	))
+
+	subject.Next(1)
+	is.NoError(subject.CompleteAndDrain(context.Background()))
+	subject.Next(2)
+
+	is.Equal([]int{1}, values)
+	is.True(completed)
+	is.True(subject.IsCompleted())
+}
+
+func TestShardedPublishSubject(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	subject, ok := NewShardedPublishSubject[int](4).(*publishSubjectImpl[int])
+	is.True(ok)
+	is.Len(subject.observers.shards, 4)
+
+	const observers = 100
+
+	var mu sync.Mutex
+	received := make([][]int, observers)
+	completed := int64(0)
+
+	subscriptions := make([]Subscription, 0, observers)
+	for i := 0; i < observers; i++ {
+		i := i
+		subscriptions = append(subscriptions, subject.Subscribe(NewObserver(
+			func(value int) {
+				mu.Lock()
+				received[i] = append(received[i], value)
+				mu.Unlock()
+			},
+			func(err error) { is.Fail("should not error") },
+			func() { atomic.AddInt64(&completed, 1) },
+		)))
+	}
+
+	is.True(subject.HasObserver())
+	is.Equal(observers, subject.CountObservers())
+
+	subscriptions[0].Unsubscribe()
+	is.Equal(observers-1, subject.CountObservers())
+
+	for i := 0; i < 10; i++ {
+		subject.Next(i)
+	}
+
+	subject.Complete()
+
+	// every observer received every value in order before Next returned
+	is.Nil(received[0])
+	for i := 1; i < observers; i++ {
+		is.Equal([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, received[i])
+	}
+
+	is.Equal(int64(observers-1), atomic.LoadInt64(&completed))
+	is.False(subject.HasObserver())
+	is.Equal(0, subject.CountObservers())
+
+	for _, sub := range subscriptions {
+		sub.Unsubscribe()
+	}
+}
+
+func TestShardedPublishSubject_wrongShards(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	subject, ok := NewShardedPublishSubject[int](0).(*publishSubjectImpl[int])
+	is.True(ok)
+	is.Len(subject.observers.shards, 1)
+}
+
+func TestShardedPublishSubject_panic(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	observers := newSubjectObservers[int](4)
+	for i := uint32(0); i < 8; i++ {
+		observers.store(i, NoopObserver[int]())
+	}
+
+	// panics raised on shard goroutines are rethrown on the caller goroutine
+	is.PanicsWithValue(42, func() {
+		observers.broadcastParallel(func(observer Observer[int]) {
+			panic(42)
+		})
+	})
+
+	observers.delete(0)
+	observers.delete(0)
+	is.Equal(7, observers.count())
+
+	observers.clear()
+	is.Equal(0, observers.count())
+}
@@ -16,10 +16,14 @@ package ro
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/samber/lo"
+	"github.com/samber/ro/internal/xring"
+	"github.com/samber/ro/internal/xtime"
 )
 
 // ReplaySubjectUnlimitedBufferSize is the unlimited buffer size for a ReplaySubject.
@@ -30,6 +34,15 @@ var _ Subject[int] = (*replaySubjectImpl[int])(nil)
 // NewReplaySubject emits old values to new subscribers.
 // After error or completion, new subscriptions receive values from the buffer then the error or the completion.
 func NewReplaySubject[T any](bufferSize int) Subject[T] {
+	return NewReplaySubjectWithWindow[T](bufferSize, 0)
+}
+
+// NewReplaySubjectWithWindow emits old values to new subscribers, up to
+// `bufferSize` values emitted during the last `window`. A zero window keeps
+// values until they are evicted by the count bound. The values evicted by
+// either bound are reported to OnDroppedNotification.
+// After error or completion, new subscriptions receive values from the buffer then the error or the completion.
+func NewReplaySubjectWithWindow[T any](bufferSize int, window time.Duration) Subject[T] {
 	return &replaySubjectImpl[T]{
 		mu:     sync.Mutex{},
 		status: KindNext,
@@ -38,12 +51,18 @@ func NewReplaySubject[T any](bufferSize int) Subject[T] {
 		observerIndex: 0,
 
 		err:        lo.Tuple2[context.Context, error]{},
-		values:     []lo.Tuple2[context.Context, T]{},
-		head:       0,
+		values:     xring.NewRing[replayEntry[T]](bufferSize),
 		bufferSize: bufferSize,
+		window:     window,
 	}
 }
 
+type replayEntry[T any] struct {
+	ctx       context.Context
+	value     T
+	timestamp int64 // monotonic, only set when the subject has a window
+}
+
 type replaySubjectImpl[T any] struct {
 	mu     sync.Mutex // sync.RWMutex would be better, but it is too slow for high-volume subjects
 	status Kind
@@ -52,11 +71,17 @@ type replaySubjectImpl[T any] struct {
 	observerIndex uint32
 
 	err lo.Tuple2[context.Context, error]
-	// values is used as a circular buffer when bufferSize > 0: once full, the
-	// oldest value lives at index head and new values overwrite it in place.
-	values     []lo.Tuple2[context.Context, T]
-	head       int
+	// values is a preallocated ring buffer: once full, the oldest value is
+	// overwritten in place. Values are pushed in emission order, so the ring
+	// is also sorted by timestamp and expired values are always at its front:
+	// no separate time index is needed for the window.
+	values     xring.Ring[replayEntry[T]]
 	bufferSize int
+	window     time.Duration
+
+	// onDropped receives the evicted values instead of OnDroppedNotification
+	// when set. Used by the tests.
+	onDropped func(ctx context.Context, notification fmt.Stringer)
 }
 
 // Implements Observable.
@@ -71,12 +96,12 @@ func (s *replaySubjectImpl[T]) SubscribeWithContext(subscriberCtx context.Contex
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	// Replay values oldest-first: from head to the end, then the wrapped part.
-	for i := s.head; i < len(s.values); i++ {
-		subscription.NextWithContext(s.values[i].A, s.values[i].B)
-	}
-	for i := 0; i < s.head; i++ {
-		subscription.NextWithContext(s.values[i].A, s.values[i].B)
+	s.evictExpired()
+
+	// Replay values oldest-first.
+	for i := 0; i < s.values.Len(); i++ {
+		entry := s.values.At(i)
+		subscription.NextWithContext(entry.ctx, entry.value)
 	}
 
 	switch s.status {
@@ -119,26 +144,17 @@ func (s *replaySubjectImpl[T]) NextWithContext(ctx context.Context, value T) {
 	if s.status == KindNext {
 		s.broadcastNext(ctx, value)
 
-		switch {
-		case s.bufferSize == ReplaySubjectUnlimitedBufferSize:
-			s.values = append(s.values, lo.T2(ctx, value))
-		case s.bufferSize == 0:
-			// The buffer cannot hold anything: the incoming value is dropped immediately.
-			OnDroppedNotification(ctx, NewNotificationNext(value))
-		case s.bufferSize > 0:
-			if len(s.values) < s.bufferSize {
-				s.values = append(s.values, lo.T2(ctx, value))
-			} else {
-				// Buffer is full: overwrite the oldest value in place.
-				OnDroppedNotification(ctx, NewNotificationNext(s.values[s.head].B))
-				s.values[s.head] = lo.T2(ctx, value)
-				s.head = (s.head + 1) % s.bufferSize
-			}
-		default:
-			// bufferSize < -1 is invalid; kept as-is from the previous implementation.
-			s.values = append(s.values, lo.T2(ctx, value))
-			OnDroppedNotification(ctx, NewNotificationNext(s.values[0].B))
-			s.values = s.values[len(s.values)-s.bufferSize:]
+		entry := replayEntry[T]{ctx: ctx, value: value}
+		if s.window > 0 {
+			entry.timestamp = xtime.NowNanoMonotonic()
+			s.evictExpiredAt(entry.timestamp)
+		}
+
+		// When the buffer is full, the oldest value is overwritten in place.
+		// When the buffer cannot hold anything, the incoming value is dropped
+		// immediately.
+		if evicted, ok := s.values.Push(entry); ok {
+			s.reportEvicted(evicted)
 		}
 	} else {
 		OnDroppedNotification(ctx, NewNotificationNext(value))
@@ -188,6 +204,103 @@ func (s *replaySubjectImpl[T]) CompleteWithContext(ctx context.Context) {
 	s.unsubscribeAll()
 }
 
+// evictExpired drops the values older than the window. Unsafe: must be
+// called in a mutex lock.
+func (s *replaySubjectImpl[T]) evictExpired() {
+	if s.window > 0 {
+		s.evictExpiredAt(xtime.NowNanoMonotonic())
+	}
+}
+
+func (s *replaySubjectImpl[T]) evictExpiredAt(now int64) {
+	deadline := now - s.window.Nanoseconds()
+
+	for s.values.Len() > 0 && s.values.Front().timestamp <= deadline {
+		s.reportEvicted(s.values.PopFront())
+	}
+}
+
+// reportEvicted reports a value evicted from the buffer, because it is full
+// or because the value expired, to OnDroppedNotification.
+func (s *replaySubjectImpl[T]) reportEvicted(entry replayEntry[T]) {
+	notification := NewNotificationNext(entry.value)
+
+	if s.onDropped != nil {
+		s.onDropped(entry.ctx, &notification)
+	} else {
+		OnDroppedNotification(entry.ctx, &notification)
+	}
+}
+
+// CompleteAndDrain completes the subject. Notifications are broadcast
+// synchronously, so the observers have processed the completion when it
+// returns.
+//
+// Implements Drainer.
+func (s *replaySubjectImpl[T]) CompleteAndDrain(ctx context.Context) error {
+	s.CompleteWithContext(ctx)
+	return nil
+}
+
+func (s *replaySubjectImpl[T]) snapshotEntries() []snapshotEntry[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.evictExpired()
+
+	// Monotonic timestamps are converted to wall-clock times, which survive a
+	// restart.
+	now := time.Now()
+	nowMonotonic := xtime.NowNanoMonotonic()
+
+	entries := make([]snapshotEntry[T], 0, s.values.Len())
+	for i := 0; i < s.values.Len(); i++ {
+		entry := s.values.At(i)
+
+		snapshot := snapshotEntry[T]{value: entry.value}
+		if s.window > 0 {
+			snapshot.time = now.Add(-time.Duration(nowMonotonic - entry.timestamp))
+		}
+
+		entries = append(entries, snapshot)
+	}
+
+	return entries
+}
+
+func (s *replaySubjectImpl[T]) restoreEntries(entries []snapshotEntry[T]) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.status != KindNext {
+		return ErrSubjectRestoreClosed
+	}
+
+	for s.values.Len() > 0 {
+		s.values.PopFront()
+	}
+
+	nowMonotonic := xtime.NowNanoMonotonic()
+
+	for i := range entries {
+		entry := replayEntry[T]{ctx: context.Background(), value: entries[i].value}
+		if s.window > 0 {
+			// Values without emission time are considered fresh.
+			entry.timestamp = nowMonotonic
+			if !entries[i].time.IsZero() {
+				entry.timestamp -= time.Since(entries[i].time).Nanoseconds()
+			}
+		}
+
+		s.values.Push(entry)
+	}
+
+	// Drops the values which expired while the service was down.
+	s.evictExpired()
+
+	return nil
+}
+
 func (s *replaySubjectImpl[T]) HasObserver() bool {
 	has := false
 
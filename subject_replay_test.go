@@ -15,6 +15,8 @@
 package ro
 
 import (
+	"context"
+	"fmt"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -22,6 +24,15 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func replayValues[T any](subject *replaySubjectImpl[T]) []T {
+	values := make([]T, 0, subject.values.Len())
+	for i := 0; i < subject.values.Len(); i++ {
+		values = append(values, subject.values.At(i).value)
+	}
+
+	return values
+}
+
 func TestReplaySubject_internalOk(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -33,7 +44,7 @@ func TestReplaySubject_internalOk(t *testing.T) {
 	// default state
 	is.Equal(KindNext, subject.status)
 	is.Empty(subject.err)
-	is.Equal([]int{}, t2ToSliceB(subject.values))
+	is.Equal([]int{}, replayValues(subject))
 	is.Equal(10, subject.bufferSize)
 	is.Equal(0, syncMapLength(&subject.observers))
 	is.Equal(uint32(0), subject.observerIndex)
@@ -43,7 +54,7 @@ func TestReplaySubject_internalOk(t *testing.T) {
 	subject.Next(42)
 	is.Equal(KindNext, subject.status)
 	is.Empty(subject.err)
-	is.Equal([]int{21, 42}, t2ToSliceB(subject.values))
+	is.Equal([]int{21, 42}, replayValues(subject))
 	is.Equal(10, subject.bufferSize)
 	is.Equal(0, syncMapLength(&subject.observers))
 	is.Equal(uint32(0), subject.observerIndex)
@@ -52,7 +63,7 @@ func TestReplaySubject_internalOk(t *testing.T) {
 	subject.Complete()
 	is.Equal(KindComplete, subject.status)
 	is.Empty(subject.err)
-	is.Equal([]int{21, 42}, t2ToSliceB(subject.values))
+	is.Equal([]int{21, 42}, replayValues(subject))
 	is.Equal(10, subject.bufferSize)
 	is.Equal(0, syncMapLength(&subject.observers))
 	is.Equal(uint32(0), subject.observerIndex)
@@ -61,7 +72,7 @@ func TestReplaySubject_internalOk(t *testing.T) {
 	subject.Next(84)
 	is.Equal(KindComplete, subject.status)
 	is.Empty(subject.err)
-	is.Equal([]int{21, 42}, t2ToSliceB(subject.values))
+	is.Equal([]int{21, 42}, replayValues(subject))
 	is.Equal(10, subject.bufferSize)
 	is.Equal(0, syncMapLength(&subject.observers))
 	is.Equal(uint32(0), subject.observerIndex)
@@ -78,7 +89,7 @@ func TestReplaySubject_internalError(t *testing.T) {
 	// default state
 	is.Equal(KindNext, subject.status)
 	is.Empty(subject.err)
-	is.Equal([]int{}, t2ToSliceB(subject.values))
+	is.Equal([]int{}, replayValues(subject))
 	is.Equal(10, subject.bufferSize)
 	is.Equal(0, syncMapLength(&subject.observers))
 	is.Equal(uint32(0), subject.observerIndex)
@@ -88,7 +99,7 @@ func TestReplaySubject_internalError(t *testing.T) {
 	subject.Next(42)
 	is.Equal(KindNext, subject.status)
 	is.Empty(subject.err)
-	is.Equal([]int{21, 42}, t2ToSliceB(subject.values))
+	is.Equal([]int{21, 42}, replayValues(subject))
 	is.Equal(10, subject.bufferSize)
 	is.Equal(0, syncMapLength(&subject.observers))
 	is.Equal(uint32(0), subject.observerIndex)
@@ -97,7 +108,7 @@ func TestReplaySubject_internalError(t *testing.T) {
 	subject.Error(assert.AnError)
 	is.Equal(KindError, subject.status)
 	is.Equal(assert.AnError, subject.err.B)
-	is.Equal([]int{21, 42}, t2ToSliceB(subject.values))
+	is.Equal([]int{21, 42}, replayValues(subject))
 	is.Equal(10, subject.bufferSize)
 	is.Equal(0, syncMapLength(&subject.observers))
 	is.Equal(uint32(0), subject.observerIndex)
@@ -106,7 +117,7 @@ func TestReplaySubject_internalError(t *testing.T) {
 	subject.Next(42)
 	is.Equal(KindError, subject.status)
 	is.Equal(assert.AnError, subject.err.B)
-	is.Equal([]int{21, 42}, t2ToSliceB(subject.values))
+	is.Equal([]int{21, 42}, replayValues(subject))
 	is.Equal(10, subject.bufferSize)
 	is.Equal(0, syncMapLength(&subject.observers))
 	is.Equal(uint32(0), subject.observerIndex)
@@ -173,7 +184,7 @@ func TestReplaySubject_internalOverflow(t *testing.T) {
 	// default state
 	is.Equal(KindNext, subject.status)
 	is.Empty(subject.err)
-	is.Equal([]int{}, t2ToSliceB(subject.values))
+	is.Equal([]int{}, replayValues(subject))
 	is.Equal(2, subject.bufferSize)
 	is.Equal(0, syncMapLength(&subject.observers))
 	is.Equal(uint32(0), subject.observerIndex)
@@ -185,8 +196,7 @@ func TestReplaySubject_internalOverflow(t *testing.T) {
 	is.Equal(KindNext, subject.status)
 	is.Empty(subject.err)
 	// the circular buffer overwrites the oldest value in place: 84 replaced 21
-	is.Equal([]int{84, 42}, t2ToSliceB(subject.values))
-	is.Equal(1, subject.head)
+	is.Equal([]int{42, 84}, replayValues(subject))
 	is.Equal(2, subject.bufferSize)
 	is.Equal(0, syncMapLength(&subject.observers))
 	is.Equal(uint32(0), subject.observerIndex)
@@ -622,3 +632,125 @@ func TestReplaySubject_implBufferOverflow(t *testing.T) {
 	subscription1.Unsubscribe()
 	subscription2.Unsubscribe()
 }
+
+func TestReplaySubject_window(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	subject, ok := NewReplaySubjectWithWindow[int](10, 50*time.Millisecond).(*replaySubjectImpl[int])
+	is.True(ok)
+
+	subject.Next(1)
+	subject.Next(2)
+	time.Sleep(100 * time.Millisecond)
+	subject.Next(3)
+
+	// expired values are evicted on push
+	is.Equal([]int{3}, replayValues(subject))
+
+	time.Sleep(100 * time.Millisecond)
+
+	values := []int{}
+	subject.Subscribe(OnNext(func(value int) {
+		values = append(values, value)
+	})).Unsubscribe()
+	is.Equal([]int{}, values)
+	is.Equal([]int{}, replayValues(subject))
+
+	// count bound still applies within the window
+	subject2 := NewReplaySubjectWithWindow[int](2, time.Second)
+	subject2.Next(1)
+	subject2.Next(2)
+	subject2.Next(3)
+	subject2.Complete()
+
+	values, err := Collect[int](subject2)
+	is.Equal([]int{2, 3}, values)
+	is.NoError(err)
+}
+
+func TestReplaySubject_steadyStateDoesNotAllocate(t *testing.T) {
+	// Not parallel: testing.AllocsPerRun is unreliable when other tests run
+	// concurrently, since its result depends on whole-program GC behavior.
+	is := assert.New(t)
+
+	ctx := context.Background()
+
+	// Values are pushed into the preallocated buffer.
+	subject := NewReplaySubject[int](1000)
+	allocs := testing.AllocsPerRun(100, func() {
+		subject.NextWithContext(ctx, 42)
+	})
+	is.Zero(allocs)
+
+	// Once full, the only allocation is the notification of the evicted value.
+	subject = NewReplaySubject[int](8)
+	for i := 0; i < 8; i++ {
+		subject.NextWithContext(ctx, i)
+	}
+
+	allocs = testing.AllocsPerRun(100, func() {
+		subject.NextWithContext(ctx, 42)
+	})
+	is.Equal(float64(1), allocs)
+}
+
+func TestReplaySubject_reportsEvicted(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type key struct{}
+
+	var dropped []fmt.Stringer
+	var contexts []any
+
+	onDropped := func(ctx context.Context, notification fmt.Stringer) {
+		dropped = append(dropped, notification)
+		contexts = append(contexts, ctx.Value(key{}))
+	}
+
+	// Evicted by the count bound, with the context of the evicted value.
+	subject, ok := NewReplaySubject[int](2).(*replaySubjectImpl[int])
+	is.True(ok)
+	subject.onDropped = onDropped
+
+	subject.NextWithContext(context.WithValue(context.Background(), key{}, "a"), 21)
+	subject.NextWithContext(context.WithValue(context.Background(), key{}, "b"), 42)
+	subject.NextWithContext(context.WithValue(context.Background(), key{}, "c"), 84)
+	subject.NextWithContext(context.WithValue(context.Background(), key{}, "d"), 168)
+
+	// The notifications are not reused.
+	is.Equal("Next(21)", dropped[0].String())
+	is.Equal("Next(42)", dropped[1].String())
+	is.Equal([]any{"a", "b"}, contexts)
+
+	// Dropped immediately when the buffer cannot hold anything.
+	dropped, contexts = nil, nil
+	subject, ok = NewReplaySubject[int](0).(*replaySubjectImpl[int])
+	is.True(ok)
+	subject.onDropped = onDropped
+
+	subject.Next(1)
+	is.Len(dropped, 1)
+	is.Equal("Next(1)", dropped[0].String())
+
+	// Evicted by the time window.
+	dropped, contexts = nil, nil
+	subject, ok = NewReplaySubjectWithWindow[int](10, 20*time.Millisecond).(*replaySubjectImpl[int])
+	is.True(ok)
+	subject.onDropped = onDropped
+
+	subject.Next(1)
+	subject.Next(2)
+	time.Sleep(40 * time.Millisecond)
+	subject.Next(3)
+	is.Len(dropped, 2)
+	is.Equal("Next(1)", dropped[0].String())
+	is.Equal("Next(2)", dropped[1].String())
+
+	time.Sleep(40 * time.Millisecond)
+	// Expired values are evicted upon subscription too.
+	subject.Subscribe(NoopObserver[int]()).Unsubscribe()
+	is.Len(dropped, 3)
+	is.Equal("Next(3)", dropped[2].String())
+}
@@ -0,0 +1,142 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ro
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// subjectSnapshotVersion is the version of the snapshot format, bumped on
+// incompatible changes.
+const subjectSnapshotVersion = 1
+
+// subjectSnapshot is the document written by SnapshotSubject.
+type subjectSnapshot struct {
+	Version int                    `json:"version"`
+	Values  []subjectSnapshotValue `json:"values"`
+}
+
+type subjectSnapshotValue struct {
+	// Data is the value encoded by the Codec.
+	Data []byte `json:"data"`
+	// Time is the emission time of the value, for the subjects evicting
+	// values after a window.
+	Time *time.Time `json:"time,omitempty"`
+}
+
+// snapshotEntry is a value held by a subject. A zero time means the subject
+// does not track emission times.
+type snapshotEntry[T any] struct {
+	value T
+	time  time.Time
+}
+
+// snapshotter is implemented by the subjects holding values for their future
+// subscribers.
+type snapshotter[T any] interface {
+	snapshotEntries() []snapshotEntry[T]
+	restoreEntries(entries []snapshotEntry[T]) error
+}
+
+var (
+	_ snapshotter[int] = (*behaviorSubjectImpl[int])(nil)
+	_ snapshotter[int] = (*replaySubjectImpl[int])(nil)
+)
+
+// SnapshotSubject writes the values held by a subject for its future
+// subscribers to w, encoded with codec: the current value of a
+// BehaviorSubject, or the buffered values of a ReplaySubject. Load them back
+// with RestoreSubject, for instance to warm up a service after a restart.
+//
+// The terminal state and the contexts of the values are not part of the
+// snapshot. Other subjects return ErrSubjectSnapshotUnsupported.
+func SnapshotSubject[T any](subject Subject[T], w io.Writer, codec Codec[T]) error {
+	s, ok := subject.(snapshotter[T])
+	if !ok {
+		return ErrSubjectSnapshotUnsupported
+	}
+
+	entries := s.snapshotEntries()
+
+	snapshot := subjectSnapshot{
+		Version: subjectSnapshotVersion,
+		Values:  make([]subjectSnapshotValue, 0, len(entries)),
+	}
+
+	for i := range entries {
+		data, err := codec.Encode(entries[i].value)
+		if err != nil {
+			return err
+		}
+
+		value := subjectSnapshotValue{Data: data}
+		if !entries[i].time.IsZero() {
+			value.Time = &entries[i].time
+		}
+
+		snapshot.Values = append(snapshot.Values, value)
+	}
+
+	return json.NewEncoder(w).Encode(snapshot)
+}
+
+// RestoreSubject reads a snapshot written by SnapshotSubject from r, and
+// replaces the values held by subject with the ones of the snapshot. The
+// values are not emitted to the current observers: restore the subject before
+// subscribing to it.
+//
+// A BehaviorSubject takes the last value of the snapshot, and keeps its
+// current value when the snapshot is empty. A ReplaySubject keeps the latest
+// values fitting its buffer and window. The restored values are emitted with
+// context.Background().
+//
+// It returns ErrSubjectRestoreClosed if the subject is closed, and
+// ErrSubjectSnapshotUnsupported for the other subjects.
+func RestoreSubject[T any](subject Subject[T], r io.Reader, codec Codec[T]) error {
+	s, ok := subject.(snapshotter[T])
+	if !ok {
+		return ErrSubjectSnapshotUnsupported
+	}
+
+	var snapshot subjectSnapshot
+	if err := json.NewDecoder(r).Decode(&snapshot); err != nil {
+		return err
+	}
+
+	if snapshot.Version != subjectSnapshotVersion {
+		return fmt.Errorf("ro.RestoreSubject: unsupported snapshot version %d", snapshot.Version)
+	}
+
+	entries := make([]snapshotEntry[T], 0, len(snapshot.Values))
+
+	for _, v := range snapshot.Values {
+		value, err := codec.Decode(v.Data)
+		if err != nil {
+			return err
+		}
+
+		entry := snapshotEntry[T]{value: value}
+		if v.Time != nil {
+			entry.time = *v.Time
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return s.restoreEntries(entries)
+}
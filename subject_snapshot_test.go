@@ -0,0 +1,155 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ro
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotSubject_replay(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	codec := NewJSONCodec[int]()
+
+	subject := NewReplaySubject[int](3)
+	for i := 1; i <= 5; i++ {
+		subject.Next(i)
+	}
+
+	var buf bytes.Buffer
+	is.NoError(SnapshotSubject(subject, &buf, codec))
+
+	restored := NewReplaySubject[int](2)
+	is.NoError(RestoreSubject(restored, &buf, codec))
+
+	// The buffer keeps the latest values.
+	restored.Complete()
+	values, err := Collect[int](restored)
+	is.Equal([]int{4, 5}, values)
+	is.NoError(err)
+
+	// Restoring replaces the buffered values.
+	subject.Next(6)
+	buf.Reset()
+	is.NoError(SnapshotSubject(subject, &buf, codec))
+	is.NoError(RestoreSubject(subject, &buf, codec))
+	subject.Complete()
+	values, err = Collect[int](subject)
+	is.Equal([]int{4, 5, 6}, values)
+	is.NoError(err)
+}
+
+func TestSnapshotSubject_replayWindow(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	codec := NewJSONCodec[int]()
+	now := time.Now()
+
+	snapshot, err := json.Marshal(subjectSnapshot{
+		Version: subjectSnapshotVersion,
+		Values: []subjectSnapshotValue{
+			{Data: []byte("1"), Time: func() *time.Time { t := now.Add(-time.Hour); return &t }()},
+			{Data: []byte("2"), Time: &now},
+			{Data: []byte("3")},
+		},
+	})
+	is.NoError(err)
+
+	// The values expired while the service was down are dropped.
+	subject := NewReplaySubjectWithWindow[int](ReplaySubjectUnlimitedBufferSize, time.Minute)
+	is.NoError(RestoreSubject(subject, bytes.NewReader(snapshot), codec))
+	subject.Complete()
+
+	values, err := Collect[int](subject)
+	is.Equal([]int{2, 3}, values)
+	is.NoError(err)
+
+	// Emission times are kept by snapshots.
+	subject = NewReplaySubjectWithWindow[int](ReplaySubjectUnlimitedBufferSize, time.Minute)
+	subject.Next(42)
+
+	var buf bytes.Buffer
+	is.NoError(SnapshotSubject(subject, &buf, codec))
+
+	var decoded subjectSnapshot
+	is.NoError(json.Unmarshal(buf.Bytes(), &decoded))
+	is.Len(decoded.Values, 1)
+	is.NotNil(decoded.Values[0].Time)
+	is.WithinDuration(time.Now(), *decoded.Values[0].Time, time.Second)
+}
+
+func TestSnapshotSubject_behavior(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	codec := NewJSONCodec[string]()
+
+	subject := NewBehaviorSubject("initial")
+	subject.Next("a")
+	subject.Next("b")
+
+	var buf bytes.Buffer
+	is.NoError(SnapshotSubject(subject, &buf, codec))
+
+	restored := NewBehaviorSubject("initial")
+	is.NoError(RestoreSubject(restored, &buf, codec))
+
+	values := []string{}
+	restored.Subscribe(OnNext(func(value string) {
+		values = append(values, value)
+	}))
+	is.Equal([]string{"b"}, values)
+
+	// An empty snapshot keeps the current value.
+	is.NoError(RestoreSubject(restored, bytes.NewReader([]byte(`{"version":1,"values":[]}`)), codec))
+
+	values = []string{}
+	restored.Subscribe(OnNext(func(value string) {
+		values = append(values, value)
+	}))
+	is.Equal([]string{"b"}, values)
+}
+
+func TestSnapshotSubject_errors(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	codec := NewJSONCodec[int]()
+
+	var buf bytes.Buffer
+	is.ErrorIs(SnapshotSubject(NewPublishSubject[int](), &buf, codec), ErrSubjectSnapshotUnsupported)
+	is.ErrorIs(RestoreSubject(NewPublishSubject[int](), &buf, codec), ErrSubjectSnapshotUnsupported)
+
+	subject := NewReplaySubject[int](10)
+	subject.Complete()
+	is.ErrorIs(RestoreSubject(subject, bytes.NewReader([]byte(`{"version":1,"values":[]}`)), codec), ErrSubjectRestoreClosed)
+
+	is.EqualError(
+		RestoreSubject(NewReplaySubject[int](10), bytes.NewReader([]byte(`{"version":2,"values":[]}`)), codec),
+		"ro.RestoreSubject: unsupported snapshot version 2",
+	)
+	is.Error(RestoreSubject(NewReplaySubject[int](10), bytes.NewReader([]byte(`{"version":1,"values":[{"data":"bm9wZQ=="}]}`)), codec))
+}
@@ -51,6 +51,11 @@ type unicastSubjectImpl[T any] struct {
 	err        lo.Tuple2[context.Context, error]
 	values     []lo.Tuple2[context.Context, T]
 	bufferSize int
+
+	// draining is not nil while CompleteAndDrain waits for an observer
+	// to flush the buffered values to.
+	draining    chan struct{}
+	drainingCtx context.Context
 }
 
 // Implements Observable.
@@ -87,6 +92,16 @@ func (s *unicastSubjectImpl[T]) SubscribeWithContext(subscriberCtx context.Conte
 
 	s.values = []lo.Tuple2[context.Context, T]{}
 
+	if s.draining != nil {
+		ctx := s.drainingCtx
+
+		s.status = KindComplete
+		s.stopDraining()
+		subscription.CompleteWithContext(ctx)
+
+		return subscription
+	}
+
 	s.observer = subscription
 
 	subscription.Add(func() {
@@ -107,7 +122,7 @@ func (s *unicastSubjectImpl[T]) Next(value T) {
 func (s *unicastSubjectImpl[T]) NextWithContext(ctx context.Context, value T) {
 	s.mu.Lock()
 
-	if s.status == KindNext { //nolint:nestif
+	if s.status == KindNext && s.draining == nil { //nolint:nestif
 		if s.observer != nil {
 			tmp := s.observer
 			defer tmp.NextWithContext(ctx, value) // out of lock
@@ -137,6 +152,7 @@ func (s *unicastSubjectImpl[T]) ErrorWithContext(ctx context.Context, err error)
 	if s.status == KindNext {
 		s.err = lo.T2(ctx, err)
 		s.status = KindError
+		s.stopDraining()
 
 		if s.observer != nil {
 			tmp := s.observer
@@ -164,6 +180,7 @@ func (s *unicastSubjectImpl[T]) CompleteWithContext(ctx context.Context) {
 
 	if s.status == KindNext {
 		s.status = KindComplete
+		s.stopDraining()
 
 		if s.observer != nil {
 			tmp := s.observer
@@ -180,6 +197,68 @@ func (s *unicastSubjectImpl[T]) CompleteWithContext(ctx context.Context) {
 	s.mu.Unlock()
 }
 
+// CompleteAndDrain stops accepting new values and completes the subject. When
+// values are buffered and no observer subscribed yet, it waits for the next
+// observer to receive them, before completing it. If ctx is done first, the
+// buffered values are dropped, the subject completes and ctx.Err() is
+// returned.
+//
+// Implements Drainer.
+func (s *unicastSubjectImpl[T]) CompleteAndDrain(ctx context.Context) error {
+	s.mu.Lock()
+
+	if s.status != KindNext || s.observer != nil || len(s.values) == 0 {
+		s.mu.Unlock()
+		s.CompleteWithContext(ctx)
+
+		return nil
+	}
+
+	if s.draining == nil {
+		s.draining = make(chan struct{})
+		s.drainingCtx = ctx
+	}
+
+	draining := s.draining
+
+	s.mu.Unlock()
+
+	select {
+	case <-draining:
+		return nil
+	case <-ctx.Done():
+	}
+
+	s.mu.Lock()
+
+	if s.draining != draining {
+		// The values were delivered concurrently.
+		s.mu.Unlock()
+		return nil
+	}
+
+	values := s.values
+	s.values = []lo.Tuple2[context.Context, T]{}
+	s.mu.Unlock()
+
+	for _, v := range values {
+		OnDroppedNotification(v.A, NewNotificationNext(v.B))
+	}
+
+	s.CompleteWithContext(ctx)
+
+	return ctx.Err()
+}
+
+// stopDraining must be called with the lock held.
+func (s *unicastSubjectImpl[T]) stopDraining() {
+	if s.draining != nil {
+		close(s.draining)
+		s.draining = nil
+		s.drainingCtx = nil
+	}
+}
+
 func (s *unicastSubjectImpl[T]) HasObserver() bool {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -203,7 +282,7 @@ func (s *unicastSubjectImpl[T]) IsClosed() bool {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	return s.status != KindNext
+	return s.status != KindNext || s.draining != nil
 }
 
 // Implements Observer.
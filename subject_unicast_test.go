@@ -15,6 +15,7 @@
 package ro
 
 import (
+	"context"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -590,3 +591,74 @@ func TestUnicastSubject_implBufferOverflow(t *testing.T) {
 	subscription1.Unsubscribe()
 	subscription2.Unsubscribe()
 }
+
+func TestUnicastSubject_completeAndDrain(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 500*time.Millisecond)
+	is := assert.New(t)
+
+	// buffered values are delivered to the next observer
+	subject := NewUnicastSubject[int](10)
+	subject.Next(1)
+	subject.Next(2)
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		time.Sleep(20 * time.Millisecond)
+
+		values, err := Collect[int](subject)
+		is.Equal([]int{1, 2}, values)
+		is.NoError(err)
+	}()
+
+	is.False(subject.IsClosed())
+
+	err := subject.CompleteAndDrain(context.Background())
+	is.NoError(err)
+	is.True(subject.IsCompleted())
+	<-done
+
+	// new values are dropped while draining
+	subject = NewUnicastSubject[int](10)
+	subject.Next(1)
+
+	done = make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		time.Sleep(10 * time.Millisecond)
+		is.True(subject.IsClosed())
+		subject.Next(2)
+
+		values, err := Collect[int](subject)
+		is.Equal([]int{1}, values)
+		is.NoError(err)
+	}()
+
+	is.NoError(subject.CompleteAndDrain(context.Background()))
+	<-done
+
+	// the context is done before an observer subscribes
+	subject = NewUnicastSubject[int](10)
+	subject.Next(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err = subject.CompleteAndDrain(ctx)
+	is.ErrorIs(err, context.DeadlineExceeded)
+	is.True(subject.IsCompleted())
+
+	values, err := Collect[int](subject)
+	is.Equal([]int{}, values)
+	is.NoError(err)
+
+	// without buffered values, the subject completes immediately
+	subject = NewUnicastSubject[int](10)
+	is.NoError(subject.CompleteAndDrain(context.Background()))
+	is.True(subject.IsCompleted())
+}
@@ -16,6 +16,7 @@ package ro
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"sync/atomic"
 )
@@ -30,7 +31,13 @@ type Subscriber[T any] interface {
 	Observer[T]
 }
 
-var _ Subscriber[int] = (*subscriberImpl[int])(nil)
+var (
+	_ Subscriber[int]       = (*subscriberImpl[int])(nil)
+	_ CauseSubscription     = (*subscriberImpl[int])(nil)
+	_ ErrorUnsubscribable   = (*subscriberImpl[int])(nil)
+	_ RemovableSubscription = (*subscriberImpl[int])(nil)
+	_ WaitableSubscription  = (*subscriberImpl[int])(nil)
+)
 
 // NewSubscriber creates a new Subscriber from an Observer. If the Observer
 // is already a Subscriber, it is returned as is. Otherwise, a new Subscriber
@@ -126,8 +133,8 @@ func newSubscriberImpl[T any](mode ConcurrencyMode, noLock bool, backpressure Ba
 		noLock:      noLock,
 		destination: destination,
 
-		Subscription: NewSubscription(nil),
-		mode:         mode,
+		subscriptionImpl: newSubscriptionImpl(nil),
+		mode:             mode,
 	}
 
 	if subscription, ok := destination.(Subscription); ok {
@@ -166,7 +173,7 @@ type subscriberImpl[T any] struct {
 	noLock      bool
 	destination Observer[T]
 
-	Subscription
+	*subscriptionImpl
 
 	mode ConcurrencyMode
 }
@@ -214,7 +221,7 @@ func (s *subscriberImpl[T]) NextWithContext(ctx context.Context, v T) {
 	if atomic.LoadInt32(&s.status) == 0 {
 		s.destination.NextWithContext(ctx, v)
 	} else {
-		OnDroppedNotification(ctx, NewNotificationNext(v))
+		OnDroppedNotification(s.droppedContext(ctx), NewNotificationNext(v))
 	}
 
 	s.unlock()
@@ -234,12 +241,12 @@ func (s *subscriberImpl[T]) ErrorWithContext(ctx context.Context, err error) {
 			s.destination.ErrorWithContext(ctx, err)
 		}
 	} else {
-		OnDroppedNotification(ctx, NewNotificationError[T](err))
+		OnDroppedNotification(s.droppedContext(ctx), NewNotificationError[T](err))
 	}
 
 	s.unlock()
 
-	s.unsubscribe()
+	s.unsubscribe(err)
 }
 
 // Implements Observer.
@@ -256,12 +263,12 @@ func (s *subscriberImpl[T]) CompleteWithContext(ctx context.Context) {
 			s.destination.CompleteWithContext(ctx)
 		}
 	} else {
-		OnDroppedNotification(ctx, NewNotificationComplete[T]())
+		OnDroppedNotification(s.droppedContext(ctx), NewNotificationComplete[T]())
 	}
 
 	s.unlock()
 
-	s.unsubscribe()
+	s.unsubscribe(nil)
 }
 
 // Implements Observer.
@@ -281,12 +288,124 @@ func (s *subscriberImpl[T]) IsCompleted() bool {
 
 // Implements Observer.
 func (s *subscriberImpl[T]) Unsubscribe() {
+	s.UnsubscribeWithCause(nil)
+}
+
+// Implements CauseSubscription.
+func (s *subscriberImpl[T]) UnsubscribeWithCause(cause error) {
+	if atomic.CompareAndSwapInt32(&s.status, 0, 2) {
+		s.unsubscribe(cause)
+	}
+}
+
+// Implements ErrorUnsubscribable.
+func (s *subscriberImpl[T]) UnsubscribeWithError() error {
 	if atomic.CompareAndSwapInt32(&s.status, 0, 2) {
-		s.unsubscribe()
+		return s.subscriptionImpl.UnsubscribeWithError()
+	}
+
+	return nil
+}
+
+func (s *subscriberImpl[T]) unsubscribe(cause error) {
+	// s.subscriptionImpl.UnsubscribeWithCause() is protected against concurrent calls.
+	s.subscriptionImpl.UnsubscribeWithCause(cause)
+}
+
+// String implements Describer: "ro.Subscriber[int](mode=safe, backpressure=block, state=active)".
+func (s *subscriberImpl[T]) String() string {
+	return fmt.Sprintf("ro.Subscriber[%s](mode=%s, backpressure=%s, state=%s)", stageTypeName[T](), s.mode, s.backpressure, s.describeState())
+}
+
+// DebugString implements Describer.
+func (s *subscriberImpl[T]) DebugString() string {
+	subscription := s.subscriptionImpl.DebugString()
+
+	return fmt.Sprintf(
+		"%s\n  destination: %s\n  subscription: %s",
+		s.String(),
+		describeName(s.destination),
+		indentDescription(subscription),
+	)
+}
+
+func (s *subscriberImpl[T]) describeState() string {
+	switch atomic.LoadInt32(&s.status) {
+	case 0:
+		return "active"
+	case 1:
+		return "errored"
+	default:
+		return "completed"
 	}
 }
 
-func (s *subscriberImpl[T]) unsubscribe() {
-	// s.Subscription.Unsubscribe() is protected against concurrent calls.
-	s.Subscription.Unsubscribe()
+// droppedContext attaches the cause of the unsubscription, if any, to the
+// context of a dropped notification. See UnsubscriptionCause.
+func (s *subscriberImpl[T]) droppedContext(ctx context.Context) context.Context {
+	if cause := s.subscriptionImpl.Cause(); cause != nil {
+		return withUnsubscriptionCause(ctx, cause)
+	}
+
+	return ctx
+}
+
+// BaseSubscriber is an embeddable helper for the observers of third-party
+// operators, which receive values of type T and emit values of type R to a
+// destination. It implements the Observer contract, except Next and
+// NextWithContext, that the embedding type must implement:
+//   - Error and Complete notifications are forwarded to the destination at
+//     most once, and the notifications received after closing are reported
+//     to OnDroppedNotification;
+//   - CanNext must be checked first by NextWithContext, and RecoverNext
+//     deferred, so that a panic is converted into an error notification.
+//
+// A BaseSubscriber must be created with NewBaseSubscriber. It is wrapped into
+// a Subscriber when subscribed, like any Observer.
+//
+//	type upperObserver struct {
+//		ro.BaseSubscriber[string, string]
+//	}
+//
+//	func (o *upperObserver) Next(value string) {
+//		o.NextWithContext(context.Background(), value)
+//	}
+//
+//	func (o *upperObserver) NextWithContext(ctx context.Context, value string) {
+//		if !o.CanNext(ctx, value) {
+//			return
+//		}
+//
+//		defer o.RecoverNext(ctx)
+//
+//		o.Destination().NextWithContext(ctx, strings.ToUpper(value))
+//	}
+type BaseSubscriber[T, R any] struct {
+	operatorObserver[T, R]
+}
+
+// NewBaseSubscriber creates a BaseSubscriber forwarding to destination.
+func NewBaseSubscriber[T, R any](destination Observer[R]) BaseSubscriber[T, R] {
+	return BaseSubscriber[T, R]{
+		operatorObserver: operatorObserver[T, R]{destination: destination},
+	}
+}
+
+// Destination returns the Observer receiving the values of the operator.
+func (s *BaseSubscriber[T, R]) Destination() Observer[R] {
+	return s.destination
+}
+
+// CanNext reports whether a value can be processed. When the observer is
+// closed, the value is reported to OnDroppedNotification and false is returned.
+func (s *BaseSubscriber[T, R]) CanNext(ctx context.Context, value T) bool {
+	return s.canNext(ctx, value)
+}
+
+// RecoverNext must be deferred by NextWithContext. It converts a panic into
+// an error notification sent to the destination.
+func (s *BaseSubscriber[T, R]) RecoverNext(ctx context.Context) {
+	if e := recover(); e != nil {
+		s.tryError(ctx, newObserverError(recoverValueToError(e)))
+	}
 }
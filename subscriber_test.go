@@ -1379,3 +1379,79 @@ func TestSubscriberConcurrentStatusTransitions(t *testing.T) { //nolint:parallel
 	is.True(finalStatus == 1 || finalStatus == 2)
 	is.True(subscriber.IsClosed())
 }
+
+type testDoubleObserver struct {
+	BaseSubscriber[int, int]
+}
+
+func (o *testDoubleObserver) Next(value int) {
+	o.NextWithContext(context.Background(), value)
+}
+
+func (o *testDoubleObserver) NextWithContext(ctx context.Context, value int) {
+	if !o.CanNext(ctx, value) {
+		return
+	}
+
+	defer o.RecoverNext(ctx)
+
+	if value < 0 {
+		panic(assert.AnError)
+	}
+
+	o.Destination().NextWithContext(ctx, value*2)
+}
+
+func testDouble(source Observable[int]) Observable[int] {
+	return NewUnsafeObservableWithContext(func(ctx context.Context, destination Observer[int]) Teardown {
+		sub := source.SubscribeWithContext(ctx, &testDoubleObserver{
+			BaseSubscriber: NewBaseSubscriber[int](destination),
+		})
+
+		return sub.Unsubscribe
+	})
+}
+
+func TestBaseSubscriber(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 100*time.Millisecond)
+	is := assert.New(t)
+
+	values, err := Collect(Pipe1(Just(1, 2, 3), testDouble))
+	is.Equal([]int{2, 4, 6}, values)
+	is.NoError(err)
+
+	values, err = Collect(Pipe1(Concat(Just(1), Throw[int](assert.AnError)), testDouble))
+	is.Equal([]int{2}, values)
+	is.ErrorIs(err, assert.AnError)
+
+	// panics are converted into errors
+	values, err = Collect(Pipe1(Just(1, -1, 3), testDouble))
+	is.Equal([]int{2}, values)
+	is.ErrorIs(err, assert.AnError)
+
+	// notifications after closing are dropped
+	var next, errs, completes int
+
+	observer := &testDoubleObserver{
+		BaseSubscriber: NewBaseSubscriber[int](NewObserver(
+			func(value int) { next++ },
+			func(err error) { errs++ },
+			func() { completes++ },
+		)),
+	}
+
+	observer.Next(1)
+	is.False(observer.IsClosed())
+	observer.Complete()
+	observer.Next(2)
+	observer.Error(assert.AnError)
+	observer.Complete()
+
+	is.True(observer.IsClosed())
+	is.True(observer.IsCompleted())
+	is.False(observer.HasThrown())
+	is.Equal(1, next)
+	is.Equal(0, errs)
+	is.Equal(1, completes)
+}
@@ -15,6 +15,9 @@
 package ro
 
 import (
+	"context"
+	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/samber/lo"
@@ -35,6 +38,10 @@ type Unsubscribable interface {
 
 // Subscription represents an ongoing execution of an `Observable`, and has
 // a minimal API which allows you to cancel that execution.
+//
+// The Subscriptions and Subscribers created by ro also implement
+// CauseSubscription, ErrorUnsubscribable, RemovableSubscription and
+// WaitableSubscription, that can be checked with a type assertion.
 type Subscription interface {
 	Unsubscribable
 
@@ -44,15 +51,93 @@ type Subscription interface {
 	Wait() // Note: using .Wait() is not recommended.
 }
 
-var _ Subscription = (*subscriptionImpl)(nil)
+// CauseSubscription is a Subscription recording why it has been canceled.
+type CauseSubscription interface {
+	Subscription
+
+	// UnsubscribeWithCause is Unsubscribe, recording why the subscription is
+	// canceled. The cause is forwarded to the subscriptions added with
+	// AddUnsubscribable, and is returned by Cause.
+	UnsubscribeWithCause(cause error)
+	// Cause returns the error passed to UnsubscribeWithCause, or the error
+	// notification that closed a Subscriber. It returns nil while the
+	// subscription is active, and after a deliberate Unsubscribe() or a completion.
+	Cause() error
+}
+
+// ErrorUnsubscribable is implemented by the Subscriptions, Subscribers and
+// CompositeSubscriptions created by ro.
+type ErrorUnsubscribable interface {
+	Unsubscribable
+
+	// UnsubscribeWithError is Unsubscribe, returning the errors raised by
+	// the teardowns instead of reporting them to OnUnhandledError.
+	UnsubscribeWithError() error
+}
+
+// RemovableSubscription is a Subscription whose teardowns can be detached.
+type RemovableSubscription interface {
+	Subscription
+
+	// AddRemovable is Add, returning a handle to detach the teardown with Remove.
+	AddRemovable(teardown Teardown) TeardownHandle
+	// Remove detaches a teardown added with AddRemovable, so that it is not
+	// called upon unsubscription. It returns false if the teardown was already
+	// called or removed.
+	Remove(handle TeardownHandle) bool
+}
+
+// WaitableSubscription is a Subscription that can be waited for in a select
+// statement or with a context.
+type WaitableSubscription interface {
+	Subscription
+
+	// Done returns a channel closed when the subscription is disposed.
+	Done() <-chan struct{}
+	// WaitWithContext is Wait, returning ctx.Err() if ctx is canceled first.
+	WaitWithContext(ctx context.Context) error
+}
+
+// causeUnsubscribable is implemented by CauseSubscription and
+// CompositeSubscription.
+type causeUnsubscribable interface {
+	UnsubscribeWithCause(cause error)
+}
+
+// unsubscribeWithCause forwards the cause to unsubscribable when it supports
+// it, or calls Unsubscribe.
+func unsubscribeWithCause(unsubscribable Unsubscribable, cause error) {
+	if withCause, ok := unsubscribable.(causeUnsubscribable); ok {
+		withCause.UnsubscribeWithCause(cause)
+	} else {
+		unsubscribable.Unsubscribe()
+	}
+}
+
+// TeardownHandle identifies a teardown added with RemovableSubscription.AddRemovable.
+// The zero value identifies no teardown.
+type TeardownHandle struct {
+	id uint64
+}
+
+var (
+	_ CauseSubscription     = (*subscriptionImpl)(nil)
+	_ ErrorUnsubscribable   = (*subscriptionImpl)(nil)
+	_ RemovableSubscription = (*subscriptionImpl)(nil)
+	_ WaitableSubscription  = (*subscriptionImpl)(nil)
+)
 
 // NewSubscription creates a new Subscription. When `teardown` is nil, nothing
 // is added. When the subscription is already disposed, the `teardown` callback
 // is triggered immediately.
 func NewSubscription(teardown Teardown) Subscription {
-	teardowns := make([]func(), 0, 4) // Pre-allocate for common case
+	return newSubscriptionImpl(teardown)
+}
+
+func newSubscriptionImpl(teardown Teardown) *subscriptionImpl {
+	teardowns := make([]finalizer, 0, 4) // Pre-allocate for common case
 	if teardown != nil {
-		teardowns = append(teardowns, teardown)
+		teardowns = append(teardowns, finalizer{teardown: teardown})
 	}
 
 	return &subscriptionImpl{
@@ -65,7 +150,26 @@ func NewSubscription(teardown Teardown) Subscription {
 type subscriptionImpl struct {
 	done       bool
 	mu         sync.Mutex // Should be a RWMutex because of the .IsClosed() method, but sync.RWMutex is 30% slower.
-	finalizers []func()
+	finalizers []finalizer
+	cause      error
+	lastID     uint64        // last TeardownHandle id
+	doneCh     chan struct{} // lazily created by Done()
+}
+
+// finalizer is either a teardown, or an unsubscribable receiving the cause of
+// the unsubscription.
+type finalizer struct {
+	teardown  func()
+	withCause causeUnsubscribable
+	id        uint64 // non-zero when added with AddRemovable
+}
+
+func (f finalizer) run(cause error) {
+	if f.withCause != nil {
+		f.withCause.UnsubscribeWithCause(cause)
+	} else {
+		f.teardown()
+	}
 }
 
 // Add receives a finalizer to execute upon unsubscription. When `teardown`
@@ -86,8 +190,64 @@ func (s *subscriptionImpl) Add(teardown Teardown) {
 	if s.done {
 		teardown() // not protected against panics
 	} else {
-		s.finalizers = append(s.finalizers, teardown)
+		s.finalizers = append(s.finalizers, finalizer{teardown: teardown})
+	}
+}
+
+// AddRemovable receives a finalizer to execute upon unsubscription, like Add,
+// and returns a handle to detach it with Remove. Operators managing dynamic
+// inner subscriptions use it to forget the inner subscriptions that
+// completed. When `teardown` is nil, or when the subscription is already
+// disposed, the zero handle is returned.
+//
+// This method is thread-safe.
+//
+// Implements RemovableSubscription.
+func (s *subscriptionImpl) AddRemovable(teardown Teardown) TeardownHandle {
+	if teardown == nil {
+		return TeardownHandle{}
+	}
+
+	s.mu.Lock()
+
+	if s.done {
+		s.mu.Unlock()
+		teardown() // not protected against panics
+		return TeardownHandle{}
+	}
+
+	s.lastID++
+	handle := TeardownHandle{id: s.lastID}
+	s.finalizers = append(s.finalizers, finalizer{teardown: teardown, id: handle.id})
+
+	s.mu.Unlock()
+
+	return handle
+}
+
+// Remove detaches a finalizer added with AddRemovable, without calling it.
+// It returns false if the finalizer was already executed or removed, or if
+// the handle is the zero value.
+//
+// This method is thread-safe.
+//
+// Implements RemovableSubscription.
+func (s *subscriptionImpl) Remove(handle TeardownHandle) bool {
+	if handle.id == 0 {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.finalizers {
+		if s.finalizers[i].id == handle.id {
+			s.finalizers = append(s.finalizers[:i], s.finalizers[i+1:]...)
+			return true
+		}
 	}
+
+	return false
 }
 
 // AddUnsubscribable merges multiple subscriptions into one. The method does nothing
@@ -101,52 +261,162 @@ func (s *subscriptionImpl) AddUnsubscribable(unsubscribable Unsubscribable) {
 		return
 	}
 
-	s.Add(unsubscribable.Unsubscribe)
+	withCause, ok := unsubscribable.(causeUnsubscribable)
+	if !ok {
+		s.Add(unsubscribable.Unsubscribe)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.done {
+		withCause.UnsubscribeWithCause(s.cause) // not protected against panics
+	} else {
+		s.finalizers = append(s.finalizers, finalizer{withCause: withCause})
+	}
 }
 
 // Unsubscribe disposes the resources held by the subscription. May, for
 // instance, cancel an ongoing `Observable` execution or cancel any other
 // type of work that started when the `Subscription` was created.
 //
-// This method is thread-safe. Finalizers are executed in sequence.
+// This method is thread-safe. Finalizers are executed in sequence. The
+// errors raised by the finalizers are reported to OnUnhandledError, or
+// rethrown when PanicOnUnsubscriptionError is enabled.
 //
 // Implements Unsuscribable.
 func (s *subscriptionImpl) Unsubscribe() {
+	s.UnsubscribeWithCause(nil)
+}
+
+// UnsubscribeWithCause disposes the resources held by the subscription, like
+// Unsubscribe, and records the cause of the unsubscription. The cause is
+// forwarded to the subscriptions added with AddUnsubscribable, so that their
+// cleanup can distinguish a deliberate shutdown (nil cause) from a failure.
+//
+// This method is thread-safe. Only the first call has an effect.
+//
+// Implements CauseSubscription.
+func (s *subscriptionImpl) UnsubscribeWithCause(cause error) {
+	err := s.unsubscribe(cause)
+	if err == nil {
+		return
+	}
+
+	if PanicOnUnsubscriptionError {
+		panic(err)
+	}
+
+	OnUnhandledError(context.TODO(), err)
+}
+
+// UnsubscribeWithError disposes the resources held by the subscription, like
+// Unsubscribe, and returns the errors raised by the finalizers, instead of
+// reporting them to OnUnhandledError. Every finalizer is executed, even if a
+// previous one failed.
+//
+// This method is thread-safe. Only the first call has an effect: next calls
+// return nil.
+//
+// Implements ErrorUnsubscribable.
+func (s *subscriptionImpl) UnsubscribeWithError() error {
+	return s.unsubscribe(nil)
+}
+
+func (s *subscriptionImpl) unsubscribe(cause error) error {
 	s.mu.Lock()
 
 	if s.done {
 		s.mu.Unlock()
-		return
+		return nil
 	}
 
 	s.done = true
+	s.cause = cause
+
+	if s.doneCh != nil {
+		close(s.doneCh)
+	}
 
 	if len(s.finalizers) == 0 {
 		s.mu.Unlock()
-		return
+		return nil
 	}
 
 	finalizers := s.finalizers
-	s.finalizers = make([]func(), 0)
+	s.finalizers = make([]finalizer, 0)
 	s.mu.Unlock()
 
 	var errs []error
 
 	// Note: we prefer not running this in parallel.
 	for i := range finalizers {
-		err := execFinalizer(finalizers[i]) // protected against panics
+		err := execFinalizer(finalizers[i], cause) // protected against panics
 		if err != nil {
-			// OnUnhandledError(err)
 			errs = append(errs, err)
 		}
 	}
 
-	// Error is triggered after the recursive call to finalizers
-	// because we want to execute all finalizers before panicking.
+	// Errors are reported after the recursive call to finalizers
+	// because we want to execute all finalizers before failing.
 	if len(errs) > 0 {
 		// errors.Join has been introduced in go 1.20
-		panic(xerrors.Join(errs...))
+		return xerrors.Join(errs...)
 	}
+
+	return nil
+}
+
+// String implements Describer: "ro.Subscription(state=active, teardowns=2)".
+func (s *subscriptionImpl) String() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.done {
+		return fmt.Sprintf("ro.Subscription(state=active, teardowns=%d)", len(s.finalizers))
+	} else if s.cause != nil {
+		return fmt.Sprintf("ro.Subscription(state=closed, cause=%s)", s.cause.Error())
+	}
+
+	return "ro.Subscription(state=closed)"
+}
+
+// DebugString implements Describer. It lists the pending teardowns, named
+// after the function that declared them.
+func (s *subscriptionImpl) DebugString() string {
+	summary := s.String()
+
+	s.mu.Lock()
+	finalizers := append([]finalizer{}, s.finalizers...)
+	s.mu.Unlock()
+
+	var b strings.Builder
+
+	b.WriteString(summary)
+
+	for i := range finalizers {
+		var name string
+		if finalizers[i].withCause != nil {
+			name = describeName(finalizers[i].withCause)
+		} else {
+			name = describeName(finalizers[i].teardown)
+		}
+
+		fmt.Fprintf(&b, "\n  teardown %d: %s", i+1, name)
+	}
+
+	return b.String()
+}
+
+// Cause returns the cause passed to UnsubscribeWithCause, or nil.
+//
+// Implements CauseSubscription.
+func (s *subscriptionImpl) Cause() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cause
 }
 
 // IsClosed returns true if the subscription has been disposed
@@ -182,11 +452,73 @@ func (s *subscriptionImpl) Wait() {
 	close(ch)
 }
 
+// Done returns a channel that is closed when the subscription is disposed,
+// or when unsubscription is in progress, like IsClosed. It can be used in a
+// select statement, along with timeouts and other channels.
+//
+// Implements WaitableSubscription.
+func (s *subscriptionImpl) Done() <-chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.doneCh == nil {
+		s.doneCh = make(chan struct{})
+
+		if s.done {
+			close(s.doneCh)
+		}
+	}
+
+	return s.doneCh
+}
+
+// WaitWithContext blocks until the subscription is disposed, like Wait, or
+// until ctx is canceled. It returns nil once the subscription is disposed,
+// and ctx.Err() otherwise. The subscription is not canceled along with ctx.
+//
+// Implements WaitableSubscription.
+func (s *subscriptionImpl) WaitWithContext(ctx context.Context) error {
+	select {
+	case <-s.Done():
+		return nil
+	case <-ctx.Done():
+		// The subscription may have been disposed concurrently.
+		if s.IsClosed() {
+			return nil
+		}
+
+		return ctx.Err()
+	}
+}
+
+// waitWithContext is WaitWithContext, for any Subscription.
+func waitWithContext(ctx context.Context, subscription Subscription) error {
+	if waitable, ok := subscription.(WaitableSubscription); ok {
+		return waitable.WaitWithContext(ctx)
+	}
+
+	done := make(chan struct{})
+	subscription.Add(func() {
+		close(done)
+	})
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		if subscription.IsClosed() {
+			return nil
+		}
+
+		return ctx.Err()
+	}
+}
+
 // execFinalizer runs the finalizer and catches any panics, converting them to errors.
-func execFinalizer(finalizer func()) (err error) {
+func execFinalizer(f finalizer, cause error) (err error) {
 	lo.TryCatchWithErrorValue(
 		func() error {
-			finalizer()
+			f.run(cause)
 
 			err = nil
 
@@ -200,6 +532,227 @@ func execFinalizer(finalizer func()) (err error) {
 	return err
 }
 
-// @TODO: Add methods Remove + RemoveSubscription.
-// Currently, Go does not support function address comparison, so we cannot
-// remove a finalizer from the list.
+// CompositeSubscription holds a dynamic set of child subscriptions, such as the
+// pipelines created per connection or per tenant, and disposes of them in bulk.
+type CompositeSubscription interface {
+	Unsubscribable
+
+	// UnsubscribeWithCause is Unsubscribe, forwarding the cause to the children.
+	UnsubscribeWithCause(cause error)
+	// UnsubscribeWithError is Unsubscribe, returning the errors raised by the
+	// children instead of reporting them to OnUnhandledError.
+	UnsubscribeWithError() error
+
+	// Add adds a child. When the composite is already disposed, the child is
+	// unsubscribed immediately. A child Subscription is removed once disposed.
+	Add(child Unsubscribable)
+	// Remove detaches a child, without unsubscribing it. It returns false if
+	// the child was not found.
+	Remove(child Unsubscribable) bool
+	// Clear unsubscribes the children, but keeps the composite open.
+	Clear()
+	// Len returns the number of children.
+	Len() int
+	IsClosed() bool
+}
+
+var _ CompositeSubscription = (*compositeSubscriptionImpl)(nil)
+
+// NewCompositeSubscription creates an empty CompositeSubscription. Unsubscribe
+// disposes of every child, then of the children added later.
+//
+// Children are compared by identity: they must be comparable, such as the
+// Subscriptions returned by Subscribe.
+func NewCompositeSubscription(children ...Unsubscribable) CompositeSubscription {
+	c := &compositeSubscriptionImpl{
+		children: map[Unsubscribable]TeardownHandle{},
+	}
+
+	for _, child := range children {
+		c.Add(child)
+	}
+
+	return c
+}
+
+type compositeSubscriptionImpl struct {
+	mu       sync.Mutex
+	done     bool
+	children map[Unsubscribable]TeardownHandle
+}
+
+// Implements CompositeSubscription.
+func (c *compositeSubscriptionImpl) Add(child Unsubscribable) {
+	if child == nil {
+		return
+	}
+
+	c.mu.Lock()
+
+	if c.done {
+		c.mu.Unlock()
+		child.Unsubscribe() // not protected against panics
+		return
+	}
+
+	if _, ok := c.children[child]; ok {
+		c.mu.Unlock()
+		return
+	}
+
+	c.children[child] = TeardownHandle{}
+	c.mu.Unlock()
+
+	// The lock must be released: the teardown is called immediately if the
+	// child is already disposed.
+	if subscription, ok := child.(RemovableSubscription); ok {
+		handle := subscription.AddRemovable(func() {
+			c.forget(child)
+		})
+
+		c.mu.Lock()
+		if _, ok := c.children[child]; ok {
+			c.children[child] = handle
+		}
+		c.mu.Unlock()
+	}
+}
+
+// Implements CompositeSubscription.
+func (c *compositeSubscriptionImpl) Remove(child Unsubscribable) bool {
+	if child == nil {
+		return false
+	}
+
+	c.mu.Lock()
+	handle, ok := c.children[child]
+	delete(c.children, child)
+	c.mu.Unlock()
+
+	if ok {
+		if subscription, isSubscription := child.(RemovableSubscription); isSubscription {
+			subscription.Remove(handle)
+		}
+	}
+
+	return ok
+}
+
+func (c *compositeSubscriptionImpl) forget(child Unsubscribable) {
+	c.mu.Lock()
+	delete(c.children, child)
+	c.mu.Unlock()
+}
+
+// Implements CompositeSubscription.
+func (c *compositeSubscriptionImpl) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.children)
+}
+
+// Implements CompositeSubscription.
+func (c *compositeSubscriptionImpl) IsClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.done
+}
+
+// Implements CompositeSubscription.
+func (c *compositeSubscriptionImpl) Unsubscribe() {
+	c.UnsubscribeWithCause(nil)
+}
+
+// Implements CompositeSubscription.
+func (c *compositeSubscriptionImpl) UnsubscribeWithCause(cause error) {
+	c.report(c.dispose(cause, true))
+}
+
+// Implements CompositeSubscription.
+func (c *compositeSubscriptionImpl) UnsubscribeWithError() error {
+	return c.dispose(nil, true)
+}
+
+// Implements CompositeSubscription.
+func (c *compositeSubscriptionImpl) Clear() {
+	c.report(c.dispose(nil, false))
+}
+
+func (c *compositeSubscriptionImpl) report(err error) {
+	if err == nil {
+		return
+	}
+
+	if PanicOnUnsubscriptionError {
+		panic(err)
+	}
+
+	OnUnhandledError(context.TODO(), err)
+}
+
+// dispose unsubscribes the children, and closes the composite if closing is true.
+func (c *compositeSubscriptionImpl) dispose(cause error, closing bool) error {
+	c.mu.Lock()
+
+	if c.done {
+		c.mu.Unlock()
+		return nil
+	}
+
+	c.done = closing
+	children := c.children
+	c.children = map[Unsubscribable]TeardownHandle{}
+
+	c.mu.Unlock()
+
+	var errs []error
+
+	for child := range children {
+		// Without cause, the errors of the child Subscriptions are collected,
+		// instead of being reported by each of them.
+		if withError, ok := child.(ErrorUnsubscribable); ok && cause == nil {
+			if err := withError.UnsubscribeWithError(); err != nil {
+				errs = append(errs, err)
+			}
+
+			continue
+		}
+
+		f := finalizer{teardown: child.Unsubscribe}
+		if withCause, ok := child.(causeUnsubscribable); ok {
+			f = finalizer{withCause: withCause}
+		}
+
+		if err := execFinalizer(f, cause); err != nil { // protected against panics
+			errs = append(errs, err)
+		}
+	}
+
+	// errors.Join has been introduced in go 1.20
+	return xerrors.Join(errs...)
+}
+
+type unsubscriptionCauseKey struct{}
+
+func withUnsubscriptionCause(ctx context.Context, cause error) context.Context {
+	return context.WithValue(ctx, unsubscriptionCauseKey{}, cause)
+}
+
+// UnsubscriptionCause returns the cause of the unsubscription attached to the
+// context of a notification dropped by a Subscriber closed with
+// UnsubscribeWithCause or by an error. It returns nil otherwise, for instance
+// after a deliberate Unsubscribe().
+//
+// It lets OnDroppedNotification hooks, such as dead-letter handlers,
+// distinguish a deliberate shutdown from a cancellation due to a failure.
+func UnsubscriptionCause(ctx context.Context) error {
+	if ctx == nil {
+		return nil
+	}
+
+	cause, _ := ctx.Value(unsubscriptionCauseKey{}).(error)
+
+	return cause
+}
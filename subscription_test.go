@@ -15,7 +15,9 @@
 package ro
 
 import (
+	"context"
 	"errors"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -119,6 +121,54 @@ func TestSubscriptionAddUnsubscribable(t *testing.T) {
 	is.True(called2) // Should be called immediately
 }
 
+func TestSubscriptionAddRemovable(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	sub := NewSubscription(nil).(RemovableSubscription)
+	called1 := false
+	called2 := false
+
+	// Test adding nil teardown
+	is.Equal(TeardownHandle{}, sub.AddRemovable(nil))
+
+	handle1 := sub.AddRemovable(func() { called1 = true })
+	handle2 := sub.AddRemovable(func() { called2 = true })
+	is.NotEqual(TeardownHandle{}, handle1)
+	is.NotEqual(handle1, handle2)
+
+	// Test removing a teardown
+	is.True(sub.Remove(handle1))
+	is.False(sub.Remove(handle1))
+	is.False(sub.Remove(TeardownHandle{}))
+
+	sub.Unsubscribe()
+	is.False(called1)
+	is.True(called2)
+
+	// Test removing an executed teardown
+	is.False(sub.Remove(handle2))
+
+	// Test adding teardown to already closed subscription
+	called3 := false
+	is.Equal(TeardownHandle{}, sub.AddRemovable(func() { called3 = true }))
+	is.True(called3) // Should be called immediately
+}
+
+func TestSubscriptionRemoveMemoryLeak(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	sub := NewSubscription(nil).(RemovableSubscription)
+
+	for i := 0; i < 1000; i++ {
+		handle := sub.AddRemovable(func() {})
+		is.True(sub.Remove(handle))
+	}
+
+	is.Empty(sub.(*subscriptionImpl).finalizers)
+}
+
 func TestSubscriptionUnsubscribe(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -155,6 +205,104 @@ func TestSubscriptionUnsubscribe(t *testing.T) {
 	is.True(sub2.IsClosed())
 }
 
+func TestSubscriptionUnsubscribeWithCause(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	cause := errors.New("upstream failure")
+
+	// Deliberate shutdown
+	sub := NewSubscription(nil).(CauseSubscription)
+	sub.Unsubscribe()
+	is.True(sub.IsClosed())
+	is.Nil(sub.Cause())
+
+	// Shutdown due to a failure
+	called := false
+	sub = NewSubscription(func() { called = true }).(CauseSubscription)
+	is.Nil(sub.Cause())
+	sub.UnsubscribeWithCause(cause)
+	is.True(called)
+	is.True(sub.IsClosed())
+	is.Equal(cause, sub.Cause())
+
+	// The first cause wins
+	sub.UnsubscribeWithCause(errors.New("other"))
+	is.Equal(cause, sub.Cause())
+
+	// The cause is forwarded to child subscriptions
+	parent := NewSubscription(nil).(CauseSubscription)
+	child := NewSubscription(nil).(CauseSubscription)
+	parent.AddUnsubscribable(child)
+	parent.UnsubscribeWithCause(cause)
+	is.True(child.IsClosed())
+	is.Equal(cause, child.Cause())
+
+	// Child subscriptions added after closing receive the cause too
+	child = NewSubscription(nil).(CauseSubscription)
+	parent.AddUnsubscribable(child)
+	is.True(child.IsClosed())
+	is.Equal(cause, child.Cause())
+
+	// Unsubscribables without cause support are unsubscribed as usual
+	called = false
+	parent = NewSubscription(nil).(CauseSubscription)
+	parent.AddUnsubscribable(&mockUnsubscribable{unsubscribe: func() { called = true }})
+	parent.UnsubscribeWithCause(cause)
+	is.True(called)
+}
+
+func TestSubscriberUnsubscribeWithCause(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	cause := errors.New("upstream failure")
+
+	// An error closes the subscriber with the error as cause
+	subscriber := NewSubscriber(NoopObserver[int]())
+	subscriber.Error(cause)
+	is.Equal(cause, subscriber.(CauseSubscription).Cause())
+
+	// A completion is a deliberate shutdown
+	subscriber = NewSubscriber(NoopObserver[int]())
+	subscriber.Complete()
+	is.Nil(subscriber.(CauseSubscription).Cause())
+
+	subscriber = NewSubscriber(NoopObserver[int]())
+	subscriber.(CauseSubscription).UnsubscribeWithCause(cause)
+	is.True(subscriber.IsClosed())
+	is.Equal(cause, subscriber.(CauseSubscription).Cause())
+
+	// The cause is forwarded to the source subscription
+	child := NewSubscription(nil).(CauseSubscription)
+	sub := Pipe1(
+		Never(),
+		Map(func(x struct{}) int { return 42 }),
+	).Subscribe(NoopObserver[int]()).(CauseSubscription)
+	sub.AddUnsubscribable(child)
+	sub.UnsubscribeWithCause(cause)
+	is.Equal(cause, sub.Cause())
+	is.Equal(cause, child.Cause())
+}
+
+func TestUnsubscriptionCause(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	cause := errors.New("upstream failure")
+
+	is.Nil(UnsubscriptionCause(nil)) //nolint:staticcheck
+	is.Nil(UnsubscriptionCause(context.Background()))
+	is.Equal(cause, UnsubscriptionCause(withUnsubscriptionCause(context.Background(), cause)))
+
+	subscriber := NewSubscriber(NoopObserver[int]()).(*subscriberImpl[int])
+	ctx := context.Background()
+	is.Equal(ctx, subscriber.droppedContext(ctx))
+
+	subscriber.UnsubscribeWithCause(cause)
+	is.Equal(cause, UnsubscriptionCause(subscriber.droppedContext(ctx)))
+}
+
 func TestSubscriptionIsClosed(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -208,6 +356,91 @@ func TestSubscriptionWait(t *testing.T) {
 	}
 }
 
+func TestSubscriptionDone(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	sub := NewSubscription(nil).(WaitableSubscription)
+	done := sub.Done()
+	is.Equal(done, sub.Done())
+
+	select {
+	case <-done:
+		is.Fail("Done should not be closed before unsubscribe")
+	default:
+	}
+
+	sub.Unsubscribe()
+
+	select {
+	case <-done:
+	case <-time.After(100 * time.Millisecond):
+		is.Fail("Done should be closed after unsubscribe")
+	}
+
+	// Test Done on an already closed subscription
+	select {
+	case <-sub.Done():
+	default:
+		is.Fail("Done should be closed")
+	}
+
+	// Test Done on a subscriber
+	subscriber := NewSubscriber(NoopObserver[int]())
+	done = subscriber.(WaitableSubscription).Done()
+	subscriber.Complete()
+	<-done
+}
+
+func TestSubscriptionWaitWithContext(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 200*time.Millisecond)
+	is := assert.New(t)
+
+	// Test cancellation
+	sub := NewSubscription(nil).(WaitableSubscription)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	is.ErrorIs(sub.WaitWithContext(ctx), context.DeadlineExceeded)
+	is.False(sub.IsClosed())
+
+	// Test unsubscription
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		sub.Unsubscribe()
+	}()
+
+	is.NoError(sub.WaitWithContext(context.Background()))
+	is.True(sub.IsClosed())
+
+	// Test already closed subscription with a canceled context
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	is.NoError(sub.WaitWithContext(ctx))
+}
+
+func TestSubscriptionWithoutOptionalInterfaces(t *testing.T) {
+	t.Parallel()
+	testWithTimeout(t, 200*time.Millisecond)
+	is := assert.New(t)
+
+	// A Subscription implementing none of the optional interfaces.
+	sub := struct{ Subscription }{NewSubscription(nil)}
+	_, ok := Subscription(sub).(WaitableSubscription)
+	is.False(ok)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	is.ErrorIs(waitWithContext(ctx, sub), context.DeadlineExceeded)
+	is.False(sub.IsClosed())
+
+	unsubscribeWithCause(sub, assert.AnError)
+	is.True(sub.IsClosed())
+	is.NoError(waitWithContext(context.Background(), sub))
+}
+
 func TestSubscriptionPanicHandling(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -218,10 +451,12 @@ func TestSubscriptionPanicHandling(t *testing.T) {
 	}
 	sub := NewSubscription(panicTeardown)
 
-	// Should panic when unsubscribe is called
-	is.Panics(func() {
+	// Should not panic when unsubscribe is called: the error is reported
+	// to OnUnhandledError
+	is.NotPanics(func() {
 		sub.Unsubscribe()
 	})
+	is.True(sub.IsClosed())
 
 	// Test multiple teardowns with one that panics
 	called := false
@@ -232,11 +467,13 @@ func TestSubscriptionPanicHandling(t *testing.T) {
 	sub2 := NewSubscription(normalTeardown)
 	sub2.Add(panicTeardown)
 
-	// Should panic, but normal teardown should still be called
-	is.Panics(func() {
-		sub2.Unsubscribe()
-	})
+	// Should return the error, and normal teardown should still be called
+	err := sub2.(ErrorUnsubscribable).UnsubscribeWithError()
+	is.EqualError(err, newUnsubscriptionError(errors.New("unexpected error: test panic")).Error())
 	is.True(called)
+
+	// Next calls have no effect
+	is.NoError(sub2.(ErrorUnsubscribable).UnsubscribeWithError())
 }
 
 func TestSubscriptionConcurrentAdd(t *testing.T) {
@@ -478,12 +715,12 @@ func TestSubscriptionErrorHandling(t *testing.T) {
 	errorTeardown := func() {
 		panic(errors.New("test error"))
 	}
-	sub := NewSubscription(errorTeardown)
+	sub := NewSubscription(errorTeardown).(ErrorUnsubscribable)
 
-	// Should panic with the error
-	is.Panics(func() {
-		sub.Unsubscribe()
-	})
+	// Should return the error
+	err := sub.UnsubscribeWithError()
+	is.Error(err)
+	is.ErrorContains(err, "test error")
 
 	// Test multiple teardowns with errors
 	normalCalled := false
@@ -493,11 +730,12 @@ func TestSubscriptionErrorHandling(t *testing.T) {
 
 	sub2 := NewSubscription(normalTeardown)
 	sub2.Add(errorTeardown)
+	sub2.Add(errorTeardown)
 
-	// Should panic, but normal teardown should still be called
-	is.Panics(func() {
-		sub2.Unsubscribe()
-	})
+	// Should return both errors, and normal teardown should still be called
+	err = sub2.(ErrorUnsubscribable).UnsubscribeWithError()
+	is.Error(err)
+	is.Len(strings.Split(err.Error(), "\n"), 2)
 	is.True(normalCalled)
 }
 
@@ -552,3 +790,122 @@ func (m *mockUnsubscribable) Unsubscribe() {
 		m.unsubscribe()
 	}
 }
+
+func TestCompositeSubscription(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var count int32
+
+	child1 := NewSubscription(func() { atomic.AddInt32(&count, 1) })
+	child2 := NewSubscription(func() { atomic.AddInt32(&count, 1) })
+	child3 := NewSubscription(func() { atomic.AddInt32(&count, 1) })
+
+	composite := NewCompositeSubscription(child1, child2)
+	composite.Add(child3)
+	composite.Add(child3)
+	composite.Add(nil)
+	is.Equal(3, composite.Len())
+
+	// a disposed child is forgotten
+	child1.Unsubscribe()
+	is.Equal(1, int(atomic.LoadInt32(&count)))
+	is.Equal(2, composite.Len())
+
+	// a removed child is not unsubscribed
+	is.True(composite.Remove(child3))
+	is.False(composite.Remove(child3))
+	is.Equal(1, composite.Len())
+
+	composite.Unsubscribe()
+	is.True(composite.IsClosed())
+	is.Equal(0, composite.Len())
+	is.Equal(2, int(atomic.LoadInt32(&count)))
+	is.False(child3.IsClosed())
+
+	// children added later are unsubscribed immediately
+	composite.Add(child3)
+	is.True(child3.IsClosed())
+	is.Equal(3, int(atomic.LoadInt32(&count)))
+	is.Equal(0, composite.Len())
+
+	composite.Unsubscribe()
+	is.Equal(3, int(atomic.LoadInt32(&count)))
+}
+
+func TestCompositeSubscriptionClear(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	composite := NewCompositeSubscription()
+
+	child1 := Interval(time.Millisecond).Subscribe(NoopObserver[int64]())
+	child2 := Interval(time.Millisecond).Subscribe(NoopObserver[int64]())
+	composite.Add(child1)
+	composite.Add(child2)
+	is.Equal(2, composite.Len())
+
+	composite.Clear()
+	is.False(composite.IsClosed())
+	is.Equal(0, composite.Len())
+	is.True(child1.IsClosed())
+	is.True(child2.IsClosed())
+
+	child3 := NewSubscription(nil)
+	composite.Add(child3)
+	is.Equal(1, composite.Len())
+	is.False(child3.IsClosed())
+
+	composite.Unsubscribe()
+	is.True(child3.IsClosed())
+}
+
+func TestCompositeSubscriptionCauseAndErrors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	child := NewSubscription(nil).(CauseSubscription)
+	composite := NewCompositeSubscription(child)
+	composite.UnsubscribeWithCause(assert.AnError)
+	is.Equal(assert.AnError, child.Cause())
+
+	composite = NewCompositeSubscription(
+		NewSubscription(func() { panic(assert.AnError) }),
+		NewSubscription(nil),
+	)
+
+	err := composite.UnsubscribeWithError()
+	is.ErrorIs(err, assert.AnError)
+	is.True(composite.IsClosed())
+	is.NoError(composite.UnsubscribeWithError())
+}
+
+func TestCompositeSubscriptionConcurrency(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	composite := NewCompositeSubscription()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			child := NewSubscription(nil)
+			composite.Add(child)
+
+			if i%2 == 0 {
+				child.Unsubscribe()
+			}
+		}(i)
+	}
+
+	wg.Wait()
+	is.Equal(50, composite.Len())
+
+	composite.Unsubscribe()
+	is.Equal(0, composite.Len())
+}
@@ -0,0 +1,269 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotesting
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/samber/ro"
+)
+
+var _ ro.Observer[int] = (*SpyObserver[int])(nil)
+
+// SpyCall is a notification received by a SpyObserver.
+type SpyCall[T any] struct {
+	// Index is the position of the call, starting at 0.
+	Index int
+	// Notification is the notification received.
+	Notification ro.Notification[T]
+	// Context is the context passed along with the notification.
+	Context context.Context
+	// Overlapping is true when the call started before the previous one
+	// returned: the observer contract forbids concurrent calls.
+	Overlapping bool
+	// AfterTermination is true when the call was received after an Error or
+	// a Complete notification.
+	AfterTermination bool
+}
+
+// SpyOption configures a SpyObserver.
+type SpyOption func(*spyConfig)
+
+type spyConfig struct {
+	delay time.Duration
+}
+
+// WithSpyDelay makes the SpyObserver sleep for delay on each call, in order
+// to widen the window in which overlapping calls are detected.
+func WithSpyDelay(delay time.Duration) SpyOption {
+	return func(c *spyConfig) {
+		c.delay = delay
+	}
+}
+
+// SpyObserver is an Observer recording the notifications it receives, in
+// order, with their context. It checks that the calls are sequential (never
+// overlapping) and that no notification follows an Error or a Complete.
+//
+// It is intended for testing custom operators:
+//
+//	spy := rotesting.NewSpyObserver[int]()
+//	MyOperator()(ro.Just(1, 2, 3)).Subscribe(spy)
+//
+//	spy.AssertSequential(t)
+//	spy.AssertNotifications(t,
+//		ro.NewNotificationNext(1),
+//		ro.NewNotificationNext(2),
+//		ro.NewNotificationNext(3),
+//		ro.NewNotificationComplete[int](),
+//	)
+//
+// A SpyObserver is safe for concurrent use. It must be created with
+// NewSpyObserver.
+type SpyObserver[T any] struct {
+	config spyConfig
+
+	inFlight int32
+	status   int32 // 0 - active, 1 - errored, 2 - completed
+
+	mu    sync.Mutex
+	calls []SpyCall[T]
+}
+
+// NewSpyObserver creates a SpyObserver.
+func NewSpyObserver[T any](opts ...SpyOption) *SpyObserver[T] {
+	config := spyConfig{}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return &SpyObserver[T]{
+		config: config,
+		calls:  []SpyCall[T]{},
+	}
+}
+
+func (s *SpyObserver[T]) record(ctx context.Context, notification ro.Notification[T]) {
+	overlapping := atomic.AddInt32(&s.inFlight, 1) > 1
+	defer atomic.AddInt32(&s.inFlight, -1)
+
+	afterTermination := atomic.LoadInt32(&s.status) != 0
+
+	switch notification.Kind {
+	case ro.KindError:
+		atomic.CompareAndSwapInt32(&s.status, 0, 1)
+	case ro.KindComplete:
+		atomic.CompareAndSwapInt32(&s.status, 0, 2)
+	case ro.KindNext:
+	}
+
+	s.mu.Lock()
+	s.calls = append(s.calls, SpyCall[T]{
+		Index:            len(s.calls),
+		Notification:     notification,
+		Context:          ctx,
+		Overlapping:      overlapping,
+		AfterTermination: afterTermination,
+	})
+	s.mu.Unlock()
+
+	if s.config.delay > 0 {
+		time.Sleep(s.config.delay)
+	}
+}
+
+// Next implements ro.Observer.
+func (s *SpyObserver[T]) Next(value T) {
+	s.NextWithContext(context.Background(), value)
+}
+
+// NextWithContext implements ro.Observer.
+func (s *SpyObserver[T]) NextWithContext(ctx context.Context, value T) {
+	s.record(ctx, ro.NewNotificationNext(value))
+}
+
+// Error implements ro.Observer.
+func (s *SpyObserver[T]) Error(err error) {
+	s.ErrorWithContext(context.Background(), err)
+}
+
+// ErrorWithContext implements ro.Observer.
+func (s *SpyObserver[T]) ErrorWithContext(ctx context.Context, err error) {
+	s.record(ctx, ro.NewNotificationError[T](err))
+}
+
+// Complete implements ro.Observer.
+func (s *SpyObserver[T]) Complete() {
+	s.CompleteWithContext(context.Background())
+}
+
+// CompleteWithContext implements ro.Observer.
+func (s *SpyObserver[T]) CompleteWithContext(ctx context.Context) {
+	s.record(ctx, ro.NewNotificationComplete[T]())
+}
+
+// IsClosed implements ro.Observer.
+func (s *SpyObserver[T]) IsClosed() bool {
+	return atomic.LoadInt32(&s.status) != 0
+}
+
+// HasThrown implements ro.Observer.
+func (s *SpyObserver[T]) HasThrown() bool {
+	return atomic.LoadInt32(&s.status) == 1
+}
+
+// IsCompleted implements ro.Observer.
+func (s *SpyObserver[T]) IsCompleted() bool {
+	return atomic.LoadInt32(&s.status) == 2
+}
+
+// Calls returns a copy of the calls received so far, in order.
+func (s *SpyObserver[T]) Calls() []SpyCall[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]SpyCall[T]{}, s.calls...)
+}
+
+// Notifications returns the notifications received so far, in order.
+func (s *SpyObserver[T]) Notifications() []ro.Notification[T] {
+	calls := s.Calls()
+
+	notifications := make([]ro.Notification[T], 0, len(calls))
+	for i := range calls {
+		notifications = append(notifications, calls[i].Notification)
+	}
+
+	return notifications
+}
+
+// Values returns the values of the Next notifications received so far.
+func (s *SpyObserver[T]) Values() []T {
+	calls := s.Calls()
+
+	values := []T{}
+	for i := range calls {
+		if calls[i].Notification.Kind == ro.KindNext {
+			values = append(values, calls[i].Notification.Value)
+		}
+	}
+
+	return values
+}
+
+// Contexts returns the contexts received so far, in order.
+func (s *SpyObserver[T]) Contexts() []context.Context {
+	calls := s.Calls()
+
+	contexts := make([]context.Context, 0, len(calls))
+	for i := range calls {
+		contexts = append(contexts, calls[i].Context)
+	}
+
+	return contexts
+}
+
+// Err returns the first error received, or nil.
+func (s *SpyObserver[T]) Err() error {
+	for _, call := range s.Calls() {
+		if call.Notification.Kind == ro.KindError {
+			return call.Notification.Err
+		}
+	}
+
+	return nil
+}
+
+// AssertSequential fails the test if calls overlapped, or if notifications
+// were received after an Error or a Complete. It returns true on success.
+func (s *SpyObserver[T]) AssertSequential(t testing.TB) bool {
+	t.Helper()
+
+	ok := true
+
+	for _, call := range s.Calls() {
+		if call.Overlapping {
+			t.Errorf("call %d (%s) overlapped the previous call", call.Index, call.Notification.Kind)
+			ok = false
+		}
+
+		if call.AfterTermination {
+			t.Errorf("call %d (%s) received after termination", call.Index, call.Notification.Kind)
+			ok = false
+		}
+	}
+
+	return ok
+}
+
+// AssertNotifications fails the test if the notifications received differ
+// from expected. Errors are compared with reflect.DeepEqual. It returns true
+// on success.
+func (s *SpyObserver[T]) AssertNotifications(t testing.TB, expected ...ro.Notification[T]) bool {
+	t.Helper()
+
+	actual := s.Notifications()
+	if len(expected) != len(actual) || (len(expected) > 0 && !reflect.DeepEqual(expected, actual)) {
+		t.Errorf("unexpected notifications:\nexpected: %v\nactual:   %v", expected, actual)
+		return false
+	}
+
+	return true
+}
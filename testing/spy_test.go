@@ -0,0 +1,126 @@
+// Copyright 2025 samber.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://github.com/samber/ro/blob/main/licenses/LICENSE.apache.md
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotesting
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/samber/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+type spyKey struct{}
+
+// recorderTB records the failures instead of failing the test.
+type recorderTB struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorderTB) Helper() {}
+
+func (r *recorderTB) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestSpyObserver(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	spy := NewSpyObserver[int]()
+	ctx := context.WithValue(context.Background(), spyKey{}, "foobar")
+
+	ro.Pipe1(
+		ro.Just(1, 2, 3),
+		ro.Filter(func(v int) bool { return v != 2 }),
+	).SubscribeWithContext(ctx, spy)
+
+	is.True(spy.AssertSequential(t))
+	is.True(spy.AssertNotifications(t,
+		ro.NewNotificationNext(1),
+		ro.NewNotificationNext(3),
+		ro.NewNotificationComplete[int](),
+	))
+	is.Equal([]int{1, 3}, spy.Values())
+	is.NoError(spy.Err())
+	is.True(spy.IsClosed())
+	is.True(spy.IsCompleted())
+	is.False(spy.HasThrown())
+
+	contexts := spy.Contexts()
+	is.Len(contexts, 3)
+	for _, ctx := range contexts {
+		is.Equal("foobar", ctx.Value(spyKey{}))
+	}
+
+	calls := spy.Calls()
+	is.Equal(2, calls[2].Index)
+	is.Equal(ro.KindComplete, calls[2].Notification.Kind)
+
+	spy = NewSpyObserver[int]()
+	ro.Throw[int](assert.AnError).Subscribe(spy)
+	is.True(spy.AssertSequential(t))
+	is.ErrorIs(spy.Err(), assert.AnError)
+	is.True(spy.HasThrown())
+	is.Empty(spy.Values())
+}
+
+func TestSpyObserverViolations(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// notification after termination
+	spy := NewSpyObserver[int]()
+	spy.Next(1)
+	spy.Complete()
+	spy.Next(2)
+
+	recorder := &recorderTB{}
+	is.False(spy.AssertSequential(recorder))
+	is.Equal([]string{"call 2 (Next) received after termination"}, recorder.errors)
+	is.True(spy.Calls()[2].AfterTermination)
+
+	recorder = &recorderTB{}
+	is.False(spy.AssertNotifications(recorder, ro.NewNotificationNext(1)))
+	is.Len(recorder.errors, 1)
+
+	recorder = &recorderTB{}
+	is.False(NewSpyObserver[int]().AssertNotifications(recorder, ro.NewNotificationComplete[int]()))
+	is.True(NewSpyObserver[int]().AssertNotifications(recorder))
+
+	// overlapping calls
+	spy = NewSpyObserver[int](WithSpyDelay(50 * time.Millisecond))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+			spy.Next(i)
+		}(i)
+	}
+	wg.Wait()
+
+	recorder = &recorderTB{}
+	is.False(spy.AssertSequential(recorder))
+	is.Len(recorder.errors, 1)
+	is.Contains(recorder.errors[0], "overlapped the previous call")
+	is.ElementsMatch([]int{0, 1}, spy.Values())
+}